@@ -0,0 +1,43 @@
+package boltdb
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Timeout to obtain a file lock of the database
+//
+// [FYI]
+// The file is locked while it is open, so another process using the same file waits for this duration
+const openTimeout time.Duration = 5 * time.Second
+
+// Open an embedded database file
+//
+// [FYI]
+// The returned function closes the database, and it must be called after the application finishes
+func NewDB(ctx context.Context, cfg *config.StorageConfig) (*bolt.DB, func(), error) {
+	if err := os.MkdirAll(filepath.Dir(cfg.StorageFilePath), 0o755); err != nil {
+		slog.ErrorContext(ctx, "failed to create a directory of the database", slog.Any("error", err))
+		return nil, nil, err
+	}
+
+	db, err := bolt.Open(cfg.StorageFilePath, 0o600, &bolt.Options{Timeout: openTimeout})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to open the database", slog.Any("error", err))
+		return nil, nil, err
+	}
+
+	cleanup := func() {
+		if err := db.Close(); err != nil {
+			slog.ErrorContext(ctx, "failed to close the database", slog.Any("error", err))
+		}
+	}
+
+	return db, cleanup, nil
+}
@@ -0,0 +1,223 @@
+package boltdb
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// A name of the bucket which stores the state of the weekly digest
+	digestBucket = []byte("digest")
+	// A name of the nested bucket which stores deals queued for the weekly digest keyed by app IDs
+	digestQueueBucket = []byte("queue")
+	// A key of the time when the weekly digest was sent last time
+	digestLastSentAtKey = []byte("last_sent_at")
+)
+
+// A record of a deal queued for the weekly digest in the database
+//
+// [FYI]
+// The amounts are stored in the minor units of the currency to avoid rounding errors,
+// and the lowest price is nil if it is not set
+type queuedDealRecord struct {
+	QueuedAt        time.Time          `json:"queued_at"`
+	Title           string             `json:"title"`
+	Priority        uint32             `json:"priority"`
+	Currency        model.CurrencyCode `json:"currency"`
+	CurrentPrice    uint64             `json:"current_price"`
+	LowestPrice     *uint64            `json:"lowest_price"`
+	RegularPrice    uint64             `json:"regular_price"`
+	DiscountPercent uint32             `json:"discount_percent"`
+	DealClass       model.DealClass    `json:"deal_class"`
+	TriggeredRules  []model.DealRule   `json:"triggered_rules"`
+}
+
+type digestRepository struct {
+	db *bolt.DB
+}
+
+var (
+	_ service.DealsQueuer        = (*digestRepository)(nil)
+	_ service.DigestQueueGetter  = (*digestRepository)(nil)
+	_ service.DigestQueueFlusher = (*digestRepository)(nil)
+)
+
+// Generate a new digest repository
+func NewDigestRepository(db *bolt.DB) *digestRepository {
+	return &digestRepository{
+		db: db,
+	}
+}
+
+// Queue deals for the weekly digest
+//
+// [FYI]
+// A deal of a video game which has already been queued overwrites it, so that the latest deal is sent
+func (r *digestRepository) QueueDeals(
+	ctx context.Context,
+	input *service.QueueDealsInput,
+) (*service.QueueDealsOutput, error) {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists(digestBucket)
+		if err != nil {
+			return err
+		}
+
+		bucket, err := root.CreateBucketIfNotExists(digestQueueBucket)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range input.QueuedDeals {
+			var lowestPrice *uint64
+			if v.Content.LowestPrice != nil {
+				lowestPrice = &v.Content.LowestPrice.Amount
+			}
+
+			record, err := json.Marshal(&queuedDealRecord{
+				QueuedAt:        v.QueuedAt.UTC(),
+				Title:           v.Content.Title,
+				Priority:        v.Content.Priority,
+				Currency:        v.Content.CurrentPrice.Currency,
+				CurrentPrice:    v.Content.CurrentPrice.Amount,
+				LowestPrice:     lowestPrice,
+				RegularPrice:    v.Content.RegularPrice.Amount,
+				DiscountPercent: v.Content.DiscountPercent,
+				DealClass:       v.Content.DealClass,
+				TriggeredRules:  v.Content.TriggeredRules,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := bucket.Put(encodeAppID(v.AppID), record); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to queue deals for the weekly digest", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &service.QueueDealsOutput{}, nil
+}
+
+// Get the queue of the weekly digest
+//
+// [FYI]
+// The deals are sorted by app IDs in ascending order
+func (r *digestRepository) GetDigestQueue(
+	ctx context.Context,
+	input *service.GetDigestQueueInput,
+) (*service.GetDigestQueueOutput, error) {
+	output := &service.GetDigestQueueOutput{
+		QueuedDeals: make([]*model.QueuedDeal, 0),
+	}
+	err := r.db.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket(digestBucket)
+		if root == nil {
+			return nil
+		}
+
+		if v := root.Get(digestLastSentAtKey); v != nil {
+			if err := output.LastSentAt.UnmarshalBinary(v); err != nil {
+				return err
+			}
+		}
+
+		bucket := root.Bucket(digestQueueBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			record := &queuedDealRecord{}
+			if err := json.Unmarshal(v, record); err != nil {
+				return err
+			}
+
+			var lowestPrice *model.Money
+			if record.LowestPrice != nil {
+				lowestPrice = &model.Money{
+					Currency: record.Currency,
+					Amount:   *record.LowestPrice,
+				}
+			}
+
+			output.QueuedDeals = append(output.QueuedDeals, &model.QueuedDeal{
+				AppID:    model.SteamAppID(binary.BigEndian.Uint64(k)),
+				QueuedAt: record.QueuedAt,
+				Content: &model.DealContent{
+					Title:    record.Title,
+					Priority: record.Priority,
+					CurrentPrice: model.Money{
+						Currency: record.Currency,
+						Amount:   record.CurrentPrice,
+					},
+					LowestPrice: lowestPrice,
+					RegularPrice: model.Money{
+						Currency: record.Currency,
+						Amount:   record.RegularPrice,
+					},
+					DiscountPercent: record.DiscountPercent,
+					DealClass:       record.DealClass,
+					TriggeredRules:  record.TriggeredRules,
+				},
+			})
+
+			return nil
+		})
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get the queue of the weekly digest", slog.Any("error", err))
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// Flush the queue of the weekly digest after it is sent
+//
+// [FYI]
+// The queue is emptied and the sent time is recorded in a single transaction,
+// so that the same deals are not sent twice
+func (r *digestRepository) FlushDigestQueue(
+	ctx context.Context,
+	input *service.FlushDigestQueueInput,
+) (*service.FlushDigestQueueOutput, error) {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists(digestBucket)
+		if err != nil {
+			return err
+		}
+
+		if root.Bucket(digestQueueBucket) != nil {
+			if err := root.DeleteBucket(digestQueueBucket); err != nil {
+				return err
+			}
+		}
+
+		sentAt, err := input.SentAt.UTC().MarshalBinary()
+		if err != nil {
+			return err
+		}
+
+		return root.Put(digestLastSentAtKey, sentAt)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to flush the queue of the weekly digest", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &service.FlushDigestQueueOutput{}, nil
+}
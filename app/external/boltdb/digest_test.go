@@ -0,0 +1,158 @@
+package boltdb
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDigestRepository(t *testing.T) {
+	t.Parallel()
+
+	// Generate a deal queued for the weekly digest
+	newQueuedDeal := func(appID model.SteamAppID, queuedAt time.Time, currentPrice uint64, lowestPrice *model.Money) *model.QueuedDeal {
+		return &model.QueuedDeal{
+			AppID:    appID,
+			QueuedAt: queuedAt,
+			Content: &model.DealContent{
+				Title:           "Title",
+				Priority:        1,
+				CurrentPrice:    model.Money{Currency: "JPY", Amount: currentPrice},
+				LowestPrice:     lowestPrice,
+				RegularPrice:    model.Money{Currency: "JPY", Amount: 2000},
+				DiscountPercent: 50,
+				DealClass:       model.DealClassMatchesLow,
+				TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+			},
+		}
+	}
+
+	t.Run("Positive case: Queue deals over runs and flush them after the digest is sent", func(t *testing.T) {
+		t.Parallel()
+
+		// Open a database in a temporary directory
+		ctx := t.Context()
+		cfg := &config.StorageConfig{
+			StorageFilePath: filepath.Join(t.TempDir(), "test.db"),
+		}
+		db, cleanup, err := NewDB(ctx, cfg)
+		if err != nil {
+			t.Fatalf("failed to open a database: %v", err)
+		}
+		t.Cleanup(cleanup)
+
+		// Queue deals over two runs, and the deal of the same video game is overwritten
+		r := NewDigestRepository(db)
+		firstRun := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		secondRun := firstRun.Add(24 * time.Hour)
+		inputs := []*service.QueueDealsInput{
+			{
+				QueuedDeals: []*model.QueuedDeal{
+					newQueuedDeal(2, firstRun, 1200, nil),
+					newQueuedDeal(1, firstRun, 1000, &model.Money{Currency: "JPY", Amount: 1000}),
+				},
+			},
+			{
+				QueuedDeals: []*model.QueuedDeal{
+					newQueuedDeal(2, secondRun, 1100, nil),
+				},
+			},
+		}
+		for _, input := range inputs {
+			if _, err := r.QueueDeals(ctx, input); err != nil {
+				t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+			}
+		}
+
+		// Execute the method to be tested
+		got, err := r.GetDigestQueue(ctx, &service.GetDigestQueueInput{})
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetDigestQueueOutput{
+			QueuedDeals: []*model.QueuedDeal{
+				newQueuedDeal(1, firstRun, 1000, &model.Money{Currency: "JPY", Amount: 1000}),
+				newQueuedDeal(2, secondRun, 1100, nil),
+			},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+
+		// Flush the queue, and only the sent time is left
+		sentAt := secondRun.Add(time.Hour)
+		if _, err := r.FlushDigestQueue(ctx, &service.FlushDigestQueueInput{SentAt: sentAt}); err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+		got, err = r.GetDigestQueue(ctx, &service.GetDigestQueueInput{})
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want = &service.GetDigestQueueOutput{
+			QueuedDeals: []*model.QueuedDeal{},
+			LastSentAt:  sentAt,
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: Get an empty queue if the digest has never been used", func(t *testing.T) {
+		t.Parallel()
+
+		// Open a database in a temporary directory
+		ctx := t.Context()
+		cfg := &config.StorageConfig{
+			StorageFilePath: filepath.Join(t.TempDir(), "test.db"),
+		}
+		db, cleanup, err := NewDB(ctx, cfg)
+		if err != nil {
+			t.Fatalf("failed to open a database: %v", err)
+		}
+		t.Cleanup(cleanup)
+
+		// Execute the method to be tested
+		r := NewDigestRepository(db)
+		got, err := r.GetDigestQueue(ctx, &service.GetDigestQueueInput{})
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetDigestQueueOutput{
+			QueuedDeals: []*model.QueuedDeal{},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Negative case: Failed to queue deals in a closed database", func(t *testing.T) {
+		t.Parallel()
+
+		// Open and close a database in a temporary directory
+		ctx := t.Context()
+		cfg := &config.StorageConfig{
+			StorageFilePath: filepath.Join(t.TempDir(), "test.db"),
+		}
+		db, cleanup, err := NewDB(ctx, cfg)
+		if err != nil {
+			t.Fatalf("failed to open a database: %v", err)
+		}
+		cleanup()
+
+		// Execute the method to be tested
+		r := NewDigestRepository(db)
+		input := &service.QueueDealsInput{
+			QueuedDeals: []*model.QueuedDeal{
+				newQueuedDeal(1, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), 1000, nil),
+			},
+		}
+		if _, err := r.QueueDeals(ctx, input); err == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated in digest.go", nil)
+		}
+	})
+}
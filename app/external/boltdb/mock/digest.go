@@ -0,0 +1,207 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./digest.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./digest.go -destination=../external/boltdb/mock/digest.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	service "github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDealsQueuer is a mock of DealsQueuer interface.
+type MockDealsQueuer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDealsQueuerMockRecorder
+	isgomock struct{}
+}
+
+// MockDealsQueuerMockRecorder is the mock recorder for MockDealsQueuer.
+type MockDealsQueuerMockRecorder struct {
+	mock *MockDealsQueuer
+}
+
+// NewMockDealsQueuer creates a new mock instance.
+func NewMockDealsQueuer(ctrl *gomock.Controller) *MockDealsQueuer {
+	mock := &MockDealsQueuer{ctrl: ctrl}
+	mock.recorder = &MockDealsQueuerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDealsQueuer) EXPECT() *MockDealsQueuerMockRecorder {
+	return m.recorder
+}
+
+// QueueDeals mocks base method.
+func (m *MockDealsQueuer) QueueDeals(ctx context.Context, input *service.QueueDealsInput) (*service.QueueDealsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueDeals", ctx, input)
+	ret0, _ := ret[0].(*service.QueueDealsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueDeals indicates an expected call of QueueDeals.
+func (mr *MockDealsQueuerMockRecorder) QueueDeals(ctx, input any) *MockDealsQueuerQueueDealsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueDeals", reflect.TypeOf((*MockDealsQueuer)(nil).QueueDeals), ctx, input)
+	return &MockDealsQueuerQueueDealsCall{Call: call}
+}
+
+// MockDealsQueuerQueueDealsCall wrap *gomock.Call
+type MockDealsQueuerQueueDealsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDealsQueuerQueueDealsCall) Return(arg0 *service.QueueDealsOutput, arg1 error) *MockDealsQueuerQueueDealsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDealsQueuerQueueDealsCall) Do(f func(context.Context, *service.QueueDealsInput) (*service.QueueDealsOutput, error)) *MockDealsQueuerQueueDealsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDealsQueuerQueueDealsCall) DoAndReturn(f func(context.Context, *service.QueueDealsInput) (*service.QueueDealsOutput, error)) *MockDealsQueuerQueueDealsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockDigestQueueGetter is a mock of DigestQueueGetter interface.
+type MockDigestQueueGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestQueueGetterMockRecorder
+	isgomock struct{}
+}
+
+// MockDigestQueueGetterMockRecorder is the mock recorder for MockDigestQueueGetter.
+type MockDigestQueueGetterMockRecorder struct {
+	mock *MockDigestQueueGetter
+}
+
+// NewMockDigestQueueGetter creates a new mock instance.
+func NewMockDigestQueueGetter(ctrl *gomock.Controller) *MockDigestQueueGetter {
+	mock := &MockDigestQueueGetter{ctrl: ctrl}
+	mock.recorder = &MockDigestQueueGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDigestQueueGetter) EXPECT() *MockDigestQueueGetterMockRecorder {
+	return m.recorder
+}
+
+// GetDigestQueue mocks base method.
+func (m *MockDigestQueueGetter) GetDigestQueue(ctx context.Context, input *service.GetDigestQueueInput) (*service.GetDigestQueueOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigestQueue", ctx, input)
+	ret0, _ := ret[0].(*service.GetDigestQueueOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDigestQueue indicates an expected call of GetDigestQueue.
+func (mr *MockDigestQueueGetterMockRecorder) GetDigestQueue(ctx, input any) *MockDigestQueueGetterGetDigestQueueCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigestQueue", reflect.TypeOf((*MockDigestQueueGetter)(nil).GetDigestQueue), ctx, input)
+	return &MockDigestQueueGetterGetDigestQueueCall{Call: call}
+}
+
+// MockDigestQueueGetterGetDigestQueueCall wrap *gomock.Call
+type MockDigestQueueGetterGetDigestQueueCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDigestQueueGetterGetDigestQueueCall) Return(arg0 *service.GetDigestQueueOutput, arg1 error) *MockDigestQueueGetterGetDigestQueueCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDigestQueueGetterGetDigestQueueCall) Do(f func(context.Context, *service.GetDigestQueueInput) (*service.GetDigestQueueOutput, error)) *MockDigestQueueGetterGetDigestQueueCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDigestQueueGetterGetDigestQueueCall) DoAndReturn(f func(context.Context, *service.GetDigestQueueInput) (*service.GetDigestQueueOutput, error)) *MockDigestQueueGetterGetDigestQueueCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockDigestQueueFlusher is a mock of DigestQueueFlusher interface.
+type MockDigestQueueFlusher struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestQueueFlusherMockRecorder
+	isgomock struct{}
+}
+
+// MockDigestQueueFlusherMockRecorder is the mock recorder for MockDigestQueueFlusher.
+type MockDigestQueueFlusherMockRecorder struct {
+	mock *MockDigestQueueFlusher
+}
+
+// NewMockDigestQueueFlusher creates a new mock instance.
+func NewMockDigestQueueFlusher(ctrl *gomock.Controller) *MockDigestQueueFlusher {
+	mock := &MockDigestQueueFlusher{ctrl: ctrl}
+	mock.recorder = &MockDigestQueueFlusherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDigestQueueFlusher) EXPECT() *MockDigestQueueFlusherMockRecorder {
+	return m.recorder
+}
+
+// FlushDigestQueue mocks base method.
+func (m *MockDigestQueueFlusher) FlushDigestQueue(ctx context.Context, input *service.FlushDigestQueueInput) (*service.FlushDigestQueueOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlushDigestQueue", ctx, input)
+	ret0, _ := ret[0].(*service.FlushDigestQueueOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlushDigestQueue indicates an expected call of FlushDigestQueue.
+func (mr *MockDigestQueueFlusherMockRecorder) FlushDigestQueue(ctx, input any) *MockDigestQueueFlusherFlushDigestQueueCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushDigestQueue", reflect.TypeOf((*MockDigestQueueFlusher)(nil).FlushDigestQueue), ctx, input)
+	return &MockDigestQueueFlusherFlushDigestQueueCall{Call: call}
+}
+
+// MockDigestQueueFlusherFlushDigestQueueCall wrap *gomock.Call
+type MockDigestQueueFlusherFlushDigestQueueCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDigestQueueFlusherFlushDigestQueueCall) Return(arg0 *service.FlushDigestQueueOutput, arg1 error) *MockDigestQueueFlusherFlushDigestQueueCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDigestQueueFlusherFlushDigestQueueCall) Do(f func(context.Context, *service.FlushDigestQueueInput) (*service.FlushDigestQueueOutput, error)) *MockDigestQueueFlusherFlushDigestQueueCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDigestQueueFlusherFlushDigestQueueCall) DoAndReturn(f func(context.Context, *service.FlushDigestQueueInput) (*service.FlushDigestQueueOutput, error)) *MockDigestQueueFlusherFlushDigestQueueCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
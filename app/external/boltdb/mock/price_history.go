@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./price_history.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./price_history.go -destination=../external/boltdb/mock/price_history.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	service "github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPriceObservationsRecorder is a mock of PriceObservationsRecorder interface.
+type MockPriceObservationsRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceObservationsRecorderMockRecorder
+	isgomock struct{}
+}
+
+// MockPriceObservationsRecorderMockRecorder is the mock recorder for MockPriceObservationsRecorder.
+type MockPriceObservationsRecorderMockRecorder struct {
+	mock *MockPriceObservationsRecorder
+}
+
+// NewMockPriceObservationsRecorder creates a new mock instance.
+func NewMockPriceObservationsRecorder(ctrl *gomock.Controller) *MockPriceObservationsRecorder {
+	mock := &MockPriceObservationsRecorder{ctrl: ctrl}
+	mock.recorder = &MockPriceObservationsRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceObservationsRecorder) EXPECT() *MockPriceObservationsRecorderMockRecorder {
+	return m.recorder
+}
+
+// RecordPriceObservations mocks base method.
+func (m *MockPriceObservationsRecorder) RecordPriceObservations(ctx context.Context, input *service.RecordPriceObservationsInput) (*service.RecordPriceObservationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPriceObservations", ctx, input)
+	ret0, _ := ret[0].(*service.RecordPriceObservationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordPriceObservations indicates an expected call of RecordPriceObservations.
+func (mr *MockPriceObservationsRecorderMockRecorder) RecordPriceObservations(ctx, input any) *MockPriceObservationsRecorderRecordPriceObservationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPriceObservations", reflect.TypeOf((*MockPriceObservationsRecorder)(nil).RecordPriceObservations), ctx, input)
+	return &MockPriceObservationsRecorderRecordPriceObservationsCall{Call: call}
+}
+
+// MockPriceObservationsRecorderRecordPriceObservationsCall wrap *gomock.Call
+type MockPriceObservationsRecorderRecordPriceObservationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPriceObservationsRecorderRecordPriceObservationsCall) Return(arg0 *service.RecordPriceObservationsOutput, arg1 error) *MockPriceObservationsRecorderRecordPriceObservationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPriceObservationsRecorderRecordPriceObservationsCall) Do(f func(context.Context, *service.RecordPriceObservationsInput) (*service.RecordPriceObservationsOutput, error)) *MockPriceObservationsRecorderRecordPriceObservationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPriceObservationsRecorderRecordPriceObservationsCall) DoAndReturn(f func(context.Context, *service.RecordPriceObservationsInput) (*service.RecordPriceObservationsOutput, error)) *MockPriceObservationsRecorderRecordPriceObservationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockPriceHistoryGetter is a mock of PriceHistoryGetter interface.
+type MockPriceHistoryGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceHistoryGetterMockRecorder
+	isgomock struct{}
+}
+
+// MockPriceHistoryGetterMockRecorder is the mock recorder for MockPriceHistoryGetter.
+type MockPriceHistoryGetterMockRecorder struct {
+	mock *MockPriceHistoryGetter
+}
+
+// NewMockPriceHistoryGetter creates a new mock instance.
+func NewMockPriceHistoryGetter(ctrl *gomock.Controller) *MockPriceHistoryGetter {
+	mock := &MockPriceHistoryGetter{ctrl: ctrl}
+	mock.recorder = &MockPriceHistoryGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceHistoryGetter) EXPECT() *MockPriceHistoryGetterMockRecorder {
+	return m.recorder
+}
+
+// GetPriceHistory mocks base method.
+func (m *MockPriceHistoryGetter) GetPriceHistory(ctx context.Context, input *service.GetPriceHistoryInput) (*service.GetPriceHistoryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceHistory", ctx, input)
+	ret0, _ := ret[0].(*service.GetPriceHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceHistory indicates an expected call of GetPriceHistory.
+func (mr *MockPriceHistoryGetterMockRecorder) GetPriceHistory(ctx, input any) *MockPriceHistoryGetterGetPriceHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistory", reflect.TypeOf((*MockPriceHistoryGetter)(nil).GetPriceHistory), ctx, input)
+	return &MockPriceHistoryGetterGetPriceHistoryCall{Call: call}
+}
+
+// MockPriceHistoryGetterGetPriceHistoryCall wrap *gomock.Call
+type MockPriceHistoryGetterGetPriceHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPriceHistoryGetterGetPriceHistoryCall) Return(arg0 *service.GetPriceHistoryOutput, arg1 error) *MockPriceHistoryGetterGetPriceHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPriceHistoryGetterGetPriceHistoryCall) Do(f func(context.Context, *service.GetPriceHistoryInput) (*service.GetPriceHistoryOutput, error)) *MockPriceHistoryGetterGetPriceHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPriceHistoryGetterGetPriceHistoryCall) DoAndReturn(f func(context.Context, *service.GetPriceHistoryInput) (*service.GetPriceHistoryOutput, error)) *MockPriceHistoryGetterGetPriceHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
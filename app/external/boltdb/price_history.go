@@ -0,0 +1,158 @@
+package boltdb
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	bolt "go.etcd.io/bbolt"
+)
+
+// A name of the bucket which stores the price history
+//
+// [FYI]
+// The bucket has a nested bucket for each app ID, and the nested bucket stores observations
+// keyed by the time when they were observed, so that they are sorted in chronological order
+var priceHistoryBucket = []byte("price_history")
+
+// A record of an observation of a video game price in the database
+//
+// [FYI]
+// The amounts are stored in the minor units of the currency to avoid rounding errors
+type priceObservationRecord struct {
+	ObservedAt      time.Time          `json:"observed_at"`
+	Currency        model.CurrencyCode `json:"currency"`
+	FinalPrice      uint64             `json:"final_price"`
+	RegularPrice    uint64             `json:"regular_price"`
+	DiscountPercent uint32             `json:"discount_percent"`
+}
+
+type priceHistoryRepository struct {
+	db *bolt.DB
+}
+
+var (
+	_ service.PriceObservationsRecorder = (*priceHistoryRepository)(nil)
+	_ service.PriceHistoryGetter        = (*priceHistoryRepository)(nil)
+)
+
+// Generate a new price history repository
+func NewPriceHistoryRepository(db *bolt.DB) *priceHistoryRepository {
+	return &priceHistoryRepository{
+		db: db,
+	}
+}
+
+// Record observations of video game prices in the price history
+//
+// [FYI]
+// All observations are recorded in a single transaction, so that none of them is recorded if one of them fails.
+// An observation at the same time as an existing one overwrites it
+func (r *priceHistoryRepository) RecordPriceObservations(
+	ctx context.Context,
+	input *service.RecordPriceObservationsInput,
+) (*service.RecordPriceObservationsOutput, error) {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists(priceHistoryBucket)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range input.PriceObservations {
+			bucket, err := root.CreateBucketIfNotExists(encodeAppID(v.AppID))
+			if err != nil {
+				return err
+			}
+
+			record, err := json.Marshal(&priceObservationRecord{
+				ObservedAt:      v.ObservedAt.UTC(),
+				Currency:        v.FinalPrice.Currency,
+				FinalPrice:      v.FinalPrice.Amount,
+				RegularPrice:    v.RegularPrice.Amount,
+				DiscountPercent: v.DiscountPercent,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := bucket.Put(encodeTime(v.ObservedAt), record); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to record observations of video game prices", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &service.RecordPriceObservationsOutput{}, nil
+}
+
+// Get the price history of a video game
+//
+// [FYI]
+// An empty history is returned if the video game has never been observed
+func (r *priceHistoryRepository) GetPriceHistory(
+	ctx context.Context,
+	input *service.GetPriceHistoryInput,
+) (*service.GetPriceHistoryOutput, error) {
+	observations := make([]*model.PriceObservation, 0)
+	err := r.db.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket(priceHistoryBucket)
+		if root == nil {
+			return nil
+		}
+
+		bucket := root.Bucket(encodeAppID(input.AppID))
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(_, v []byte) error {
+			record := &priceObservationRecord{}
+			if err := json.Unmarshal(v, record); err != nil {
+				return err
+			}
+
+			observations = append(observations, &model.PriceObservation{
+				AppID:      input.AppID,
+				ObservedAt: record.ObservedAt,
+				FinalPrice: model.Money{
+					Currency: record.Currency,
+					Amount:   record.FinalPrice,
+				},
+				RegularPrice: model.Money{
+					Currency: record.Currency,
+					Amount:   record.RegularPrice,
+				},
+				DiscountPercent: record.DiscountPercent,
+			})
+
+			return nil
+		})
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get the price history of a video game", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &service.GetPriceHistoryOutput{
+		PriceObservations: observations,
+	}, nil
+}
+
+// Encode an app ID into a key of the database in big-endian
+func encodeAppID(appID model.SteamAppID) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(appID))
+}
+
+// Encode time into a key of the database in big-endian to sort keys in chronological order
+func encodeTime(t time.Time) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(t.UnixNano()))
+}
@@ -0,0 +1,140 @@
+package boltdb
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPriceHistoryRepository(t *testing.T) {
+	t.Parallel()
+
+	// Generate an observation of a video game price
+	newObservation := func(appID model.SteamAppID, observedAt time.Time, finalPrice, regularPrice uint64, discountPercent uint32) *model.PriceObservation {
+		return &model.PriceObservation{
+			AppID:      appID,
+			ObservedAt: observedAt,
+			FinalPrice: model.Money{
+				Currency: "JPY",
+				Amount:   finalPrice,
+			},
+			RegularPrice: model.Money{
+				Currency: "JPY",
+				Amount:   regularPrice,
+			},
+			DiscountPercent: discountPercent,
+		}
+	}
+
+	t.Run("Positive case: Get the price history of a video game in chronological order", func(t *testing.T) {
+		t.Parallel()
+
+		// Open a database in a temporary directory
+		ctx := t.Context()
+		cfg := &config.StorageConfig{
+			StorageFilePath: filepath.Join(t.TempDir(), "nested", "test.db"),
+		}
+		db, cleanup, err := NewDB(ctx, cfg)
+		if err != nil {
+			t.Fatalf("failed to open a database: %v", err)
+		}
+		t.Cleanup(cleanup)
+
+		// Record observations over two runs in reverse chronological order
+		r := NewPriceHistoryRepository(db)
+		firstRun := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		secondRun := firstRun.Add(24 * time.Hour)
+		inputs := []*service.RecordPriceObservationsInput{
+			{
+				PriceObservations: []*model.PriceObservation{
+					newObservation(1, secondRun, 750, 1500, 50),
+					newObservation(2, secondRun, 3000, 3000, 0),
+				},
+			},
+			{
+				PriceObservations: []*model.PriceObservation{
+					newObservation(1, firstRun, 1500, 1500, 0),
+				},
+			},
+		}
+		for _, input := range inputs {
+			if _, err := r.RecordPriceObservations(ctx, input); err != nil {
+				t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+			}
+		}
+
+		// Execute the method to be tested
+		got, err := r.GetPriceHistory(ctx, &service.GetPriceHistoryInput{AppID: 1})
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetPriceHistoryOutput{
+			PriceObservations: []*model.PriceObservation{
+				newObservation(1, firstRun, 1500, 1500, 0),
+				newObservation(1, secondRun, 750, 1500, 50),
+			},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: Get an empty price history of a video game which has never been observed", func(t *testing.T) {
+		t.Parallel()
+
+		// Open a database in a temporary directory
+		ctx := t.Context()
+		cfg := &config.StorageConfig{
+			StorageFilePath: filepath.Join(t.TempDir(), "test.db"),
+		}
+		db, cleanup, err := NewDB(ctx, cfg)
+		if err != nil {
+			t.Fatalf("failed to open a database: %v", err)
+		}
+		t.Cleanup(cleanup)
+
+		// Execute the method to be tested
+		r := NewPriceHistoryRepository(db)
+		got, err := r.GetPriceHistory(ctx, &service.GetPriceHistoryInput{AppID: 1})
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetPriceHistoryOutput{
+			PriceObservations: []*model.PriceObservation{},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Negative case: Failed to record observations in a closed database", func(t *testing.T) {
+		t.Parallel()
+
+		// Open and close a database in a temporary directory
+		ctx := t.Context()
+		cfg := &config.StorageConfig{
+			StorageFilePath: filepath.Join(t.TempDir(), "test.db"),
+		}
+		db, cleanup, err := NewDB(ctx, cfg)
+		if err != nil {
+			t.Fatalf("failed to open a database: %v", err)
+		}
+		cleanup()
+
+		// Execute the method to be tested
+		r := NewPriceHistoryRepository(db)
+		input := &service.RecordPriceObservationsInput{
+			PriceObservations: []*model.PriceObservation{
+				newObservation(1, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), 1500, 1500, 0),
+			},
+		}
+		if _, err := r.RecordPriceObservations(ctx, input); err == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated in price_history.go", nil)
+		}
+	})
+}
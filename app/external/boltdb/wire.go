@@ -0,0 +1,18 @@
+package boltdb
+
+import (
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/google/wire"
+)
+
+// A wire set for the boltdb package
+var Set = wire.NewSet(
+	NewDB,
+	NewPriceHistoryRepository,
+	NewDigestRepository,
+	wire.Bind(new(service.PriceObservationsRecorder), new(*priceHistoryRepository)),
+	wire.Bind(new(service.PriceHistoryGetter), new(*priceHistoryRepository)),
+	wire.Bind(new(service.DealsQueuer), new(*digestRepository)),
+	wire.Bind(new(service.DigestQueueGetter), new(*digestRepository)),
+	wire.Bind(new(service.DigestQueueFlusher), new(*digestRepository)),
+)
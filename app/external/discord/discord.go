@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"slices"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
@@ -20,12 +21,111 @@ import (
 
 const discordAPIURL string = "https://discord.com/api"
 
+// A name of the notification channel of Discord
+const channelName string = "discord"
+
+// The maximum number of characters of a Discord message
+// ref. https://discord.com/developers/docs/resources/webhook#execute-webhook
+const maxContentLength int = 2000
+
+// A body of a Discord message
+type messageBody struct {
+	Content string `json:"content"`
+}
+
+// A splitter of lines into multiple Discord messages
+//
+// [FYI]
+// The lines are joined with "\n" in a message, and a new message is started
+// if the next lines do not fit in the maximum number of characters of the current message
+type messageSplitter struct {
+	contentsList [][]string
+	contents     []string
+	length       int
+}
+
+// Add lines to the current message
+//
+// [FYI]
+// The lines are kept in the same message (e.g. a heading and its first video game) if they fit in a message,
+// and a line longer than the maximum number of characters by itself is truncated
+func (s *messageSplitter) add(lines ...string) {
+	length := -1
+	for i, v := range lines {
+		lines[i] = truncateContent(v)
+		length += utf8.RuneCountInString(lines[i]) + 1
+	}
+
+	if len(lines) > 1 && length > maxContentLength {
+		for _, v := range lines {
+			s.add(v)
+		}
+		return
+	}
+
+	// Start a new message if the lines do not fit in the current message with a leading "\n"
+	if len(s.contents) > 0 && s.length+1+length > maxContentLength {
+		s.contentsList = append(s.contentsList, s.contents)
+		s.contents = nil
+		s.length = 0
+	}
+
+	if len(s.contents) > 0 {
+		length++
+	}
+	s.contents = append(s.contents, lines...)
+	s.length += length
+}
+
+// Get the lines divided into messages
+func (s *messageSplitter) build() [][]string {
+	if len(s.contents) > 0 {
+		s.contentsList = append(s.contentsList, s.contents)
+		s.contents = nil
+		s.length = 0
+	}
+
+	return s.contentsList
+}
+
+// Truncate a line to the maximum number of characters of a Discord message
+func truncateContent(line string) string {
+	if utf8.RuneCountInString(line) <= maxContentLength {
+		return line
+	}
+
+	return string([]rune(line)[:maxContentLength-1]) + "…"
+}
+
+// A notification channel of Discord
+type Channel service.NotificationChannel
+
+// Generate a new notification channel of Discord
+//
+// [FYI]
+// nil is returned if Discord is not configured
+func NewChannel(
+	cfg *config.DiscordConfig,
+	dNotifier *videoGamePricesOnDiscordNotifier,
+	eNotifier *errorOnDiscordNotifier,
+) *Channel {
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	return &Channel{
+		Name:          channelName,
+		DealNotifier:  dNotifier,
+		ErrorNotifier: eNotifier,
+	}
+}
+
 type videoGamePricesOnDiscordNotifier struct {
 	cfg        *config.DiscordConfig
 	httpClient service.HTTPClient
 }
 
-var _ service.VideoGamePricesOnDiscordNotifier = (*videoGamePricesOnDiscordNotifier)(nil)
+var _ service.DealNotifier = (*videoGamePricesOnDiscordNotifier)(nil)
 
 // Generate a new video game prices on Discord notifier
 func NewVideoGamePricesOnDiscordNotifier(
@@ -43,18 +143,30 @@ func NewVideoGamePricesOnDiscordNotifier(
 // [FYI]
 // The rate limiter is set to 5 requests per second and parallel processing is used
 // ref. https://discord.com/developers/docs/topics/rate-limits
-func (n *videoGamePricesOnDiscordNotifier) NotifyVideoGamePricesOnDiscord(
+func (n *videoGamePricesOnDiscordNotifier) NotifyDeals(
 	ctx context.Context,
-	input *service.NotifyVideoGamePricesOnDiscordInput,
-) (*service.NotifyVideoGamePricesOnDiscordOutput, error) {
+	input *service.NotifyDealsInput,
+) (*service.NotifyDealsOutput, error) {
+	// Build message bodies of recommended and skipped video games
+	//
+	// [FYI]
+	// The message of recommended video games is omitted if only skipped video games or the weekly digest are notified
+	contentsList := make([][]string, 0)
+	if len(input.Contents) > 0 || (len(input.SkippedContents) == 0 && input.Digest == nil) {
+		contentsList = append(contentsList, n.buildMessageBody(input.Contents)...)
+	}
+	contentsList = append(contentsList, n.buildBasketMessageBody(input.Basket)...)
+	contentsList = append(contentsList, n.buildDigestMessageBody(input.Digest)...)
+	contentsList = append(contentsList, n.buildSkippedMessageBody(input.SkippedContents)...)
+
 	limiter := rate.NewLimiter(5, 1)
-	for _, v := range n.buildMessageBody(input.DiscordContents) {
+	for _, v := range contentsList {
 		if err := limiter.Wait(ctx); err != nil {
 			slog.ErrorContext(ctx, "failed to wait for the rate limiter", slog.Any("error", err))
 			return nil, err
 		}
 
-		body := &model.DiscordMessageBody{
+		body := &messageBody{
 			Content: strings.Join(v, "\n"),
 		}
 
@@ -63,12 +175,12 @@ func (n *videoGamePricesOnDiscordNotifier) NotifyVideoGamePricesOnDiscord(
 		}
 	}
 
-	return &service.NotifyVideoGamePricesOnDiscordOutput{}, nil
+	return &service.NotifyDealsOutput{}, nil
 }
 
 func (n *videoGamePricesOnDiscordNotifier) notifyVideoGamePricesOnDiscord(
 	ctx context.Context,
-	body *model.DiscordMessageBody,
+	body *messageBody,
 ) error {
 	reqURL, err := url.JoinPath(discordAPIURL, "webhooks", n.cfg.DiscordWebhookID, n.cfg.DiscordWebhookToken)
 	if err != nil {
@@ -107,41 +219,200 @@ func (n *videoGamePricesOnDiscordNotifier) notifyVideoGamePricesOnDiscord(
 
 // Build a message body of a Discord message
 func (n *videoGamePricesOnDiscordNotifier) buildMessageBody(
-	discordContents map[model.SteamAppID]*model.DiscordContent,
+	dealContents map[model.SteamAppID]*model.DealContent,
 ) [][]string {
-	contents := make(map[string]string, len(discordContents))
-	for _, v := range discordContents {
-		content := fmt.Sprintf(
-			"- Title: **%s**  |  Current Price: **%d (JPY)**  |  Lowest Price: **%d (JPY)**",
-			v.Title,
-			v.CurrentPrice,
-			v.LowestPrice,
-		)
-		contents[v.Title] = content
+	// Sort the contents by the priority on the Steam wishlist in ascending order
+	sortedDealContents := make([]*model.DealContent, 0, len(dealContents))
+	for _, appID := range model.SortContentAppIDs(dealContents) {
+		sortedDealContents = append(sortedDealContents, dealContents[appID])
 	}
 
-	// Sort the contents by a video game title in ascending order
+	// Divide the contents into multiple messages
 	//
 	// [FYI]
 	// The Discord message has a limitation of 2000 characters
-	// Therefore, the contents are divided into multiple messages by 10 video games
-	sortedContents := make([]string, 0, 10)
-	contentsList := make([][]string, 0, len(contents))
-	sortedContents = append(sortedContents, "## The recommended video games to buy now are as follows:")
-	var count uint8
-	for _, k := range slices.Sorted(maps.Keys(contents)) {
-		if count == 10 {
-			contentsList = append(contentsList, sortedContents)
-			sortedContents = nil
-			count = 0
+	// Therefore, the contents are divided into multiple messages by their length.
+	// The contents are grouped into sections by their deal classes, so that new lows stand out from routine repeats
+	splitter := &messageSplitter{}
+	splitter.add("## The recommended video games to buy now are as follows:")
+	for _, class := range model.DealClasses {
+		heading := false
+		for _, v := range sortedDealContents {
+			if v.DealClass != class {
+				continue
+			}
+
+			if !heading {
+				splitter.add("### "+class.Heading(), buildContent(v))
+				heading = true
+				continue
+			}
+
+			splitter.add(buildContent(v))
+		}
+	}
+
+	return splitter.build()
+}
+
+// Build a line of a video game in a Discord message
+//
+// [FYI]
+// The lowest price is shown as "-" if it is not set
+func buildContent(v *model.DealContent) string {
+	lowestPrice := "-"
+	if v.LowestPrice != nil {
+		lowestPrice = fmt.Sprintf("%s (%s)", v.LowestPrice, v.LowestPrice.Currency)
+	}
+
+	content := fmt.Sprintf(
+		"- Title: **%s**  |  Current Price: **%s (%s)**  |  Lowest Price: **%s**",
+		v.Title,
+		v.CurrentPrice,
+		v.CurrentPrice.Currency,
+		lowestPrice,
+	)
+
+	// Add the discount to the content if the video game is on sale
+	// e.g. "-75% (¥1,000 → ¥250)"
+	if v.DiscountPercent > 0 {
+		content += fmt.Sprintf(
+			"  |  Discount: **-%d%% (%s → %s)**",
+			v.DiscountPercent,
+			v.RegularPrice.Format(),
+			v.CurrentPrice.Format(),
+		)
+	}
+
+	// Add the rules which triggered the notification
+	// e.g. "Lowest Price, Target Price"
+	if len(v.TriggeredRules) > 0 {
+		rules := make([]string, 0, len(v.TriggeredRules))
+		for _, r := range v.TriggeredRules {
+			rules = append(rules, string(r))
 		}
+		content += fmt.Sprintf("  |  Triggered by: **%s**", strings.Join(rules, ", "))
+	}
+
+	return content
+}
+
+// Build a message body of a recommended basket of deals within the monthly budget
+//
+// [FYI]
+// The basket is shown in a separate message after the recommended video games,
+// and the items are divided into multiple messages by their length as well
+func (n *videoGamePricesOnDiscordNotifier) buildBasketMessageBody(basket *model.Basket) [][]string {
+	if basket == nil {
+		return nil
+	}
+
+	splitter := &messageSplitter{}
+	splitter.add(
+		"## Recommended basket",
+		fmt.Sprintf(
+			"Monthly Budget: **%s**  |  Spent This Month: **%s**  |  Available: **%s**",
+			basket.Budget.Format(),
+			basket.Spent.Format(),
+			basket.Available().Format(),
+		),
+	)
+	if len(basket.Items) == 0 {
+		splitter.add("- No deals fit in the available budget")
+	}
+
+	for _, v := range basket.Items {
+		content := fmt.Sprintf("- Title: **%s**  |  Price: **%s**", v.Title, v.Price.Format())
+		if v.Priority > 0 {
+			content += fmt.Sprintf("  |  Priority: **%d**", v.Priority)
+		}
+		splitter.add(content)
+	}
+
+	if len(basket.Items) > 0 {
+		splitter.add(
+			fmt.Sprintf("Total: **%s**  |  Left After Buying: **%s**", basket.Total.Format(), basket.Left().Format()),
+		)
+	}
+
+	return splitter.build()
+}
 
-		sortedContents = append(sortedContents, contents[k])
-		count++
+// Build a message body of the weekly digest
+//
+// [FYI]
+// The digest lists the deals found since the last digest, and then video games currently discounted
+// with the biggest discounts first. Each list is divided into multiple messages by its length as well
+func (n *videoGamePricesOnDiscordNotifier) buildDigestMessageBody(digest *model.Digest) [][]string {
+	if digest == nil {
+		return nil
+	}
+
+	splitter := &messageSplitter{}
+	splitter.add("## Weekly digest", "### Deals since the last digest")
+	if len(digest.QueuedDeals) == 0 {
+		splitter.add("- No deals were found since the last digest")
+	}
+
+	for _, v := range digest.QueuedDeals {
+		splitter.add(buildContent(v.Content))
+	}
+
+	splitter.add("### Currently discounted video games")
+	if len(digest.DiscountedGames) == 0 {
+		splitter.add("- No video games on the wishlist are discounted now")
+	}
+
+	for _, v := range digest.DiscountedGames {
+		splitter.add(
+			fmt.Sprintf(
+				"- Title: **%s**  |  Discount: **-%d%% (%s → %s)**  |  Savings: **%s**",
+				v.Title,
+				v.DiscountPercent,
+				v.RegularPrice.Format(),
+				v.CurrentPrice.Format(),
+				v.Savings().Format(),
+			),
+		)
+	}
+
+	if digest.TotalSavings != nil {
+		splitter.add(
+			fmt.Sprintf(
+				"Discounted Video Games: **%d**  |  Total Savings: **%s**",
+				len(digest.DiscountedGames),
+				digest.TotalSavings.Format(),
+			),
+		)
+	}
+
+	return splitter.build()
+}
+
+// Build a message body of video games skipped because they cannot be retrieved from the Steam Store
+func (n *videoGamePricesOnDiscordNotifier) buildSkippedMessageBody(
+	discordSkippedContents map[model.SteamAppID]*model.SkippedContent,
+) [][]string {
+	if len(discordSkippedContents) == 0 {
+		return nil
+	}
+
+	// Sort the contents by an app ID in ascending order
+	//
+	// [FYI]
+	// The contents are divided into multiple messages by their length as well as recommended video games
+	splitter := &messageSplitter{}
+	splitter.add("## The following video games were skipped:")
+	for _, k := range slices.Sorted(maps.Keys(discordSkippedContents)) {
+		v := discordSkippedContents[k]
+		content := fmt.Sprintf("- App ID: **%d**  |  Reason: %s", v.AppID, v.Reason)
+		if v.Title != "" {
+			content = fmt.Sprintf("- Title: **%s** (App ID: %d)  |  Reason: %s", v.Title, v.AppID, v.Reason)
+		}
+		splitter.add(content)
 	}
-	contentsList = append(contentsList, sortedContents)
 
-	return contentsList
+	return splitter.build()
 }
 
 type errorOnDiscordNotifier struct {
@@ -149,7 +420,7 @@ type errorOnDiscordNotifier struct {
 	httpClient service.HTTPClient
 }
 
-var _ service.ErrorOnDiscordNotifier = (*errorOnDiscordNotifier)(nil)
+var _ service.ErrorNotifier = (*errorOnDiscordNotifier)(nil)
 
 // Generate a new error on Discord notifier
 func NewErrorOnDiscordNotifier(
@@ -163,10 +434,10 @@ func NewErrorOnDiscordNotifier(
 }
 
 // Notify an error on Discord
-func (n *errorOnDiscordNotifier) NotifyErrorOnDiscord(
+func (n *errorOnDiscordNotifier) NotifyError(
 	ctx context.Context,
-	input *service.NotifyErrorOnDiscordInput,
-) (*service.NotifyErrorOnDiscordOutput, error) {
+	input *service.NotifyErrorInput,
+) (*service.NotifyErrorOutput, error) {
 	reqURL, err := url.JoinPath(discordAPIURL, "webhooks", n.cfg.DiscordWebhookID, n.cfg.DiscordWebhookToken)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to build a Discord API URL", slog.Any("error", err))
@@ -174,8 +445,8 @@ func (n *errorOnDiscordNotifier) NotifyErrorOnDiscord(
 	}
 
 	// Build a request body of a Discord message
-	body := &model.DiscordMessageBody{
-		Content: fmt.Sprintf("## An error occurred:\n- %s", input.GeneratedError.Error()),
+	body := &messageBody{
+		Content: truncateContent(fmt.Sprintf("## An error occurred:\n- %s", input.GeneratedError.Error())),
 	}
 	reqJSON, err := json.Marshal(body)
 	if err != nil {
@@ -203,5 +474,5 @@ func (n *errorOnDiscordNotifier) NotifyErrorOnDiscord(
 		return nil, errUnexpectedStatusCode
 	}
 
-	return &service.NotifyErrorOnDiscordOutput{}, nil
+	return &service.NotifyErrorOutput{}, nil
 }
@@ -1,9 +1,14 @@
 package discord
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	httpclient "github.com/TsubasaBneAus/steam_game_price_notifier/app/external/httpclient/mock"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
@@ -45,16 +50,16 @@ func TestNotifyVideoGamePricesOnDiscord(t *testing.T) {
 			DiscordWebhookToken: "dummy_discord_webhook_token",
 		}
 		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
-		input := &service.NotifyVideoGamePricesOnDiscordInput{
-			DiscordContents: map[model.SteamAppID]*model.DiscordContent{
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
 				1: {
 					Title:        "dummy_title",
-					CurrentPrice: 1000,
-					LowestPrice:  1500,
+					CurrentPrice: model.Money{Currency: "JPY", Amount: 1000},
+					LowestPrice:  &model.Money{Currency: "JPY", Amount: 1500},
 				},
 			},
 		}
-		if _, err := n.NotifyVideoGamePricesOnDiscord(ctx, input); err != nil {
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
 			t.Errorf("\ngot: %v\nwant: %v", err, nil)
 		}
 	})
@@ -88,10 +93,598 @@ func TestNotifyVideoGamePricesOnDiscord(t *testing.T) {
 			DiscordWebhookToken: "dummy_discord_webhook_token",
 		}
 		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
-		input := &service.NotifyVideoGamePricesOnDiscordInput{
-			DiscordContents: map[model.SteamAppID]*model.DiscordContent{},
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
 		}
-		if _, err := n.NotifyVideoGamePricesOnDiscord(ctx, input); err != nil {
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: Video games are sorted by the priority on the Steam wishlist", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &messageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				got := body.Content
+				want := "## The recommended video games to buy now are as follows:\n" +
+					"### New all-time lows\n" +
+					"- Title: **C**  |  Current Price: **1000 (JPY)**  |  Lowest Price: **1500 (JPY)**\n" +
+					"- Title: **B**  |  Current Price: **1000 (JPY)**  |  Lowest Price: **1500 (JPY)**\n" +
+					"- Title: **A**  |  Current Price: **1000 (JPY)**  |  Lowest Price: **1500 (JPY)**\n" +
+					"- Title: **D**  |  Current Price: **1000 (JPY)**  |  Lowest Price: **1500 (JPY)**"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusNoContent,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.DiscordConfig{
+			DiscordWebhookID:    "dummy_discord_webhook_id",
+			DiscordWebhookToken: "dummy_discord_webhook_token",
+		}
+		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
+		price := model.Money{Currency: "JPY", Amount: 1000}
+		lowestPrice := model.Money{Currency: "JPY", Amount: 1500}
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				1: {Title: "A", Priority: 0, CurrentPrice: price, LowestPrice: &lowestPrice},
+				2: {Title: "B", Priority: 2, CurrentPrice: price, LowestPrice: &lowestPrice},
+				3: {Title: "C", Priority: 1, CurrentPrice: price, LowestPrice: &lowestPrice},
+				4: {Title: "D", Priority: 0, CurrentPrice: price, LowestPrice: &lowestPrice},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: Video games are grouped into sections by their deal classes", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &messageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				got := body.Content
+				want := "## The recommended video games to buy now are as follows:\n" +
+					"### New all-time lows\n" +
+					"- Title: **B**  |  Current Price: **900 (JPY)**  |  Lowest Price: **1000 (JPY)**\n" +
+					"### Matching the lowest prices\n" +
+					"- Title: **A**  |  Current Price: **1000 (JPY)**  |  Lowest Price: **1000 (JPY)**\n" +
+					"- Title: **C**  |  Current Price: **1000 (JPY)**  |  Lowest Price: **1000 (JPY)**\n" +
+					"### Close to the lowest prices\n" +
+					"- Title: **D**  |  Current Price: **1050 (JPY)**  |  Lowest Price: **1000 (JPY)**"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusNoContent,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.DiscordConfig{
+			DiscordWebhookID:    "dummy_discord_webhook_id",
+			DiscordWebhookToken: "dummy_discord_webhook_token",
+		}
+		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
+		lowestPrice := model.Money{Currency: "JPY", Amount: 1000}
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				1: {
+					Title:        "A",
+					Priority:     1,
+					CurrentPrice: model.Money{Currency: "JPY", Amount: 1000},
+					LowestPrice:  &lowestPrice,
+					DealClass:    model.DealClassMatchesLow,
+				},
+				2: {
+					Title:        "B",
+					Priority:     2,
+					CurrentPrice: model.Money{Currency: "JPY", Amount: 900},
+					LowestPrice:  &lowestPrice,
+					DealClass:    model.DealClassNewLow,
+				},
+				3: {
+					Title:        "C",
+					Priority:     3,
+					CurrentPrice: model.Money{Currency: "JPY", Amount: 1000},
+					LowestPrice:  &lowestPrice,
+					DealClass:    model.DealClassMatchesLow,
+				},
+				4: {
+					Title:        "D",
+					Priority:     4,
+					CurrentPrice: model.Money{Currency: "JPY", Amount: 1050},
+					LowestPrice:  &lowestPrice,
+					DealClass:    model.DealClassNearLow,
+				},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: A video game is notified with the rules which triggered it", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &messageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				got := body.Content
+				want := "## The recommended video games to buy now are as follows:\n" +
+					"### Matching your own rules\n" +
+					"- Title: **dummy_title**  |  Current Price: **500 (JPY)**  |  Lowest Price: **-**" +
+					"  |  Discount: **-50% (¥1,000 → ¥500)**  |  Triggered by: **Target Price, Min Discount %**"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusNoContent,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.DiscordConfig{
+			DiscordWebhookID:    "dummy_discord_webhook_id",
+			DiscordWebhookToken: "dummy_discord_webhook_token",
+		}
+		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				1: {
+					Title:           "dummy_title",
+					CurrentPrice:    model.Money{Currency: "JPY", Amount: 500},
+					RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+					DiscountPercent: 50,
+					DealClass:       model.DealClassOtherRules,
+					TriggeredRules:  []model.DealRule{model.DealRuleTargetPrice, model.DealRuleMinDiscount},
+				},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// Each video game has a long title and long rule expressions, so only a few video games fit in a message
+	t.Run("Positive case: Video games with long titles and rules are divided into messages by their length", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		gots := make([]string, 0)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &messageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				if got := utf8.RuneCountInString(body.Content); got > maxContentLength {
+					t.Errorf("\ngot: %v\nwant: less than or equal to %v", got, maxContentLength)
+				}
+				gots = append(gots, body.Content)
+
+				return &http.Response{
+					StatusCode: http.StatusNoContent,
+					Body:       http.NoBody,
+				}, nil
+			}).
+			MinTimes(2)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.DiscordConfig{
+			DiscordWebhookID:    "dummy_discord_webhook_id",
+			DiscordWebhookToken: "dummy_discord_webhook_token",
+		}
+		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
+		contents := make(map[model.SteamAppID]*model.DealContent, 10)
+		for i := range 10 {
+			contents[model.SteamAppID(i+1)] = &model.DealContent{
+				Title:           fmt.Sprintf("%02d: %s", i+1, strings.Repeat("ゲーム", 60)),
+				Priority:        uint32(i + 1),
+				CurrentPrice:    model.Money{Currency: "JPY", Amount: 500},
+				RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+				DiscountPercent: 50,
+				DealClass:       model.DealClassOtherRules,
+				TriggeredRules: []model.DealRule{
+					"discount >= 50 && price <= lowest * 1.1 && priority <= 10 && review_score >= 90",
+					"price <= regular_price * 0.5 && (target == 0 || price <= target) && min_discount <= discount",
+				},
+			}
+		}
+		input := &service.NotifyDealsInput{
+			Contents: contents,
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+
+		// All video games are notified once in the order of their priorities
+		got := strings.Join(gots, "\n")
+		for i := range 10 {
+			title := fmt.Sprintf("%02d: ", i+1)
+			if count := strings.Count(got, "- Title: **"+title); count != 1 {
+				t.Errorf("\ngot: %v\nwant: %v", count, 1)
+			}
+		}
+		if !strings.HasPrefix(gots[0], "## The recommended video games to buy now are as follows:\n### Matching your own rules\n") {
+			t.Errorf("\ngot: %v\nwant: the heading and the section of the first video game", gots[0])
+		}
+	})
+
+	t.Run("Positive case: A line longer than the limitation of Discord is truncated", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		gots := make([]string, 0)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &messageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				gots = append(gots, body.Content)
+
+				return &http.Response{
+					StatusCode: http.StatusNoContent,
+					Body:       http.NoBody,
+				}, nil
+			}).
+			Times(2)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.DiscordConfig{
+			DiscordWebhookID:    "dummy_discord_webhook_id",
+			DiscordWebhookToken: "dummy_discord_webhook_token",
+		}
+		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				1: {
+					Title:        strings.Repeat("a", 3000),
+					CurrentPrice: model.Money{Currency: "JPY", Amount: 1000},
+					LowestPrice:  &model.Money{Currency: "JPY", Amount: 1500},
+				},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+
+		// The headings are sent in a message, and the truncated line is sent in the next message
+		want := []string{
+			"## The recommended video games to buy now are as follows:",
+			"### New all-time lows",
+		}
+		if diff := cmp.Diff(gots[0], strings.Join(want, "\n")); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+		if got := utf8.RuneCountInString(gots[1]); got != maxContentLength {
+			t.Errorf("\ngot: %v\nwant: %v", got, maxContentLength)
+		}
+		if !strings.HasSuffix(gots[1], "…") {
+			t.Errorf("\ngot: %v\nwant: a line ending with …", gots[1])
+		}
+	})
+
+	t.Run("Positive case: A recommended basket is notified in a separate message", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		wants := []string{
+			"## The recommended video games to buy now are as follows:\n" +
+				"### Matching the lowest prices\n" +
+				"- Title: **A**  |  Current Price: **6000 (JPY)**  |  Lowest Price: **6000 (JPY)**\n" +
+				"- Title: **B**  |  Current Price: **3000 (JPY)**  |  Lowest Price: **3000 (JPY)**",
+			"## Recommended basket\n" +
+				"Monthly Budget: **¥10,000**  |  Spent This Month: **¥2,000**  |  Available: **¥8,000**\n" +
+				"- Title: **A**  |  Price: **¥6,000**  |  Priority: **1**\n" +
+				"Total: **¥6,000**  |  Left After Buying: **¥2,000**",
+		}
+		calls := make([]any, 0, len(wants))
+		for _, want := range wants {
+			calls = append(calls, m.
+				EXPECT().
+				Do(gomock.Any()).
+				DoAndReturn(func(req *http.Request) (*http.Response, error) {
+					body := &messageBody{}
+					if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+						t.Fatalf("failed to decode a request body: %v", err)
+					}
+
+					if diff := cmp.Diff(body.Content, want); diff != "" {
+						t.Errorf("got(-) want(+)\n%s", diff)
+					}
+
+					return &http.Response{
+						StatusCode: http.StatusNoContent,
+						Body:       http.NoBody,
+					}, nil
+				}))
+		}
+		gomock.InOrder(calls...)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.DiscordConfig{
+			DiscordWebhookID:    "dummy_discord_webhook_id",
+			DiscordWebhookToken: "dummy_discord_webhook_token",
+		}
+		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
+		item := &model.BasketItem{
+			AppID:    1,
+			Title:    "A",
+			Priority: 1,
+			Price:    model.Money{Currency: "JPY", Amount: 6000},
+		}
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				1: {
+					Title:        "A",
+					Priority:     1,
+					CurrentPrice: model.Money{Currency: "JPY", Amount: 6000},
+					LowestPrice:  &model.Money{Currency: "JPY", Amount: 6000},
+					DealClass:    model.DealClassMatchesLow,
+				},
+				2: {
+					Title:        "B",
+					Priority:     2,
+					CurrentPrice: model.Money{Currency: "JPY", Amount: 3000},
+					LowestPrice:  &model.Money{Currency: "JPY", Amount: 3000},
+					DealClass:    model.DealClassMatchesLow,
+				},
+			},
+			Basket: &model.Basket{
+				Items:  []*model.BasketItem{item},
+				Total:  model.Money{Currency: "JPY", Amount: 6000},
+				Budget: model.Money{Currency: "JPY", Amount: 10000},
+				Spent:  model.Money{Currency: "JPY", Amount: 2000},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: Only the weekly digest is notified", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &messageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				got := body.Content
+				want := "## Weekly digest\n" +
+					"### Deals since the last digest\n" +
+					"- Title: **A**  |  Current Price: **1000 (JPY)**  |  Lowest Price: **1000 (JPY)**" +
+					"  |  Discount: **-50% (¥2,000 → ¥1,000)**\n" +
+					"### Currently discounted video games\n" +
+					"- Title: **B**  |  Discount: **-75% (¥4,000 → ¥1,000)**  |  Savings: **¥3,000**\n" +
+					"- Title: **A**  |  Discount: **-50% (¥2,000 → ¥1,000)**  |  Savings: **¥1,000**\n" +
+					"Discounted Video Games: **2**  |  Total Savings: **¥4,000**"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusNoContent,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.DiscordConfig{
+			DiscordWebhookID:    "dummy_discord_webhook_id",
+			DiscordWebhookToken: "dummy_discord_webhook_token",
+		}
+		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Digest: model.NewDigest(
+				[]*model.QueuedDeal{
+					{
+						AppID:    1,
+						QueuedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						Content: &model.DealContent{
+							Title:           "A",
+							CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+							LowestPrice:     &model.Money{Currency: "JPY", Amount: 1000},
+							RegularPrice:    model.Money{Currency: "JPY", Amount: 2000},
+							DiscountPercent: 50,
+							DealClass:       model.DealClassMatchesLow,
+						},
+					},
+				},
+				[]*model.DiscountedGame{
+					{
+						AppID:           1,
+						Title:           "A",
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 2000},
+						DiscountPercent: 50,
+					},
+					{
+						AppID:           2,
+						Title:           "B",
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 4000},
+						DiscountPercent: 75,
+					},
+				},
+			),
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: A discounted video game is notified with its regular price", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &messageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				got := body.Content
+				want := "## The recommended video games to buy now are as follows:\n" +
+					"### New all-time lows\n" +
+					"- Title: **dummy_title**  |  Current Price: **250 (JPY)**  |  Lowest Price: **1500 (JPY)**" +
+					"  |  Discount: **-75% (¥1,000 → ¥250)**"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusNoContent,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.DiscordConfig{
+			DiscordWebhookID:    "dummy_discord_webhook_id",
+			DiscordWebhookToken: "dummy_discord_webhook_token",
+		}
+		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				1: {
+					Title:           "dummy_title",
+					CurrentPrice:    model.Money{Currency: "JPY", Amount: 250},
+					LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+					RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+					DiscountPercent: 75,
+				},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: Successfully notify skipped video games on Discord", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &messageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				got := body.Content
+				want := "## The following video games were skipped:\n" +
+					"- Title: **dummy_title** (App ID: 1)  |  Reason: video game delisted from the Steam Store\n" +
+					"- App ID: **2**  |  Reason: video game unavailable in the region"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusNoContent,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.DiscordConfig{
+			DiscordWebhookID:    "dummy_discord_webhook_id",
+			DiscordWebhookToken: "dummy_discord_webhook_token",
+		}
+		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+			SkippedContents: map[model.SteamAppID]*model.SkippedContent{
+				1: {
+					AppID:  1,
+					Title:  "dummy_title",
+					Reason: "video game delisted from the Steam Store",
+				},
+				2: {
+					AppID:  2,
+					Reason: "video game unavailable in the region",
+				},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
 			t.Errorf("\ngot: %v\nwant: %v", err, nil)
 		}
 	})
@@ -123,16 +716,77 @@ func TestNotifyVideoGamePricesOnDiscord(t *testing.T) {
 			DiscordWebhookToken: "dummy_discord_webhook_token",
 		}
 		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
-		input := &service.NotifyVideoGamePricesOnDiscordInput{
-			DiscordContents: map[model.SteamAppID]*model.DiscordContent{
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
 				1: {
 					Title:        "dummy_title",
-					CurrentPrice: 1000,
-					LowestPrice:  1500,
+					CurrentPrice: model.Money{Currency: "JPY", Amount: 1000},
+					LowestPrice:  &model.Money{Currency: "JPY", Amount: 1500},
+				},
+			},
+		}
+		if _, gotErr := n.NotifyDeals(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	// The messages sent before the failure are not withdrawn, so they are sent again when the deals are retried
+	t.Run("Negative case: A later message fails after an earlier message is sent", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		gomock.InOrder(
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				Return(&http.Response{
+					StatusCode: http.StatusNoContent,
+					Body:       http.NoBody,
+				}, nil),
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				Return(&http.Response{
+					StatusCode: http.StatusInternalServerError,
+					Body:       http.NoBody,
+				}, nil),
+		)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.DiscordConfig{
+			DiscordWebhookID:    "dummy_discord_webhook_id",
+			DiscordWebhookToken: "dummy_discord_webhook_token",
+		}
+		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				1: {
+					Title:        "A",
+					Priority:     1,
+					CurrentPrice: model.Money{Currency: "JPY", Amount: 6000},
+					LowestPrice:  &model.Money{Currency: "JPY", Amount: 6000},
+					DealClass:    model.DealClassMatchesLow,
 				},
 			},
+			Basket: &model.Basket{
+				Items: []*model.BasketItem{
+					{
+						AppID:    1,
+						Title:    "A",
+						Priority: 1,
+						Price:    model.Money{Currency: "JPY", Amount: 6000},
+					},
+				},
+				Total:  model.Money{Currency: "JPY", Amount: 6000},
+				Budget: model.Money{Currency: "JPY", Amount: 10000},
+				Spent:  model.Money{Currency: "JPY", Amount: 2000},
+			},
 		}
-		if _, gotErr := n.NotifyVideoGamePricesOnDiscord(ctx, input); !errors.Is(gotErr, wantErr) {
+		wantErr := errUnexpectedStatusCode
+		if _, gotErr := n.NotifyDeals(ctx, input); !errors.Is(gotErr, wantErr) {
 			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
 		}
 	})
@@ -166,17 +820,17 @@ func TestNotifyVideoGamePricesOnDiscord(t *testing.T) {
 			DiscordWebhookToken: "dummy_discord_webhook_token",
 		}
 		n := NewVideoGamePricesOnDiscordNotifier(cfg, m)
-		input := &service.NotifyVideoGamePricesOnDiscordInput{
-			DiscordContents: map[model.SteamAppID]*model.DiscordContent{
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
 				1: {
 					Title:        "dummy_title",
-					CurrentPrice: 1000,
-					LowestPrice:  1500,
+					CurrentPrice: model.Money{Currency: "JPY", Amount: 1000},
+					LowestPrice:  &model.Money{Currency: "JPY", Amount: 1500},
 				},
 			},
 		}
 		wantErr := errUnexpectedStatusCode
-		if _, gotErr := n.NotifyVideoGamePricesOnDiscord(ctx, input); !errors.Is(gotErr, wantErr) {
+		if _, gotErr := n.NotifyDeals(ctx, input); !errors.Is(gotErr, wantErr) {
 			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
 		}
 	})
@@ -214,10 +868,10 @@ func TestNotifyErrorOnDiscord(t *testing.T) {
 			DiscordWebhookToken: "dummy_discord_webhook_token",
 		}
 		n := NewErrorOnDiscordNotifier(cfg, m)
-		input := &service.NotifyErrorOnDiscordInput{
+		input := &service.NotifyErrorInput{
 			GeneratedError: errors.New("dummy_error"),
 		}
-		if _, err := n.NotifyErrorOnDiscord(ctx, input); err != nil {
+		if _, err := n.NotifyError(ctx, input); err != nil {
 			t.Errorf("\ngot: %v\nwant: %v", err, nil)
 		}
 	})
@@ -249,10 +903,10 @@ func TestNotifyErrorOnDiscord(t *testing.T) {
 			DiscordWebhookToken: "dummy_discord_webhook_token",
 		}
 		n := NewErrorOnDiscordNotifier(cfg, m)
-		input := &service.NotifyErrorOnDiscordInput{
+		input := &service.NotifyErrorInput{
 			GeneratedError: errors.New("dummy_error"),
 		}
-		if _, gotErr := n.NotifyErrorOnDiscord(ctx, input); !errors.Is(gotErr, wantErr) {
+		if _, gotErr := n.NotifyError(ctx, input); !errors.Is(gotErr, wantErr) {
 			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
 		}
 	})
@@ -286,11 +940,11 @@ func TestNotifyErrorOnDiscord(t *testing.T) {
 			DiscordWebhookToken: "dummy_discord_webhook_token",
 		}
 		n := NewErrorOnDiscordNotifier(cfg, m)
-		input := &service.NotifyErrorOnDiscordInput{
+		input := &service.NotifyErrorInput{
 			GeneratedError: errors.New("dummy_error"),
 		}
 		wantErr := errUnexpectedStatusCode
-		if _, gotErr := n.NotifyErrorOnDiscord(ctx, input); !errors.Is(gotErr, wantErr) {
+		if _, gotErr := n.NotifyError(ctx, input); !errors.Is(gotErr, wantErr) {
 			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
 		}
 	})
@@ -0,0 +1,227 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"log/slog"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+)
+
+// A name of the notification channel of emails
+const channelName string = "email"
+
+// A timeout of a conversation with the SMTP server
+const smtpTimeout time.Duration = 30 * time.Second
+
+// A notification channel of emails
+type Channel service.NotificationChannel
+
+// Generate a new notification channel of emails
+//
+// [FYI]
+// nil is returned if SMTP is not configured
+func NewChannel(
+	cfg *config.SMTPConfig,
+	dNotifier *videoGamePricesByEmailNotifier,
+	eNotifier *errorByEmailNotifier,
+) *Channel {
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	return &Channel{
+		Name:          channelName,
+		DealNotifier:  dNotifier,
+		ErrorNotifier: eNotifier,
+	}
+}
+
+type videoGamePricesByEmailNotifier struct {
+	cfg       *config.SMTPConfig
+	tlsConfig *tls.Config
+	now       func() time.Time
+}
+
+var _ service.DealNotifier = (*videoGamePricesByEmailNotifier)(nil)
+
+// Generate a new video game prices by email notifier
+func NewVideoGamePricesByEmailNotifier(cfg *config.SMTPConfig) *videoGamePricesByEmailNotifier {
+	return &videoGamePricesByEmailNotifier{
+		cfg:       cfg,
+		tlsConfig: newTLSConfig(cfg),
+		now:       time.Now,
+	}
+}
+
+// Notify video game prices by email
+//
+// [FYI]
+// All sections are sent in one multipart email with a plain text part and an HTML part
+func (n *videoGamePricesByEmailNotifier) NotifyDeals(
+	ctx context.Context,
+	input *service.NotifyDealsInput,
+) (*service.NotifyDealsOutput, error) {
+	sections := buildSections(input)
+	html, err := renderHTML(sections)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to render an HTML email", slog.Any("error", err))
+		return nil, err
+	}
+
+	msg, err := buildMessage(n.cfg, &message{
+		subject: buildSubject(input),
+		text:    renderText(sections),
+		html:    html,
+		date:    n.now(),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build an email", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := sendMail(ctx, n.cfg, n.tlsConfig, msg); err != nil {
+		return nil, err
+	}
+
+	return &service.NotifyDealsOutput{}, nil
+}
+
+type errorByEmailNotifier struct {
+	cfg       *config.SMTPConfig
+	tlsConfig *tls.Config
+	now       func() time.Time
+}
+
+var _ service.ErrorNotifier = (*errorByEmailNotifier)(nil)
+
+// Generate a new error by email notifier
+func NewErrorByEmailNotifier(cfg *config.SMTPConfig) *errorByEmailNotifier {
+	return &errorByEmailNotifier{
+		cfg:       cfg,
+		tlsConfig: newTLSConfig(cfg),
+		now:       time.Now,
+	}
+}
+
+// Notify an error by email
+func (n *errorByEmailNotifier) NotifyError(
+	ctx context.Context,
+	input *service.NotifyErrorInput,
+) (*service.NotifyErrorOutput, error) {
+	msg, err := buildMessage(n.cfg, &message{
+		subject: errorSubject,
+		text:    "An error occurred:\n" + input.GeneratedError.Error() + "\n",
+		date:    n.now(),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build an email", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := sendMail(ctx, n.cfg, n.tlsConfig, msg); err != nil {
+		return nil, err
+	}
+
+	return &service.NotifyErrorOutput{}, nil
+}
+
+// Generate a new TLS configuration to upgrade a connection with STARTTLS
+func newTLSConfig(cfg *config.SMTPConfig) *tls.Config {
+	return &tls.Config{
+		ServerName: cfg.SMTPHost,
+		MinVersion: tls.VersionTLS12,
+	}
+}
+
+// Send an email to the recipients over SMTP
+//
+// [FYI]
+// smtp.SendMail is not used because it upgrades a connection with STARTTLS only if the server supports it
+// and it cannot be cancelled with a context.
+// The connection is upgraded with STARTTLS if it is enabled, and then the client is authenticated if it is configured
+func sendMail(ctx context.Context, cfg *config.SMTPConfig, tlsConfig *tls.Config, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, smtpTimeout)
+	defer cancel()
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(cfg.SMTPHost, strconv.Itoa(int(cfg.SMTPPort))))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to connect to the SMTP server", slog.Any("error", err))
+		return err
+	}
+	defer conn.Close()
+
+	// Abort the conversation if the context is done
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		slog.ErrorContext(ctx, "failed to set a deadline of the SMTP connection", slog.Any("error", err))
+		return err
+	}
+
+	c, err := smtp.NewClient(conn, cfg.SMTPHost)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create an SMTP client", slog.Any("error", err))
+		return err
+	}
+	defer c.Close()
+
+	if cfg.SMTPStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			slog.ErrorContext(ctx, "failed to start TLS", slog.Any("error", errStartTLSNotSupported))
+			return errStartTLSNotSupported
+		}
+
+		if err := c.StartTLS(tlsConfig); err != nil {
+			slog.ErrorContext(ctx, "failed to start TLS", slog.Any("error", err))
+			return err
+		}
+	}
+
+	if cfg.AuthEnabled() {
+		if err := c.Auth(smtp.PlainAuth("", cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPHost)); err != nil {
+			slog.ErrorContext(ctx, "failed to authenticate with the SMTP server", slog.Any("error", err))
+			return err
+		}
+	}
+
+	if err := c.Mail(cfg.SMTPFrom); err != nil {
+		slog.ErrorContext(ctx, "failed to set the sender of an email", slog.Any("error", err))
+		return err
+	}
+
+	for _, to := range cfg.SMTPTo {
+		if err := c.Rcpt(to); err != nil {
+			slog.ErrorContext(ctx, "failed to set a recipient of an email", slog.String("to", to), slog.Any("error", err))
+			return err
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to start sending an email", slog.Any("error", err))
+		return err
+	}
+
+	if _, err := w.Write(msg); err != nil {
+		slog.ErrorContext(ctx, "failed to write an email", slog.Any("error", err))
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		slog.ErrorContext(ctx, "failed to send an email", slog.Any("error", err))
+		return err
+	}
+
+	if err := c.Quit(); err != nil {
+		slog.ErrorContext(ctx, "failed to quit the SMTP session", slog.Any("error", err))
+		return err
+	}
+
+	return nil
+}
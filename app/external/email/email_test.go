@@ -0,0 +1,499 @@
+package email
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNotifyVideoGamePricesByEmail(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("Positive case: Successfully notify video game prices by email", func(t *testing.T) {
+		t.Parallel()
+
+		// Start an SMTP server
+		s := newSMTPServer(t, nil, "", "")
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := s.config()
+		n := NewVideoGamePricesByEmailNotifier(cfg)
+		n.now = func() time.Time { return now }
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				1: {
+					Title:           "Tom & Jerry <Remastered>",
+					CurrentPrice:    model.Money{Currency: "JPY", Amount: 250},
+					RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+					LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+					DiscountPercent: 75,
+					DealClass:       model.DealClassNewLow,
+				},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+
+		// Check the received email
+		mails := s.receivedMails()
+		if len(mails) != 1 {
+			t.Fatalf("\ngot: %v\nwant: %v", len(mails), 1)
+		}
+		got := mails[0]
+		want := &receivedMail{
+			from: "notifier@example.com",
+			to:   []string{"alice@example.com", "bob@example.com"},
+			header: map[string]string{
+				"From":    "notifier@example.com",
+				"To":      "alice@example.com, bob@example.com",
+				"Subject": "[Steam Game Price Notifier] 1 deal to buy now",
+				"Date":    "Thu, 02 Jan 2025 03:04:05 +0000",
+			},
+			text: "## The recommended video games to buy now are as follows:\n" +
+				"\n" +
+				"### New all-time lows\n" +
+				"- Tom & Jerry <Remastered>\n" +
+				"  Current Price: ¥250  |  Lowest Price: ¥1,500  |  Discount: -75% (¥1,000 → ¥250)\n" +
+				"  https://store.steampowered.com/app/1/\n",
+		}
+		if diff := cmp.Diff(got, want, cmp.AllowUnexported(receivedMail{}), cmp.FilterPath(func(p cmp.Path) bool {
+			return p.Last().String() == ".html"
+		}, cmp.Ignore())); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+
+		wantHTML := `<li><a href="https://store.steampowered.com/app/1/">Tom &amp; Jerry &lt;Remastered&gt;</a><br>` +
+			`Current Price: ¥250  |  Lowest Price: ¥1,500  |  Discount: -75% (¥1,000 → ¥250)</li>`
+		if !strings.Contains(got.html, wantHTML) {
+			t.Errorf("\ngot: %v\nwant: an HTML part containing %v", got.html, wantHTML)
+		}
+	})
+
+	t.Run("Positive case: Successfully notify video game prices over STARTTLS with authentication", func(t *testing.T) {
+		t.Parallel()
+
+		// Start an SMTP server which supports STARTTLS and authentication
+		serverTLSConfig, clientTLSConfig := newTLSConfigs(t)
+		s := newSMTPServer(t, serverTLSConfig, "dummy_smtp_username", "dummy_smtp_password")
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := s.config()
+		cfg.SMTPStartTLS = true
+		cfg.SMTPUsername = "dummy_smtp_username"
+		cfg.SMTPPassword = "dummy_smtp_password"
+		n := NewVideoGamePricesByEmailNotifier(cfg)
+		n.tlsConfig = clientTLSConfig
+		input := &service.NotifyDealsInput{
+			SkippedContents: map[model.SteamAppID]*model.SkippedContent{
+				2: {
+					AppID:  2,
+					Reason: "dummy_reason",
+				},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+
+		// Check the received email
+		mails := s.receivedMails()
+		if len(mails) != 1 {
+			t.Fatalf("\ngot: %v\nwant: %v", len(mails), 1)
+		}
+		got := mails[0]
+		if !got.tls || got.username != "dummy_smtp_username" {
+			t.Errorf("\ngot: %v, %v\nwant: %v, %v", got.tls, got.username, true, "dummy_smtp_username")
+		}
+		if diff := cmp.Diff(got.header["Subject"], "[Steam Game Price Notifier] Skipped video games"); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+		wantText := "## The following video games were skipped:\n" +
+			"- App ID: 2\n" +
+			"  App ID: 2  |  Reason: dummy_reason\n" +
+			"  https://store.steampowered.com/app/2/\n"
+		if diff := cmp.Diff(got.text, wantText); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Negative case: The SMTP server does not support STARTTLS", func(t *testing.T) {
+		t.Parallel()
+
+		// Start an SMTP server
+		s := newSMTPServer(t, nil, "", "")
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := s.config()
+		cfg.SMTPStartTLS = true
+		n := NewVideoGamePricesByEmailNotifier(cfg)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+		}
+		wantErr := errStartTLSNotSupported
+		if _, gotErr := n.NotifyDeals(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+		if mails := s.receivedMails(); len(mails) != 0 {
+			t.Errorf("\ngot: %v\nwant: %v", len(mails), 0)
+		}
+	})
+
+	t.Run("Negative case: Failed to authenticate with the SMTP server", func(t *testing.T) {
+		t.Parallel()
+
+		// Start an SMTP server which supports authentication
+		s := newSMTPServer(t, nil, "dummy_smtp_username", "dummy_smtp_password")
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := s.config()
+		cfg.SMTPUsername = "dummy_smtp_username"
+		cfg.SMTPPassword = "wrong_smtp_password"
+		n := NewVideoGamePricesByEmailNotifier(cfg)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+		}
+		_, gotErr := n.NotifyDeals(ctx, input)
+		var tpErr *textproto.Error
+		if !errors.As(gotErr, &tpErr) || tpErr.Code != 535 {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, "535 Authentication failed")
+		}
+	})
+
+	t.Run("Negative case: Failed to connect to the SMTP server", func(t *testing.T) {
+		t.Parallel()
+
+		// Close an SMTP server before connecting to it
+		s := newSMTPServer(t, nil, "", "")
+		cfg := s.config()
+		if err := s.listener.Close(); err != nil {
+			t.Fatalf("failed to close an SMTP server: %v", err)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesByEmailNotifier(cfg)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated in email.go", err)
+		}
+	})
+}
+
+func TestNotifyErrorByEmail(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Positive case: Successfully notify an error by email", func(t *testing.T) {
+		t.Parallel()
+
+		// Start an SMTP server
+		s := newSMTPServer(t, nil, "", "")
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewErrorByEmailNotifier(s.config())
+		input := &service.NotifyErrorInput{
+			GeneratedError: errors.New("dummy_error"),
+		}
+		if _, err := n.NotifyError(ctx, input); err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+
+		// Check the received email
+		mails := s.receivedMails()
+		if len(mails) != 1 {
+			t.Fatalf("\ngot: %v\nwant: %v", len(mails), 1)
+		}
+		got := mails[0]
+		if diff := cmp.Diff(got.header["Subject"], "[Steam Game Price Notifier] An error occurred"); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+		if diff := cmp.Diff(got.text, "An error occurred:\ndummy_error\n"); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+		if got.html != "" {
+			t.Errorf("\ngot: %v\nwant: %v", got.html, "")
+		}
+	})
+}
+
+// A received email of the SMTP server for tests
+type receivedMail struct {
+	from     string
+	to       []string
+	header   map[string]string
+	text     string
+	html     string
+	tls      bool
+	username string
+}
+
+// An in-process SMTP server for tests
+//
+// [FYI]
+// STARTTLS is advertised if the TLS configuration is set,
+// and AUTH PLAIN is advertised if the username is set
+type smtpServer struct {
+	t         *testing.T
+	listener  net.Listener
+	tlsConfig *tls.Config
+	username  string
+	password  string
+	mu        sync.Mutex
+	mails     []*receivedMail
+}
+
+// Start a new SMTP server listening on a random port of localhost
+func newSMTPServer(t *testing.T, tlsConfig *tls.Config, username, password string) *smtpServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	s := &smtpServer{
+		t:         t,
+		listener:  listener,
+		tlsConfig: tlsConfig,
+		username:  username,
+		password:  password,
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go s.handle(conn)
+		}
+	}()
+
+	return s
+}
+
+// Generate configuration for SMTP to connect to the server without STARTTLS and authentication
+func (s *smtpServer) config() *config.SMTPConfig {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return &config.SMTPConfig{
+		SMTPHost: addr.IP.String(),
+		SMTPPort: uint16(addr.Port),
+		SMTPFrom: "notifier@example.com",
+		SMTPTo:   []string{"alice@example.com", "bob@example.com"},
+	}
+}
+
+// Get the emails received by the server
+func (s *smtpServer) receivedMails() []*receivedMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.mails
+}
+
+// Handle an SMTP session
+func (s *smtpServer) handle(conn net.Conn) {
+	defer func() { conn.Close() }()
+
+	tp := textproto.NewConn(conn)
+	current := &receivedMail{}
+	if err := tp.PrintfLine("220 localhost ESMTP"); err != nil {
+		return
+	}
+
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		var reply string
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			extensions := []string{"localhost"}
+			if s.tlsConfig != nil && !current.tls {
+				extensions = append(extensions, "STARTTLS")
+			}
+			if s.username != "" {
+				extensions = append(extensions, "AUTH PLAIN")
+			}
+			for _, e := range extensions[:len(extensions)-1] {
+				if err := tp.PrintfLine("250-%s", e); err != nil {
+					return
+				}
+			}
+			reply = "250 " + extensions[len(extensions)-1]
+		case "STARTTLS":
+			if err := tp.PrintfLine("220 Ready to start TLS"); err != nil {
+				return
+			}
+
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			tp = textproto.NewConn(tlsConn)
+			current.tls = true
+			continue
+		case "AUTH":
+			mechanism, initialResponse, _ := strings.Cut(arg, " ")
+			decoded, _ := base64.StdEncoding.DecodeString(initialResponse)
+			credentials := strings.Split(string(decoded), "\x00")
+			if mechanism != "PLAIN" || len(credentials) != 3 || credentials[1] != s.username || credentials[2] != s.password {
+				reply = "535 Authentication failed"
+				break
+			}
+			current.username = credentials[1]
+			reply = "235 Authentication succeeded"
+		case "MAIL":
+			current.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			reply = "250 OK"
+		case "RCPT":
+			current.to = append(current.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			reply = "250 OK"
+		case "DATA":
+			if err := tp.PrintfLine("354 Start mail input"); err != nil {
+				return
+			}
+
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.receive(current, data)
+			current = &receivedMail{tls: current.tls, username: current.username}
+			reply = "250 OK"
+		case "QUIT":
+			_ = tp.PrintfLine("221 Bye")
+			return
+		default:
+			reply = "502 Command not implemented"
+		}
+
+		if err := tp.PrintfLine("%s", reply); err != nil {
+			return
+		}
+	}
+}
+
+// Parse and record a received email
+func (s *smtpServer) receive(m *receivedMail, data []byte) {
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		s.t.Errorf("failed to read an email: %v", err)
+		return
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		s.t.Errorf("failed to decode a subject: %v", err)
+		return
+	}
+	m.header = map[string]string{
+		"From":    msg.Header.Get("From"),
+		"To":      msg.Header.Get("To"),
+		"Subject": subject,
+		"Date":    msg.Header.Get("Date"),
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		s.t.Errorf("failed to parse a content type: %v", err)
+		return
+	}
+	if mediaType != "multipart/alternative" {
+		text, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+		if err != nil {
+			s.t.Errorf("failed to read a body: %v", err)
+			return
+		}
+		m.text = string(text)
+	} else {
+		// [FYI]
+		// multipart.Reader decodes quoted-printable parts transparently
+		mr := multipart.NewReader(msg.Body, params["boundary"])
+		for {
+			p, err := mr.NextPart()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				s.t.Errorf("failed to read a part: %v", err)
+				return
+			}
+
+			content, err := io.ReadAll(p)
+			if err != nil {
+				s.t.Errorf("failed to read a part: %v", err)
+				return
+			}
+			switch {
+			case strings.HasPrefix(p.Header.Get("Content-Type"), "text/plain"):
+				m.text = string(content)
+			case strings.HasPrefix(p.Header.Get("Content-Type"), "text/html"):
+				m.html = string(content)
+			}
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mails = append(s.mails, m)
+}
+
+// Generate TLS configurations of the SMTP server and the client with a self-signed certificate of localhost
+func newTLSConfigs(t *testing.T) (*tls.Config, *tls.Config) {
+	t.Helper()
+
+	// [FYI]
+	// httptest issues a certificate valid for 127.0.0.1
+	ts := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(ts.Close)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ts.Certificate())
+
+	serverTLSConfig := &tls.Config{
+		Certificates: ts.TLS.Certificates,
+		MinVersion:   tls.VersionTLS12,
+	}
+	clientTLSConfig := &tls.Config{
+		ServerName: "127.0.0.1",
+		RootCAs:    pool,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	return serverTLSConfig, clientTLSConfig
+}
@@ -0,0 +1,5 @@
+package email
+
+import "errors"
+
+var errStartTLSNotSupported = errors.New("the SMTP server does not support STARTTLS")
@@ -0,0 +1,414 @@
+package email
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"maps"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+)
+
+// A prefix of subjects of emails
+const subjectPrefix string = "[Steam Game Price Notifier] "
+
+// A subject of an email of an error
+const errorSubject string = subjectPrefix + "An error occurred"
+
+// Levels of headings of sections
+const (
+	levelSection    uint8 = 2
+	levelSubsection uint8 = 3
+)
+
+// A template of the HTML part of an email
+//
+// [FYI]
+// html/template escapes titles of video games and reasons of skipped video games
+const htmlTemplate string = `<!DOCTYPE html>
+<html>
+<body>
+{{- range .}}
+{{if eq .Level 2}}<h2>{{.Heading}}</h2>{{else}}<h3>{{.Heading}}</h3>{{end}}
+{{- range .Notes}}
+<p>{{.}}</p>
+{{- end}}
+{{- if .Items}}
+<ul>
+{{- range .Items}}
+<li><a href="{{.URL}}">{{.Title}}</a><br>{{join .Details}}</li>
+{{- end}}
+</ul>
+{{- else if .Empty}}
+<p>{{.Empty}}</p>
+{{- end}}
+{{- with .Summary}}
+<p>{{.}}</p>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`
+
+var htmlTmpl = template.Must(template.New("email").Funcs(template.FuncMap{
+	"join": func(details []string) string { return strings.Join(details, "  |  ") },
+}).Parse(htmlTemplate))
+
+// A section of an email, which is rendered into both of the plain text part and the HTML part
+//
+// [FYI]
+// The notes are shown before the items and the summary is shown after them.
+// The empty text is shown instead of the items if there are no items
+type section struct {
+	Level   uint8
+	Heading string
+	Notes   []string
+	Items   []*item
+	Empty   string
+	Summary string
+}
+
+// A video game in a section of an email
+type item struct {
+	Title   string
+	URL     string
+	Details []string
+}
+
+// A message of an email before it is encoded
+//
+// [FYI]
+// The email is sent only with the plain text part if the HTML part is empty
+type message struct {
+	subject string
+	text    string
+	html    string
+	date    time.Time
+}
+
+// Build a subject of an email of deals of video games
+//
+// e.g. "[Steam Game Price Notifier] 3 deals to buy now"
+func buildSubject(input *service.NotifyDealsInput) string {
+	switch {
+	case len(input.Contents) == 1:
+		return subjectPrefix + "1 deal to buy now"
+	case len(input.Contents) > 1:
+		return subjectPrefix + fmt.Sprintf("%d deals to buy now", len(input.Contents))
+	case input.Digest != nil:
+		return subjectPrefix + "Weekly digest"
+	case len(input.SkippedContents) > 0:
+		return subjectPrefix + "Skipped video games"
+	default:
+		return subjectPrefix + "No deals to buy now"
+	}
+}
+
+// Build sections of an email in the same order as the Discord messages
+//
+// [FYI]
+// The section of recommended video games is omitted if only skipped video games or the weekly digest are notified
+func buildSections(input *service.NotifyDealsInput) []*section {
+	sections := make([]*section, 0)
+	if len(input.Contents) > 0 || (len(input.SkippedContents) == 0 && input.Digest == nil) {
+		sections = append(sections, buildDealSections(input.Contents)...)
+	}
+	if input.Basket != nil {
+		sections = append(sections, buildBasketSection(input.Basket))
+	}
+	if input.Digest != nil {
+		sections = append(sections, buildDigestSections(input.Digest)...)
+	}
+	if len(input.SkippedContents) > 0 {
+		sections = append(sections, buildSkippedSection(input.SkippedContents))
+	}
+
+	return sections
+}
+
+// Build sections of recommended video games grouped by their deal classes
+func buildDealSections(contents map[model.SteamAppID]*model.DealContent) []*section {
+	sections := []*section{
+		{
+			Level:   levelSection,
+			Heading: "The recommended video games to buy now are as follows:",
+		},
+	}
+	if len(contents) == 0 {
+		sections[0].Empty = "No video games to buy now"
+		return sections
+	}
+
+	appIDs := model.SortContentAppIDs(contents)
+	for _, class := range model.DealClasses {
+		s := &section{
+			Level:   levelSubsection,
+			Heading: class.Heading(),
+		}
+		for _, appID := range appIDs {
+			if v := contents[appID]; v.DealClass == class {
+				s.Items = append(s.Items, buildItem(appID, v))
+			}
+		}
+		if len(s.Items) > 0 {
+			sections = append(sections, s)
+		}
+	}
+
+	return sections
+}
+
+// Build an item of a recommended video game
+//
+// [FYI]
+// The lowest price is shown as "-" if it is not set
+func buildItem(appID model.SteamAppID, v *model.DealContent) *item {
+	lowestPrice := "-"
+	if v.LowestPrice != nil {
+		lowestPrice = v.LowestPrice.Format()
+	}
+
+	details := []string{
+		"Current Price: " + v.CurrentPrice.Format(),
+		"Lowest Price: " + lowestPrice,
+	}
+	if v.DiscountPercent > 0 {
+		details = append(details, fmt.Sprintf(
+			"Discount: -%d%% (%s → %s)",
+			v.DiscountPercent,
+			v.RegularPrice.Format(),
+			v.CurrentPrice.Format(),
+		))
+	}
+	if len(v.TriggeredRules) > 0 {
+		rules := make([]string, 0, len(v.TriggeredRules))
+		for _, r := range v.TriggeredRules {
+			rules = append(rules, string(r))
+		}
+		details = append(details, "Triggered by: "+strings.Join(rules, ", "))
+	}
+
+	return &item{
+		Title:   v.Title,
+		URL:     appID.StoreURL(),
+		Details: details,
+	}
+}
+
+// Build a section of a recommended basket of deals within the monthly budget
+func buildBasketSection(basket *model.Basket) *section {
+	s := &section{
+		Level:   levelSection,
+		Heading: "Recommended basket",
+		Notes: []string{fmt.Sprintf(
+			"Monthly Budget: %s  |  Spent This Month: %s  |  Available: %s",
+			basket.Budget.Format(),
+			basket.Spent.Format(),
+			basket.Available().Format(),
+		)},
+		Empty: "No deals fit in the available budget",
+	}
+	if len(basket.Items) == 0 {
+		return s
+	}
+
+	for _, v := range basket.Items {
+		details := []string{"Price: " + v.Price.Format()}
+		if v.Priority > 0 {
+			details = append(details, fmt.Sprintf("Priority: %d", v.Priority))
+		}
+		s.Items = append(s.Items, &item{
+			Title:   v.Title,
+			URL:     v.AppID.StoreURL(),
+			Details: details,
+		})
+	}
+	s.Summary = fmt.Sprintf("Total: %s  |  Left After Buying: %s", basket.Total.Format(), basket.Left().Format())
+
+	return s
+}
+
+// Build sections of the weekly digest
+func buildDigestSections(digest *model.Digest) []*section {
+	queued := &section{
+		Level:   levelSubsection,
+		Heading: "Deals since the last digest",
+		Empty:   "No deals were found since the last digest",
+	}
+	for _, v := range digest.QueuedDeals {
+		queued.Items = append(queued.Items, buildItem(v.AppID, v.Content))
+	}
+
+	discounted := &section{
+		Level:   levelSubsection,
+		Heading: "Currently discounted video games",
+		Empty:   "No video games on the wishlist are discounted now",
+	}
+	for _, v := range digest.DiscountedGames {
+		discounted.Items = append(discounted.Items, &item{
+			Title: v.Title,
+			URL:   v.AppID.StoreURL(),
+			Details: []string{
+				fmt.Sprintf("Discount: -%d%% (%s → %s)", v.DiscountPercent, v.RegularPrice.Format(), v.CurrentPrice.Format()),
+				"Savings: " + v.Savings().Format(),
+			},
+		})
+	}
+	if digest.TotalSavings != nil {
+		discounted.Summary = fmt.Sprintf(
+			"Discounted Video Games: %d  |  Total Savings: %s",
+			len(digest.DiscountedGames),
+			digest.TotalSavings.Format(),
+		)
+	}
+
+	return []*section{
+		{
+			Level:   levelSection,
+			Heading: "Weekly digest",
+		},
+		queued,
+		discounted,
+	}
+}
+
+// Build a section of video games skipped because they cannot be retrieved from the Steam Store
+func buildSkippedSection(skippedContents map[model.SteamAppID]*model.SkippedContent) *section {
+	s := &section{
+		Level:   levelSection,
+		Heading: "The following video games were skipped:",
+	}
+	for _, k := range slices.Sorted(maps.Keys(skippedContents)) {
+		v := skippedContents[k]
+		title := v.Title
+		if title == "" {
+			title = fmt.Sprintf("App ID: %d", v.AppID)
+		}
+		s.Items = append(s.Items, &item{
+			Title:   title,
+			URL:     v.AppID.StoreURL(),
+			Details: []string{fmt.Sprintf("App ID: %d", v.AppID), "Reason: " + v.Reason},
+		})
+	}
+
+	return s
+}
+
+// Render sections into the plain text part of an email
+//
+// e.g. "## Heading\n- Title\n  Current Price: ¥250  |  Lowest Price: ¥1,500\n  https://store.steampowered.com/app/1/\n"
+func renderText(sections []*section) string {
+	var b strings.Builder
+	for i, s := range sections {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		b.WriteString(strings.Repeat("#", int(s.Level)) + " " + s.Heading + "\n")
+		for _, note := range s.Notes {
+			b.WriteString(note + "\n")
+		}
+		for _, v := range s.Items {
+			b.WriteString("- " + v.Title + "\n")
+			b.WriteString("  " + strings.Join(v.Details, "  |  ") + "\n")
+			b.WriteString("  " + v.URL + "\n")
+		}
+		if len(s.Items) == 0 && s.Empty != "" {
+			b.WriteString(s.Empty + "\n")
+		}
+		if s.Summary != "" {
+			b.WriteString(s.Summary + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// Render sections into the HTML part of an email
+func renderHTML(sections []*section) (string, error) {
+	var b strings.Builder
+	if err := htmlTmpl.Execute(&b, sections); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// Build an email in the format of RFC 5322
+//
+// [FYI]
+// The email is a multipart/alternative message of a plain text part and an HTML part,
+// and both parts are encoded with quoted-printable to send non-ASCII titles over 7-bit SMTP servers
+func buildMessage(cfg *config.SMTPConfig, m *message) ([]byte, error) {
+	var body bytes.Buffer
+	contentType := "text/plain; charset=UTF-8"
+	if m.html == "" {
+		if err := writeQuotedPrintable(&body, m.text); err != nil {
+			return nil, err
+		}
+	} else {
+		mw := multipart.NewWriter(&body)
+		contentType = mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": mw.Boundary()})
+		for _, part := range []struct {
+			contentType string
+			content     string
+		}{
+			{contentType: "text/plain; charset=UTF-8", content: m.text},
+			{contentType: "text/html; charset=UTF-8", content: m.html},
+		} {
+			w, err := mw.CreatePart(textproto.MIMEHeader{
+				"Content-Type":              {part.contentType},
+				"Content-Transfer-Encoding": {"quoted-printable"},
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if err := writeQuotedPrintable(w, part.content); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := mw.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", cfg.SMTPFrom)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(cfg.SMTPTo, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", m.subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", m.date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&b, "Content-Type: %s\r\n", contentType)
+	if m.html == "" {
+		b.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	}
+	b.WriteString("\r\n")
+	b.Write(body.Bytes())
+
+	return b.Bytes(), nil
+}
+
+// Write a content encoded with quoted-printable
+//
+// [FYI]
+// Line breaks of the content are converted into CRLF
+func writeQuotedPrintable(w io.Writer, content string) error {
+	qw := quotedprintable.NewWriter(w)
+	if _, err := qw.Write([]byte(content)); err != nil {
+		return err
+	}
+
+	return qw.Close()
+}
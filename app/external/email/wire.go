@@ -0,0 +1,10 @@
+package email
+
+import "github.com/google/wire"
+
+// A wire set for the email package
+var Set = wire.NewSet(
+	NewVideoGamePricesByEmailNotifier,
+	NewErrorByEmailNotifier,
+	NewChannel,
+)
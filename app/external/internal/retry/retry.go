@@ -0,0 +1,62 @@
+package retry
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// The maximum delay before retrying a request
+//
+// [FYI]
+// The delay is capped not to exceed the timeout of the Lambda function even if Retry-After is long
+const MaxDelay time.Duration = 30 * time.Second
+
+// Calculate a delay before retrying a request
+//
+// [FYI]
+// Retry-After is honoured if it is set, and it is either seconds or a HTTP date
+// ref. https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Retry-After
+// Otherwise, the delay is decided by exponential backoff with full jitter
+// ref. https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/
+func Delay(baseDelay time.Duration, attempt int, retryAfter string) time.Duration {
+	if retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, MaxDelay)
+		}
+
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return min(max(time.Until(date), 0), MaxDelay)
+		}
+	}
+
+	backoff := MaxDelay
+	if attempt < 30 {
+		backoff = min(baseDelay<<attempt, MaxDelay)
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	return rand.N(backoff + 1)
+}
+
+// Check whether a request should be retried with its status code
+func IsRetryableStatusCode(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// Sleep for a duration unless the context is done
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
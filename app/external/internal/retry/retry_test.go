@@ -0,0 +1,84 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDelay(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attempt    int
+		retryAfter string
+		wantMin    time.Duration
+		wantMax    time.Duration
+	}{
+		"Positive case: Retry-After in seconds is honoured": {
+			attempt:    0,
+			retryAfter: "2",
+			wantMin:    2 * time.Second,
+			wantMax:    2 * time.Second,
+		},
+		"Positive case: Retry-After is capped by the maximum delay": {
+			attempt:    0,
+			retryAfter: "3600",
+			wantMin:    MaxDelay,
+			wantMax:    MaxDelay,
+		},
+		"Positive case: Retry-After in the past is not waited": {
+			attempt:    0,
+			retryAfter: "Wed, 21 Oct 2015 07:28:00 GMT",
+			wantMin:    0,
+			wantMax:    0,
+		},
+		"Positive case: The backoff grows exponentially with jitter": {
+			attempt: 2,
+			wantMin: 0,
+			wantMax: 4 * time.Second,
+		},
+		"Positive case: The backoff is capped by the maximum delay": {
+			attempt: 10,
+			wantMin: 0,
+			wantMax: MaxDelay,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the function to be tested
+			got := Delay(time.Second, tc.attempt, tc.retryAfter)
+			if got < tc.wantMin || got > tc.wantMax {
+				t.Errorf("\ngot: %v\nwant: between %v and %v", got, tc.wantMin, tc.wantMax)
+			}
+		})
+	}
+}
+
+func TestSleep(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Positive case: Sleep for a duration", func(t *testing.T) {
+		t.Parallel()
+
+		// Execute the function to be tested
+		if err := Sleep(t.Context(), time.Millisecond); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Negative case: The context is done before the duration passes", func(t *testing.T) {
+		t.Parallel()
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		if err := Sleep(ctx, time.Hour); !errors.Is(err, context.Canceled) {
+			t.Errorf("\ngot: %v\nwant: %v", err, context.Canceled)
+		}
+	})
+}
@@ -0,0 +1,5 @@
+package isthereanydeal
+
+import "errors"
+
+var errUnexpectedStatusCode = errors.New("unexpected status code")
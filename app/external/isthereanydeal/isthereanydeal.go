@@ -0,0 +1,201 @@
+package isthereanydeal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+)
+
+// Paths of the IsThereAnyDeal API
+//
+// [FYI]
+// ref. https://docs.isthereanydeal.com/
+var (
+	lookupGameIDsPath = fmt.Sprintf("/lookup/id/shop/%d/v1", model.IsThereAnyDealSteamShopID)
+	storeLowsPath     = "/games/storelow/v2"
+)
+
+type historicalLowsGetter struct {
+	cfg        *config.IsThereAnyDealConfig
+	steamCfg   *config.SteamConfig
+	httpClient service.HTTPClient
+}
+
+var _ service.HistoricalLowsGetter = (*historicalLowsGetter)(nil)
+
+// Generate a new HistoricalLowsGetter
+func NewHistoricalLowsGetter(
+	cfg *config.IsThereAnyDealConfig,
+	steamCfg *config.SteamConfig,
+	httpClient service.HTTPClient,
+) *historicalLowsGetter {
+	return &historicalLowsGetter{
+		cfg:        cfg,
+		steamCfg:   steamCfg,
+		httpClient: httpClient,
+	}
+}
+
+// Get historical lows of video games on the Steam Store from IsThereAnyDeal
+//
+// [FYI]
+// The app IDs are looked up into IDs of IsThereAnyDeal first, and then the lowest prices on the Steam shop are retrieved
+// in the region of the Steam Store. No historical lows are returned without sending a request if the API key is not set
+func (g *historicalLowsGetter) GetHistoricalLows(
+	ctx context.Context,
+	input *service.GetHistoricalLowsInput,
+) (*service.GetHistoricalLowsOutput, error) {
+	historicalLows := make(map[model.SteamAppID]*model.Money, len(input.AppIDs))
+	if g.cfg.IsThereAnyDealAPIKey == "" {
+		slog.WarnContext(ctx, "skipped getting historical lows because the IsThereAnyDeal API key is not set")
+		return &service.GetHistoricalLowsOutput{HistoricalLows: historicalLows}, nil
+	}
+
+	if len(input.AppIDs) == 0 {
+		return &service.GetHistoricalLowsOutput{HistoricalLows: historicalLows}, nil
+	}
+
+	// Look up the app IDs into IDs of IsThereAnyDeal
+	gameIDs, appIDs, err := g.lookupGameIDs(ctx, input.AppIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to look up IDs of video games on IsThereAnyDeal", slog.Any("error", err))
+		return nil, err
+	}
+
+	if len(gameIDs) == 0 {
+		return &service.GetHistoricalLowsOutput{HistoricalLows: historicalLows}, nil
+	}
+
+	// Get the lowest prices of the video games on the Steam shop
+	storeLows := make([]*model.IsThereAnyDealStoreLow, 0, len(gameIDs))
+	q := url.Values{}
+	q.Set("country", strings.ToUpper(g.steamCfg.SteamCountryCode))
+	q.Set("shops", strconv.Itoa(model.IsThereAnyDealSteamShopID))
+	if err := g.post(ctx, storeLowsPath, q, gameIDs, &storeLows); err != nil {
+		slog.ErrorContext(ctx, "failed to get the lowest prices of video games on IsThereAnyDeal", slog.Any("error", err))
+		return nil, err
+	}
+
+	for _, v := range storeLows {
+		appID, ok := appIDs[v.ID]
+		if !ok {
+			continue
+		}
+
+		for _, low := range v.Lows {
+			if low.Shop == nil || low.Shop.ID != model.IsThereAnyDealSteamShopID || low.Price == nil {
+				continue
+			}
+
+			price, err := low.Price.ToMoney()
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to convert a historical low to Money", slog.Any("error", err))
+				return nil, err
+			}
+
+			historicalLows[appID] = price
+		}
+	}
+
+	return &service.GetHistoricalLowsOutput{
+		HistoricalLows: historicalLows,
+	}, nil
+}
+
+// Look up app IDs on the Steam Store into IDs of IsThereAnyDeal
+//
+// [FYI]
+// Video games which are not found on IsThereAnyDeal are excluded.
+// The IDs are returned in the order of the app IDs with a map to get the app ID of each ID
+func (g *historicalLowsGetter) lookupGameIDs(
+	ctx context.Context,
+	appIDs []model.SteamAppID,
+) ([]model.IsThereAnyDealGameID, map[model.IsThereAnyDealGameID]model.SteamAppID, error) {
+	shopIDs := make([]string, 0, len(appIDs))
+	for _, v := range appIDs {
+		shopIDs = append(shopIDs, shopID(v))
+	}
+
+	found := make(map[string]*model.IsThereAnyDealGameID, len(shopIDs))
+	if err := g.post(ctx, lookupGameIDsPath, url.Values{}, shopIDs, &found); err != nil {
+		slog.ErrorContext(ctx, "failed to look up IDs of video games on IsThereAnyDeal", slog.Any("error", err))
+		return nil, nil, err
+	}
+
+	gameIDs := make([]model.IsThereAnyDealGameID, 0, len(found))
+	convertedAppIDs := make(map[model.IsThereAnyDealGameID]model.SteamAppID, len(found))
+	for _, v := range appIDs {
+		gameID := found[shopID(v)]
+		if gameID == nil {
+			continue
+		}
+
+		gameIDs = append(gameIDs, *gameID)
+		convertedAppIDs[*gameID] = v
+	}
+
+	return gameIDs, convertedAppIDs, nil
+}
+
+// Send a POST request with a JSON body to the IsThereAnyDeal API and decode its JSON response
+func (g *historicalLowsGetter) post(ctx context.Context, path string, q url.Values, body, dst any) error {
+	reqURL, err := url.Parse(strings.TrimSuffix(g.cfg.IsThereAnyDealBaseURL, "/") + path)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build an IsThereAnyDeal API URL", slog.Any("error", err))
+		return err
+	}
+	q.Set("key", g.cfg.IsThereAnyDealAPIKey)
+	reqURL.RawQuery = q.Encode()
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to marshal an IsThereAnyDeal API request", slog.Any("error", err))
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL.String(), bytes.NewBuffer(b))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create an IsThereAnyDeal API request", slog.Any("error", err))
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := g.httpClient.Do(req)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to send an IsThereAnyDeal API request", slog.Any("error", err))
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		slog.ErrorContext(
+			ctx,
+			"unexpected status code in the IsThereAnyDeal API response",
+			slog.Any("status_code", res.StatusCode),
+		)
+		return errUnexpectedStatusCode
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(dst); err != nil {
+		slog.ErrorContext(ctx, "failed to unmarshal an IsThereAnyDeal API response", slog.Any("error", err))
+		return err
+	}
+
+	return nil
+}
+
+// Get an ID of a video game in the Steam shop on IsThereAnyDeal
+// e.g. 730 -> "app/730"
+func shopID(appID model.SteamAppID) string {
+	return "app/" + strconv.FormatUint(uint64(appID), 10)
+}
@@ -0,0 +1,178 @@
+package isthereanydeal
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/httpclient"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Start a local fake server of the IsThereAnyDeal API
+//
+// [FYI]
+// App 1 and 2 are found on IsThereAnyDeal, but only app 1 has a historical low on the Steam shop.
+// App 3 is not found on IsThereAnyDeal
+func newFakeServer(t *testing.T, storeLowsStatusCode int) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /lookup/id/shop/61/v1", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("key"), "dummy_isthereanydeal_api_key"; got != want {
+			t.Errorf("\ngot: %v\nwant: %v", got, want)
+		}
+
+		var shopIDs []string
+		if err := json.NewDecoder(r.Body).Decode(&shopIDs); err != nil {
+			t.Errorf("failed to decode a request body: %v", err)
+		}
+		if diff := cmp.Diff(shopIDs, []string{"app/1", "app/2", "app/3"}); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+
+		_, _ = w.Write([]byte(`{"app/1": "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa", "app/2": "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb", "app/3": null}`))
+	})
+	mux.HandleFunc("POST /games/storelow/v2", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if diff := cmp.Diff(
+			[]string{q.Get("key"), q.Get("country"), q.Get("shops")},
+			[]string{"dummy_isthereanydeal_api_key", "AU", "61"},
+		); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+
+		var gameIDs []string
+		if err := json.NewDecoder(r.Body).Decode(&gameIDs); err != nil {
+			t.Errorf("failed to decode a request body: %v", err)
+		}
+		want := []string{"aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa", "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"}
+		if diff := cmp.Diff(gameIDs, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+
+		w.WriteHeader(storeLowsStatusCode)
+		_, _ = w.Write([]byte(`[
+			{
+				"id": "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+				"lows": [
+					{
+						"shop": {"id": 61, "name": "Steam"},
+						"price": {"amount": 7.49, "amountInt": 749, "currency": "AUD"},
+						"regular": {"amount": 29.95, "amountInt": 2995, "currency": "AUD"},
+						"cut": 75,
+						"timestamp": "2024-06-27T17:00:00+00:00"
+					}
+				]
+			},
+			{
+				"id": "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+				"lows": []
+			}
+		]`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestGetHistoricalLows(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Positive case: Successfully get historical lows of video games", func(t *testing.T) {
+		t.Parallel()
+
+		// Start a local fake server
+		server := newFakeServer(t, http.StatusOK)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.IsThereAnyDealConfig{
+			IsThereAnyDealAPIKey:  "dummy_isthereanydeal_api_key",
+			IsThereAnyDealBaseURL: server.URL,
+		}
+		steamCfg := &config.SteamConfig{
+			SteamCountryCode: "au",
+		}
+		g := NewHistoricalLowsGetter(cfg, steamCfg, httpclient.NewHTTPClient())
+		input := &service.GetHistoricalLowsInput{
+			AppIDs: []model.SteamAppID{1, 2, 3},
+		}
+		got, err := g.GetHistoricalLows(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetHistoricalLowsOutput{
+			HistoricalLows: map[model.SteamAppID]*model.Money{
+				1: {
+					Currency: "AUD",
+					Amount:   749,
+				},
+			},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: No historical lows are returned without the API key", func(t *testing.T) {
+		t.Parallel()
+
+		// Execute the method to be tested
+		//
+		// [FYI]
+		// The HTTP client is nil because no request is sent
+		ctx := t.Context()
+		cfg := &config.IsThereAnyDealConfig{
+			IsThereAnyDealBaseURL: "https://api.isthereanydeal.com",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamCountryCode: "au",
+		}
+		g := NewHistoricalLowsGetter(cfg, steamCfg, nil)
+		input := &service.GetHistoricalLowsInput{
+			AppIDs: []model.SteamAppID{1, 2, 3},
+		}
+		got, err := g.GetHistoricalLows(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetHistoricalLowsOutput{
+			HistoricalLows: map[model.SteamAppID]*model.Money{},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Negative case: Unexpected status code in the IsThereAnyDeal API response", func(t *testing.T) {
+		t.Parallel()
+
+		// Start a local fake server
+		server := newFakeServer(t, http.StatusInternalServerError)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.IsThereAnyDealConfig{
+			IsThereAnyDealAPIKey:  "dummy_isthereanydeal_api_key",
+			IsThereAnyDealBaseURL: server.URL,
+		}
+		steamCfg := &config.SteamConfig{
+			SteamCountryCode: "au",
+		}
+		g := NewHistoricalLowsGetter(cfg, steamCfg, httpclient.NewHTTPClient())
+		input := &service.GetHistoricalLowsInput{
+			AppIDs: []model.SteamAppID{1, 2, 3},
+		}
+		if _, gotErr := g.GetHistoricalLows(ctx, input); !errors.Is(gotErr, errUnexpectedStatusCode) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errUnexpectedStatusCode)
+		}
+	})
+}
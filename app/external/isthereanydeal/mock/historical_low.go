@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./historical_low.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./historical_low.go -destination=../external/isthereanydeal/mock/historical_low.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	service "github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHistoricalLowsGetter is a mock of HistoricalLowsGetter interface.
+type MockHistoricalLowsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoricalLowsGetterMockRecorder
+	isgomock struct{}
+}
+
+// MockHistoricalLowsGetterMockRecorder is the mock recorder for MockHistoricalLowsGetter.
+type MockHistoricalLowsGetterMockRecorder struct {
+	mock *MockHistoricalLowsGetter
+}
+
+// NewMockHistoricalLowsGetter creates a new mock instance.
+func NewMockHistoricalLowsGetter(ctrl *gomock.Controller) *MockHistoricalLowsGetter {
+	mock := &MockHistoricalLowsGetter{ctrl: ctrl}
+	mock.recorder = &MockHistoricalLowsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoricalLowsGetter) EXPECT() *MockHistoricalLowsGetterMockRecorder {
+	return m.recorder
+}
+
+// GetHistoricalLows mocks base method.
+func (m *MockHistoricalLowsGetter) GetHistoricalLows(ctx context.Context, input *service.GetHistoricalLowsInput) (*service.GetHistoricalLowsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoricalLows", ctx, input)
+	ret0, _ := ret[0].(*service.GetHistoricalLowsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoricalLows indicates an expected call of GetHistoricalLows.
+func (mr *MockHistoricalLowsGetterMockRecorder) GetHistoricalLows(ctx, input any) *MockHistoricalLowsGetterGetHistoricalLowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoricalLows", reflect.TypeOf((*MockHistoricalLowsGetter)(nil).GetHistoricalLows), ctx, input)
+	return &MockHistoricalLowsGetterGetHistoricalLowsCall{Call: call}
+}
+
+// MockHistoricalLowsGetterGetHistoricalLowsCall wrap *gomock.Call
+type MockHistoricalLowsGetterGetHistoricalLowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockHistoricalLowsGetterGetHistoricalLowsCall) Return(arg0 *service.GetHistoricalLowsOutput, arg1 error) *MockHistoricalLowsGetterGetHistoricalLowsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockHistoricalLowsGetterGetHistoricalLowsCall) Do(f func(context.Context, *service.GetHistoricalLowsInput) (*service.GetHistoricalLowsOutput, error)) *MockHistoricalLowsGetterGetHistoricalLowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockHistoricalLowsGetterGetHistoricalLowsCall) DoAndReturn(f func(context.Context, *service.GetHistoricalLowsInput) (*service.GetHistoricalLowsOutput, error)) *MockHistoricalLowsGetterGetHistoricalLowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
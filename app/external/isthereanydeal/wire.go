@@ -0,0 +1,12 @@
+package isthereanydeal
+
+import (
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/google/wire"
+)
+
+// A wire set for the isthereanydeal package
+var Set = wire.NewSet(
+	NewHistoricalLowsGetter,
+	wire.Bind(new(service.HistoricalLowsGetter), new(*historicalLowsGetter)),
+)
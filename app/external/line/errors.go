@@ -0,0 +1,5 @@
+package line
+
+import "errors"
+
+var errUnexpectedStatusCode = errors.New("unexpected status code")
@@ -0,0 +1,461 @@
+package line
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"golang.org/x/time/rate"
+)
+
+const linePushMessageURL string = "https://api.line.me/v2/bot/message/push"
+
+// A name of the notification channel of LINE
+const channelName string = "line"
+
+// Limits of the LINE Messaging API
+//
+// [FYI]
+// A request pushes up to 5 messages and a carousel has up to 12 bubbles and up to 50 KB.
+// A text component is truncated to 1,000 characters, so that a bubble with up to 6 text components
+// stays below the limit of 30 KB even if all characters take 4 bytes.
+// The alternative text of a Flex Message has up to 400 characters and a text message has up to 5,000 characters
+// ref. https://developers.line.biz/en/reference/messaging-api/#send-push-message
+// ref. https://developers.line.biz/en/reference/messaging-api/#flex-message
+const (
+	maxMessagesPerRequest int = 5
+	maxBubblesPerCarousel int = 12
+	maxCarouselSize       int = 50_000
+	maxFlexTextLength     int = 1_000
+	maxAltTextLength      int = 400
+	maxTextLength         int = 5_000
+)
+
+// A label of the button which opens the store page of a video game
+const storeButtonLabel string = "Open in Steam"
+
+// A notification channel of LINE
+type Channel service.NotificationChannel
+
+// Generate a new notification channel of LINE
+//
+// [FYI]
+// nil is returned if LINE is not configured
+func NewChannel(
+	cfg *config.LineConfig,
+	dNotifier *videoGamePricesOnLineNotifier,
+	eNotifier *errorOnLineNotifier,
+) *Channel {
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	return &Channel{
+		Name:          channelName,
+		DealNotifier:  dNotifier,
+		ErrorNotifier: eNotifier,
+	}
+}
+
+type videoGamePricesOnLineNotifier struct {
+	cfg        *config.LineConfig
+	httpClient service.HTTPClient
+}
+
+var _ service.DealNotifier = (*videoGamePricesOnLineNotifier)(nil)
+
+// Generate a new video game prices on LINE notifier
+func NewVideoGamePricesOnLineNotifier(
+	cfg *config.LineConfig,
+	httpClient service.HTTPClient,
+) *videoGamePricesOnLineNotifier {
+	return &videoGamePricesOnLineNotifier{
+		cfg:        cfg,
+		httpClient: httpClient,
+	}
+}
+
+// Notify video game prices on LINE
+//
+// [FYI]
+// Each video game is shown in a bubble of a Flex Message with a button to open its store page.
+// The bubbles are divided into carousels and the carousels are divided into requests by the limits of LINE.
+// The rate limiter is set to 10 requests per second, which is far below the limit of the push message API
+// ref. https://developers.line.biz/en/reference/messaging-api/#rate-limits
+func (n *videoGamePricesOnLineNotifier) NotifyDeals(
+	ctx context.Context,
+	input *service.NotifyDealsInput,
+) (*service.NotifyDealsOutput, error) {
+	// Build Flex Messages of recommended and skipped video games
+	//
+	// [FYI]
+	// A carousel must have at least one bubble, so the message of recommended video games is omitted if there are none
+	messages := make([]*model.LineMessage, 0)
+	if len(input.Contents) > 0 {
+		messages = append(messages, buildFlexMessages(
+			"The recommended video games to buy now are as follows:",
+			buildDealBubbles(input.Contents),
+		)...)
+	}
+	if input.Basket != nil {
+		messages = append(messages, buildFlexMessages("Recommended basket", buildBasketBubbles(input.Basket))...)
+	}
+	if input.Digest != nil {
+		messages = append(messages, buildFlexMessages("Weekly digest", buildDigestBubbles(input.Digest))...)
+	}
+	if len(input.SkippedContents) > 0 {
+		messages = append(messages, buildFlexMessages(
+			"The following video games were skipped:",
+			buildSkippedBubbles(input.SkippedContents),
+		)...)
+	}
+
+	limiter := rate.NewLimiter(10, 1)
+	for chunk := range slices.Chunk(messages, maxMessagesPerRequest) {
+		if err := limiter.Wait(ctx); err != nil {
+			slog.ErrorContext(ctx, "failed to wait for the rate limiter", slog.Any("error", err))
+			return nil, err
+		}
+
+		body := &model.LinePushMessageBody{
+			To:       n.cfg.LineTo,
+			Messages: chunk,
+		}
+		if err := pushMessages(ctx, n.httpClient, n.cfg.LineChannelAccessToken, body); err != nil {
+			return nil, err
+		}
+	}
+
+	return &service.NotifyDealsOutput{}, nil
+}
+
+type errorOnLineNotifier struct {
+	cfg        *config.LineConfig
+	httpClient service.HTTPClient
+}
+
+var _ service.ErrorNotifier = (*errorOnLineNotifier)(nil)
+
+// Generate a new error on LINE notifier
+func NewErrorOnLineNotifier(
+	cfg *config.LineConfig,
+	httpClient service.HTTPClient,
+) *errorOnLineNotifier {
+	return &errorOnLineNotifier{
+		cfg:        cfg,
+		httpClient: httpClient,
+	}
+}
+
+// Notify an error on LINE
+func (n *errorOnLineNotifier) NotifyError(
+	ctx context.Context,
+	input *service.NotifyErrorInput,
+) (*service.NotifyErrorOutput, error) {
+	body := &model.LinePushMessageBody{
+		To: n.cfg.LineTo,
+		Messages: []*model.LineMessage{
+			{
+				Type: model.LineMessageTypeText,
+				Text: truncate("An error occurred: "+input.GeneratedError.Error(), maxTextLength),
+			},
+		},
+	}
+	if err := pushMessages(ctx, n.httpClient, n.cfg.LineChannelAccessToken, body); err != nil {
+		return nil, err
+	}
+
+	return &service.NotifyErrorOutput{}, nil
+}
+
+// Push messages with the LINE Messaging API
+func pushMessages(
+	ctx context.Context,
+	httpClient service.HTTPClient,
+	channelAccessToken string,
+	body *model.LinePushMessageBody,
+) error {
+	reqJSON, err := json.Marshal(body)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to marshal a LINE API request body", slog.Any("error", err))
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, linePushMessageURL, bytes.NewBuffer(reqJSON))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create a LINE API request", slog.Any("error", err))
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+channelAccessToken)
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to send a LINE API request", slog.Any("error", err))
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		slog.ErrorContext(ctx, "failed to send a LINE API request", slog.Any("status_code", res.StatusCode))
+		return errUnexpectedStatusCode
+	}
+
+	return nil
+}
+
+// Build Flex Messages of carousels from bubbles
+//
+// [FYI]
+// A new carousel is started if the current one has 12 bubbles or the next bubble makes it larger than 50 KB.
+// The size of a carousel is estimated as the total size of its bubbles with commas and the envelope
+func buildFlexMessages(altText string, bubbles []*model.LineBubble) []*model.LineMessage {
+	// The size of `{"type":"carousel","contents":[]}`
+	const envelopeSize = 33
+
+	carousels := make([][]*model.LineBubble, 0, 1)
+	var current []*model.LineBubble
+	size := envelopeSize
+	for _, b := range bubbles {
+		// [FYI]
+		// Marshaling a struct without maps and channels does not fail
+		bubbleJSON, _ := json.Marshal(b)
+		bubbleSize := len(bubbleJSON) + 1
+		if len(current) == maxBubblesPerCarousel || (len(current) > 0 && size+bubbleSize > maxCarouselSize) {
+			carousels = append(carousels, current)
+			current = nil
+			size = envelopeSize
+		}
+
+		current = append(current, b)
+		size += bubbleSize
+	}
+	if len(current) > 0 {
+		carousels = append(carousels, current)
+	}
+
+	messages := make([]*model.LineMessage, 0, len(carousels))
+	for _, c := range carousels {
+		messages = append(messages, &model.LineMessage{
+			Type:    model.LineMessageTypeFlex,
+			AltText: truncate(altText, maxAltTextLength),
+			Contents: &model.LineCarousel{
+				Type:     model.LineFlexTypeCarousel,
+				Contents: c,
+			},
+		})
+	}
+
+	return messages
+}
+
+// Build bubbles of recommended video games sorted by their deal classes
+//
+// [FYI]
+// The deal class is shown as a caption of each bubble
+func buildDealBubbles(contents map[model.SteamAppID]*model.DealContent) []*model.LineBubble {
+	bubbles := make([]*model.LineBubble, 0, len(contents))
+	appIDs := model.SortContentAppIDs(contents)
+	for _, class := range model.DealClasses {
+		for _, appID := range appIDs {
+			if v := contents[appID]; v.DealClass == class {
+				bubbles = append(bubbles, newBubble(class.Heading(), v.Title, buildDetails(v), appID))
+			}
+		}
+	}
+
+	return bubbles
+}
+
+// Build details of a recommended video game
+//
+// [FYI]
+// The lowest price is shown as "-" if it is not set
+func buildDetails(v *model.DealContent) []string {
+	lowestPrice := "-"
+	if v.LowestPrice != nil {
+		lowestPrice = v.LowestPrice.Format()
+	}
+
+	details := []string{
+		"Current Price: " + v.CurrentPrice.Format(),
+		"Lowest Price: " + lowestPrice,
+	}
+	if v.DiscountPercent > 0 {
+		details = append(details, fmt.Sprintf(
+			"Discount: -%d%% (%s → %s)",
+			v.DiscountPercent,
+			v.RegularPrice.Format(),
+			v.CurrentPrice.Format(),
+		))
+	}
+	if len(v.TriggeredRules) > 0 {
+		rules := make([]string, 0, len(v.TriggeredRules))
+		for _, r := range v.TriggeredRules {
+			rules = append(rules, string(r))
+		}
+		details = append(details, "Triggered by: "+strings.Join(rules, ", "))
+	}
+
+	return details
+}
+
+// Build bubbles of a recommended basket of deals within the monthly budget
+//
+// [FYI]
+// The first bubble shows the budget and the total of the basket
+func buildBasketBubbles(basket *model.Basket) []*model.LineBubble {
+	summary := []string{fmt.Sprintf(
+		"Monthly Budget: %s  |  Spent This Month: %s  |  Available: %s",
+		basket.Budget.Format(),
+		basket.Spent.Format(),
+		basket.Available().Format(),
+	)}
+	if len(basket.Items) == 0 {
+		summary = append(summary, "No deals fit in the available budget")
+	} else {
+		summary = append(summary, fmt.Sprintf(
+			"Total: %s  |  Left After Buying: %s",
+			basket.Total.Format(),
+			basket.Left().Format(),
+		))
+	}
+
+	bubbles := make([]*model.LineBubble, 0, len(basket.Items)+1)
+	bubbles = append(bubbles, newBubble("", "Recommended basket", summary, 0))
+	for _, v := range basket.Items {
+		details := []string{"Price: " + v.Price.Format()}
+		if v.Priority > 0 {
+			details = append(details, fmt.Sprintf("Priority: %d", v.Priority))
+		}
+		bubbles = append(bubbles, newBubble("Recommended basket", v.Title, details, v.AppID))
+	}
+
+	return bubbles
+}
+
+// Build bubbles of the weekly digest
+//
+// [FYI]
+// The first bubble shows the numbers of video games and the total savings
+func buildDigestBubbles(digest *model.Digest) []*model.LineBubble {
+	summary := []string{
+		fmt.Sprintf("Deals since the last digest: %d", len(digest.QueuedDeals)),
+		fmt.Sprintf("Discounted Video Games: %d", len(digest.DiscountedGames)),
+	}
+	if digest.TotalSavings != nil {
+		summary = append(summary, "Total Savings: "+digest.TotalSavings.Format())
+	}
+
+	bubbles := make([]*model.LineBubble, 0, len(digest.QueuedDeals)+len(digest.DiscountedGames)+1)
+	bubbles = append(bubbles, newBubble("", "Weekly digest", summary, 0))
+	for _, v := range digest.QueuedDeals {
+		bubbles = append(bubbles, newBubble("Deals since the last digest", v.Content.Title, buildDetails(v.Content), v.AppID))
+	}
+	for _, v := range digest.DiscountedGames {
+		bubbles = append(bubbles, newBubble("Currently discounted video games", v.Title, []string{
+			fmt.Sprintf("Discount: -%d%% (%s → %s)", v.DiscountPercent, v.RegularPrice.Format(), v.CurrentPrice.Format()),
+			"Savings: " + v.Savings().Format(),
+		}, v.AppID))
+	}
+
+	return bubbles
+}
+
+// Build bubbles of video games skipped because they cannot be retrieved from the Steam Store
+func buildSkippedBubbles(skippedContents map[model.SteamAppID]*model.SkippedContent) []*model.LineBubble {
+	bubbles := make([]*model.LineBubble, 0, len(skippedContents))
+	for _, k := range slices.Sorted(maps.Keys(skippedContents)) {
+		v := skippedContents[k]
+		title := v.Title
+		if title == "" {
+			title = fmt.Sprintf("App ID: %d", v.AppID)
+		}
+		bubbles = append(bubbles, newBubble("Skipped", title, []string{
+			fmt.Sprintf("App ID: %d", v.AppID),
+			"Reason: " + v.Reason,
+		}, v.AppID))
+	}
+
+	return bubbles
+}
+
+// Generate a new bubble of a video game
+//
+// [FYI]
+// The caption is omitted if it is empty, and a button to open the store page is added if the app ID is not 0
+func newBubble(caption, title string, details []string, appID model.SteamAppID) *model.LineBubble {
+	contents := make([]*model.LineFlexComponent, 0, len(details)+2)
+	if caption != "" {
+		contents = append(contents, &model.LineFlexComponent{
+			Type:  model.LineFlexTypeText,
+			Text:  truncate(caption, maxFlexTextLength),
+			Size:  model.LineFlexTextSizeSmall,
+			Color: model.LineFlexTextColorCaption,
+		})
+	}
+	contents = append(contents, &model.LineFlexComponent{
+		Type:   model.LineFlexTypeText,
+		Text:   truncate(title, maxFlexTextLength),
+		Weight: model.LineFlexTextWeightBold,
+		Wrap:   true,
+	})
+	for _, d := range details {
+		contents = append(contents, &model.LineFlexComponent{
+			Type: model.LineFlexTypeText,
+			Text: truncate(d, maxFlexTextLength),
+			Size: model.LineFlexTextSizeSmall,
+			Wrap: true,
+		})
+	}
+
+	bubble := &model.LineBubble{
+		Type: model.LineFlexTypeBubble,
+		Body: &model.LineBox{
+			Type:     model.LineFlexTypeBox,
+			Layout:   model.LineFlexLayoutVertical,
+			Contents: contents,
+		},
+	}
+	if appID != 0 {
+		bubble.Footer = &model.LineBox{
+			Type:   model.LineFlexTypeBox,
+			Layout: model.LineFlexLayoutVertical,
+			Contents: []*model.LineFlexComponent{
+				{
+					Type:  model.LineFlexTypeButton,
+					Style: model.LineFlexButtonStyleLink,
+					Action: &model.LineAction{
+						Type:  model.LineActionTypeURI,
+						Label: storeButtonLabel,
+						URI:   appID.StoreURL(),
+					},
+				},
+			},
+		}
+	}
+
+	return bubble
+}
+
+// Truncate a text to the maximum number of characters with an ellipsis
+//
+// e.g. ("abcdef", 4) -> "abc…"
+func truncate(s string, maxLength int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLength {
+		return s
+	}
+
+	return string(runes[:maxLength-1]) + "…"
+}
@@ -0,0 +1,450 @@
+package line
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	httpclient "github.com/TsubasaBneAus/steam_game_price_notifier/app/external/httpclient/mock"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNotifyVideoGamePricesOnLine(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.LineConfig{
+		LineChannelAccessToken: "dummy_line_channel_access_token",
+		LineTo:                 "dummy_line_to",
+	}
+
+	t.Run("Positive case: Successfully notify video game prices on LINE", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				got := []string{req.URL.String(), req.Header.Get("Authorization")}
+				want := []string{"https://api.line.me/v2/bot/message/push", "Bearer dummy_line_channel_access_token"}
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				body := &model.LinePushMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				wantBody := &model.LinePushMessageBody{
+					To: "dummy_line_to",
+					Messages: []*model.LineMessage{
+						{
+							Type:    model.LineMessageTypeFlex,
+							AltText: "The recommended video games to buy now are as follows:",
+							Contents: &model.LineCarousel{
+								Type: model.LineFlexTypeCarousel,
+								Contents: []*model.LineBubble{
+									{
+										Type: model.LineFlexTypeBubble,
+										Body: &model.LineBox{
+											Type:   model.LineFlexTypeBox,
+											Layout: model.LineFlexLayoutVertical,
+											Contents: []*model.LineFlexComponent{
+												{
+													Type:  model.LineFlexTypeText,
+													Text:  "New all-time lows",
+													Size:  model.LineFlexTextSizeSmall,
+													Color: model.LineFlexTextColorCaption,
+												},
+												{
+													Type:   model.LineFlexTypeText,
+													Text:   "dummy_title",
+													Weight: model.LineFlexTextWeightBold,
+													Wrap:   true,
+												},
+												{
+													Type: model.LineFlexTypeText,
+													Text: "Current Price: ¥250",
+													Size: model.LineFlexTextSizeSmall,
+													Wrap: true,
+												},
+												{
+													Type: model.LineFlexTypeText,
+													Text: "Lowest Price: ¥1,500",
+													Size: model.LineFlexTextSizeSmall,
+													Wrap: true,
+												},
+												{
+													Type: model.LineFlexTypeText,
+													Text: "Discount: -75% (¥1,000 → ¥250)",
+													Size: model.LineFlexTextSizeSmall,
+													Wrap: true,
+												},
+											},
+										},
+										Footer: &model.LineBox{
+											Type:   model.LineFlexTypeBox,
+											Layout: model.LineFlexLayoutVertical,
+											Contents: []*model.LineFlexComponent{
+												{
+													Type:  model.LineFlexTypeButton,
+													Style: model.LineFlexButtonStyleLink,
+													Action: &model.LineAction{
+														Type:  model.LineActionTypeURI,
+														Label: "Open in Steam",
+														URI:   "https://store.steampowered.com/app/1/",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				if diff := cmp.Diff(body, wantBody); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesOnLineNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				1: {
+					Title:           "dummy_title",
+					CurrentPrice:    model.Money{Currency: "JPY", Amount: 250},
+					RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+					LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+					DiscountPercent: 75,
+					DealClass:       model.DealClassNewLow,
+				},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: Bubbles are divided by 12 bubbles per carousel and 5 messages per request", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		got := make([][]int, 0, 2)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &model.LinePushMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				bubbles := make([]int, 0, len(body.Messages))
+				for _, v := range body.Messages {
+					bubbles = append(bubbles, len(v.Contents.Contents))
+				}
+				got = append(got, bubbles)
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			}).
+			Times(2)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesOnLineNotifier(cfg, m)
+		contents := make(map[model.SteamAppID]*model.DealContent, 70)
+		for i := range 70 {
+			contents[model.SteamAppID(i+1)] = &model.DealContent{
+				Title:        "dummy_title",
+				CurrentPrice: model.Money{Currency: "JPY", Amount: 1000},
+				DealClass:    model.DealClassNewLow,
+			}
+		}
+		input := &service.NotifyDealsInput{
+			Contents: contents,
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+
+		want := [][]int{{12, 12, 12, 12, 12}, {10}}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: Carousels are kept within 50 KB and long texts are truncated", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &model.LinePushMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				if len(body.Messages) < 2 {
+					t.Errorf("\ngot: %v\nwant: %v", len(body.Messages), "2 or more carousels")
+				}
+				bubbles := 0
+				for _, v := range body.Messages {
+					carouselJSON, err := json.Marshal(v.Contents)
+					if err != nil {
+						t.Fatalf("failed to marshal a carousel: %v", err)
+					}
+					if len(carouselJSON) > maxCarouselSize {
+						t.Errorf("\ngot: %v\nwant: %v or less", len(carouselJSON), maxCarouselSize)
+					}
+					bubbles += len(v.Contents.Contents)
+
+					title := v.Contents.Contents[0].Body.Contents[1].Text
+					if utf8.RuneCountInString(title) != maxFlexTextLength || !strings.HasSuffix(title, "…") {
+						t.Errorf("\ngot: %v\nwant: %v characters ending with an ellipsis", utf8.RuneCountInString(title), maxFlexTextLength)
+					}
+				}
+				if bubbles != 12 {
+					t.Errorf("\ngot: %v\nwant: %v", bubbles, 12)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesOnLineNotifier(cfg, m)
+		contents := make(map[model.SteamAppID]*model.DealContent, 12)
+		for i := range 12 {
+			contents[model.SteamAppID(i+1)] = &model.DealContent{
+				Title:          strings.Repeat("長", 2000),
+				CurrentPrice:   model.Money{Currency: "JPY", Amount: 1000},
+				DealClass:      model.DealClassOtherRules,
+				TriggeredRules: []model.DealRule{model.DealRule(strings.Repeat("規", 2000))},
+			}
+		}
+		input := &service.NotifyDealsInput{
+			Contents: contents,
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: Only skipped video games are notified", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &model.LinePushMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				got := []string{body.Messages[0].AltText}
+				for _, c := range body.Messages[0].Contents.Contents[0].Body.Contents {
+					got = append(got, c.Text)
+				}
+				want := []string{"The following video games were skipped:", "Skipped", "App ID: 2", "App ID: 2", "Reason: dummy_reason"}
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesOnLineNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+			SkippedContents: map[model.SteamAppID]*model.SkippedContent{
+				2: {
+					AppID:  2,
+					Reason: "dummy_reason",
+				},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Negative case: Failed to send a LINE API request", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		wantErr := errors.New("unexpected error")
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(nil, wantErr)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesOnLineNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				1: {
+					Title:        "dummy_title",
+					CurrentPrice: model.Money{Currency: "JPY", Amount: 1000},
+					DealClass:    model.DealClassNewLow,
+				},
+			},
+		}
+		if _, gotErr := n.NotifyDeals(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Get a status code except 200", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Body:       http.NoBody,
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesOnLineNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				1: {
+					Title:        "dummy_title",
+					CurrentPrice: model.Money{Currency: "JPY", Amount: 1000},
+					DealClass:    model.DealClassNewLow,
+				},
+			},
+		}
+		wantErr := errUnexpectedStatusCode
+		if _, gotErr := n.NotifyDeals(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+}
+
+func TestNotifyErrorOnLine(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.LineConfig{
+		LineChannelAccessToken: "dummy_line_channel_access_token",
+		LineTo:                 "dummy_line_to",
+	}
+
+	t.Run("Positive case: Successfully notify an error on LINE", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &model.LinePushMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				want := &model.LinePushMessageBody{
+					To: "dummy_line_to",
+					Messages: []*model.LineMessage{
+						{
+							Type: model.LineMessageTypeText,
+							Text: "An error occurred: dummy_error",
+						},
+					},
+				}
+				if diff := cmp.Diff(body, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewErrorOnLineNotifier(cfg, m)
+		input := &service.NotifyErrorInput{
+			GeneratedError: errors.New("dummy_error"),
+		}
+		if _, err := n.NotifyError(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Negative case: Get a status code except 200", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusUnauthorized,
+				Body:       http.NoBody,
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewErrorOnLineNotifier(cfg, m)
+		input := &service.NotifyErrorInput{
+			GeneratedError: errors.New("dummy_error"),
+		}
+		wantErr := errUnexpectedStatusCode
+		if _, gotErr := n.NotifyError(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+}
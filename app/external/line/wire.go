@@ -0,0 +1,10 @@
+package line
+
+import "github.com/google/wire"
+
+// A wire set for the line package
+var Set = wire.NewSet(
+	NewVideoGamePricesOnLineNotifier,
+	NewErrorOnLineNotifier,
+	NewChannel,
+)
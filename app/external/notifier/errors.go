@@ -0,0 +1,5 @@
+package notifier
+
+import "errors"
+
+var errAllChannelsFailed = errors.New("all notification channels failed")
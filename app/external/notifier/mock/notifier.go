@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./notifier.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./notifier.go -destination=../external/notifier/mock/notifier.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	service "github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDealNotifier is a mock of DealNotifier interface.
+type MockDealNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockDealNotifierMockRecorder
+	isgomock struct{}
+}
+
+// MockDealNotifierMockRecorder is the mock recorder for MockDealNotifier.
+type MockDealNotifierMockRecorder struct {
+	mock *MockDealNotifier
+}
+
+// NewMockDealNotifier creates a new mock instance.
+func NewMockDealNotifier(ctrl *gomock.Controller) *MockDealNotifier {
+	mock := &MockDealNotifier{ctrl: ctrl}
+	mock.recorder = &MockDealNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDealNotifier) EXPECT() *MockDealNotifierMockRecorder {
+	return m.recorder
+}
+
+// NotifyDeals mocks base method.
+func (m *MockDealNotifier) NotifyDeals(ctx context.Context, input *service.NotifyDealsInput) (*service.NotifyDealsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyDeals", ctx, input)
+	ret0, _ := ret[0].(*service.NotifyDealsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyDeals indicates an expected call of NotifyDeals.
+func (mr *MockDealNotifierMockRecorder) NotifyDeals(ctx, input any) *MockDealNotifierNotifyDealsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyDeals", reflect.TypeOf((*MockDealNotifier)(nil).NotifyDeals), ctx, input)
+	return &MockDealNotifierNotifyDealsCall{Call: call}
+}
+
+// MockDealNotifierNotifyDealsCall wrap *gomock.Call
+type MockDealNotifierNotifyDealsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDealNotifierNotifyDealsCall) Return(arg0 *service.NotifyDealsOutput, arg1 error) *MockDealNotifierNotifyDealsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDealNotifierNotifyDealsCall) Do(f func(context.Context, *service.NotifyDealsInput) (*service.NotifyDealsOutput, error)) *MockDealNotifierNotifyDealsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDealNotifierNotifyDealsCall) DoAndReturn(f func(context.Context, *service.NotifyDealsInput) (*service.NotifyDealsOutput, error)) *MockDealNotifierNotifyDealsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockErrorNotifier is a mock of ErrorNotifier interface.
+type MockErrorNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockErrorNotifierMockRecorder
+	isgomock struct{}
+}
+
+// MockErrorNotifierMockRecorder is the mock recorder for MockErrorNotifier.
+type MockErrorNotifierMockRecorder struct {
+	mock *MockErrorNotifier
+}
+
+// NewMockErrorNotifier creates a new mock instance.
+func NewMockErrorNotifier(ctrl *gomock.Controller) *MockErrorNotifier {
+	mock := &MockErrorNotifier{ctrl: ctrl}
+	mock.recorder = &MockErrorNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockErrorNotifier) EXPECT() *MockErrorNotifierMockRecorder {
+	return m.recorder
+}
+
+// NotifyError mocks base method.
+func (m *MockErrorNotifier) NotifyError(ctx context.Context, input *service.NotifyErrorInput) (*service.NotifyErrorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyError", ctx, input)
+	ret0, _ := ret[0].(*service.NotifyErrorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyError indicates an expected call of NotifyError.
+func (mr *MockErrorNotifierMockRecorder) NotifyError(ctx, input any) *MockErrorNotifierNotifyErrorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyError", reflect.TypeOf((*MockErrorNotifier)(nil).NotifyError), ctx, input)
+	return &MockErrorNotifierNotifyErrorCall{Call: call}
+}
+
+// MockErrorNotifierNotifyErrorCall wrap *gomock.Call
+type MockErrorNotifierNotifyErrorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockErrorNotifierNotifyErrorCall) Return(arg0 *service.NotifyErrorOutput, arg1 error) *MockErrorNotifierNotifyErrorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockErrorNotifierNotifyErrorCall) Do(f func(context.Context, *service.NotifyErrorInput) (*service.NotifyErrorOutput, error)) *MockErrorNotifierNotifyErrorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockErrorNotifierNotifyErrorCall) DoAndReturn(f func(context.Context, *service.NotifyErrorInput) (*service.NotifyErrorOutput, error)) *MockErrorNotifierNotifyErrorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,121 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+)
+
+type dealNotifier struct {
+	channels []*service.NotificationChannel
+}
+
+var _ service.DealNotifier = (*dealNotifier)(nil)
+
+// Generate a new deal notifier which fans out to all notification channels
+func NewDealNotifier(channels []*service.NotificationChannel) *dealNotifier {
+	return &dealNotifier{
+		channels: channels,
+	}
+}
+
+// Notify deals of video games on all notification channels
+//
+// [FYI]
+// The channels are notified in parallel, and a channel which fails does not stop the others.
+// The failed channels are reported in the output, and an error is returned only if all channels fail.
+// A channel which sends deals in multiple messages fails even if some of them have been sent,
+// so the messages already sent are sent again when the deals are retried in the next run
+func (n *dealNotifier) NotifyDeals(
+	ctx context.Context,
+	input *service.NotifyDealsInput,
+) (*service.NotifyDealsOutput, error) {
+	failedChannels, err := fanOut(ctx, n.channels, func(c *service.NotificationChannel) error {
+		_, err := c.DealNotifier.NotifyDeals(ctx, input)
+		return err
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to notify deals of video games on all channels", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &service.NotifyDealsOutput{
+		FailedChannels: failedChannels,
+	}, nil
+}
+
+type errorNotifier struct {
+	channels []*service.NotificationChannel
+}
+
+var _ service.ErrorNotifier = (*errorNotifier)(nil)
+
+// Generate a new error notifier which fans out to all notification channels
+func NewErrorNotifier(channels []*service.NotificationChannel) *errorNotifier {
+	return &errorNotifier{
+		channels: channels,
+	}
+}
+
+// Notify an error on all notification channels
+//
+// [FYI]
+// The failed channels are reported in the output, and an error is returned only if all channels fail
+func (n *errorNotifier) NotifyError(
+	ctx context.Context,
+	input *service.NotifyErrorInput,
+) (*service.NotifyErrorOutput, error) {
+	failedChannels, err := fanOut(ctx, n.channels, func(c *service.NotificationChannel) error {
+		_, err := c.ErrorNotifier.NotifyError(ctx, input)
+		return err
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to notify an error on all channels", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &service.NotifyErrorOutput{
+		FailedChannels: failedChannels,
+	}, nil
+}
+
+// Run a notification on all channels in parallel
+//
+// [FYI]
+// The errors of the failed channels are keyed by the channel names,
+// and errAllChannelsFailed wrapping all of them is returned if no channel succeeds
+func fanOut(
+	ctx context.Context,
+	channels []*service.NotificationChannel,
+	notify func(c *service.NotificationChannel) error,
+) (map[string]error, error) {
+	failedChannels := make(map[string]error)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, c := range channels {
+		wg.Go(func() {
+			if err := notify(c); err != nil {
+				slog.WarnContext(ctx, "failed to notify on a channel", slog.String("channel", c.Name), slog.Any("error", err))
+				mu.Lock()
+				failedChannels[c.Name] = err
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	if len(channels) > 0 && len(failedChannels) == len(channels) {
+		errs := make([]error, 0, len(channels))
+		for _, c := range channels {
+			errs = append(errs, fmt.Errorf("%s: %w", c.Name, failedChannels[c.Name]))
+		}
+
+		return nil, fmt.Errorf("%w: %w", errAllChannelsFailed, errors.Join(errs...))
+	}
+
+	return failedChannels, nil
+}
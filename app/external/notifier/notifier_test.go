@@ -0,0 +1,165 @@
+package notifier
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/notifier/mock"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNotifyDeals(t *testing.T) {
+	t.Parallel()
+
+	input := &service.NotifyDealsInput{
+		Contents: map[model.SteamAppID]*model.DealContent{
+			1: {
+				Title:        "dummy_title",
+				CurrentPrice: model.Money{Currency: "JPY", Amount: 1000},
+			},
+		},
+	}
+
+	t.Run("Positive case: Successfully notify deals on all channels", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		first := mock.NewMockDealNotifier(ctrl)
+		first.EXPECT().NotifyDeals(gomock.Any(), input).Return(&service.NotifyDealsOutput{}, nil)
+		second := mock.NewMockDealNotifier(ctrl)
+		second.EXPECT().NotifyDeals(gomock.Any(), input).Return(&service.NotifyDealsOutput{}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewDealNotifier([]*service.NotificationChannel{
+			{Name: "first", DealNotifier: first},
+			{Name: "second", DealNotifier: second},
+		})
+		got, err := n.NotifyDeals(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.NotifyDealsOutput{
+			FailedChannels: map[string]error{},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: A failed channel is reported without stopping the others", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		wantErr := errors.New("unexpected error")
+		first := mock.NewMockDealNotifier(ctrl)
+		first.EXPECT().NotifyDeals(gomock.Any(), input).Return(nil, wantErr)
+		second := mock.NewMockDealNotifier(ctrl)
+		second.EXPECT().NotifyDeals(gomock.Any(), input).Return(&service.NotifyDealsOutput{}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewDealNotifier([]*service.NotificationChannel{
+			{Name: "first", DealNotifier: first},
+			{Name: "second", DealNotifier: second},
+		})
+		got, err := n.NotifyDeals(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.NotifyDealsOutput{
+			FailedChannels: map[string]error{"first": wantErr},
+		}
+		if diff := cmp.Diff(got, want, cmpopts.EquateErrors()); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Negative case: All channels failed", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		wantErr := errors.New("unexpected error")
+		first := mock.NewMockDealNotifier(ctrl)
+		first.EXPECT().NotifyDeals(gomock.Any(), input).Return(nil, wantErr)
+		second := mock.NewMockDealNotifier(ctrl)
+		second.EXPECT().NotifyDeals(gomock.Any(), input).Return(nil, wantErr)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewDealNotifier([]*service.NotificationChannel{
+			{Name: "first", DealNotifier: first},
+			{Name: "second", DealNotifier: second},
+		})
+		_, gotErr := n.NotifyDeals(ctx, input)
+		if !errors.Is(gotErr, errAllChannelsFailed) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errAllChannelsFailed)
+		}
+		if !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+}
+
+func TestNotifyError(t *testing.T) {
+	t.Parallel()
+
+	input := &service.NotifyErrorInput{
+		GeneratedError: errors.New("dummy error"),
+	}
+
+	t.Run("Positive case: A failed channel is reported without stopping the others", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		wantErr := errors.New("unexpected error")
+		first := mock.NewMockErrorNotifier(ctrl)
+		first.EXPECT().NotifyError(gomock.Any(), input).Return(&service.NotifyErrorOutput{}, nil)
+		second := mock.NewMockErrorNotifier(ctrl)
+		second.EXPECT().NotifyError(gomock.Any(), input).Return(nil, wantErr)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewErrorNotifier([]*service.NotificationChannel{
+			{Name: "first", ErrorNotifier: first},
+			{Name: "second", ErrorNotifier: second},
+		})
+		got, err := n.NotifyError(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.NotifyErrorOutput{
+			FailedChannels: map[string]error{"second": wantErr},
+		}
+		if diff := cmp.Diff(got, want, cmpopts.EquateErrors()); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Negative case: All channels failed", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		wantErr := errors.New("unexpected error")
+		first := mock.NewMockErrorNotifier(ctrl)
+		first.EXPECT().NotifyError(gomock.Any(), input).Return(nil, wantErr)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewErrorNotifier([]*service.NotificationChannel{
+			{Name: "first", ErrorNotifier: first},
+		})
+		if _, gotErr := n.NotifyError(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+}
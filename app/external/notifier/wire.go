@@ -0,0 +1,14 @@
+package notifier
+
+import (
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/google/wire"
+)
+
+// A wire set for the notifier package
+var Set = wire.NewSet(
+	NewDealNotifier,
+	NewErrorNotifier,
+	wire.Bind(new(service.DealNotifier), new(*dealNotifier)),
+	wire.Bind(new(service.ErrorNotifier), new(*errorNotifier)),
+)
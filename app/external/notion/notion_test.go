@@ -2,6 +2,7 @@ package notion
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
@@ -119,10 +120,10 @@ func TestGetNotionWishlist(t *testing.T) {
 						},
 					},
 					CurrentPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					LowestPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					NotionReleaseDate: &model.NotionReleaseDate{
 						NotionDate: &model.NotionDate{
@@ -363,10 +364,10 @@ func TestCreateNotionWishlistItem(t *testing.T) {
 						},
 					},
 					CurrentPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					LowestPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					NotionReleaseDate: &model.NotionReleaseDate{
 						NotionDate: &model.NotionDate{
@@ -502,10 +503,10 @@ func TestCreateNotionWishlistItem(t *testing.T) {
 						},
 					},
 					CurrentPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					LowestPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					NotionReleaseDate: &model.NotionReleaseDate{
 						NotionDate: &model.NotionDate{
@@ -574,10 +575,10 @@ func TestCreateNotionWishlistItem(t *testing.T) {
 						},
 					},
 					CurrentPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					LowestPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					NotionReleaseDate: &model.NotionReleaseDate{
 						NotionDate: &model.NotionDate{
@@ -663,10 +664,10 @@ func TestUpdateNotionWishlistItem(t *testing.T) {
 						},
 					},
 					CurrentPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					LowestPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					NotionReleaseDate: &model.NotionReleaseDate{
 						NotionDate: &model.NotionDate{
@@ -734,10 +735,10 @@ func TestUpdateNotionWishlistItem(t *testing.T) {
 						},
 					},
 					CurrentPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					LowestPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					NotionReleaseDate: &model.NotionReleaseDate{
 						NotionDate: &model.NotionDate{
@@ -807,10 +808,10 @@ func TestUpdateNotionWishlistItem(t *testing.T) {
 						},
 					},
 					CurrentPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					LowestPrice: &model.NotionPrice{
-						Number: pointer.Ptr(uint64(7678)),
+						Number: pointer.Ptr(json.Number("7678")),
 					},
 					NotionReleaseDate: &model.NotionReleaseDate{
 						NotionDate: &model.NotionDate{
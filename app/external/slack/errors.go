@@ -0,0 +1,5 @@
+package slack
+
+import "errors"
+
+var errUnexpectedStatusCode = errors.New("unexpected status code")
@@ -0,0 +1,433 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"golang.org/x/time/rate"
+)
+
+// A name of the notification channel of Slack
+const channelName string = "slack"
+
+// The maximum number of blocks in a Slack message
+//
+// [FYI]
+// ref. https://api.slack.com/reference/block-kit/blocks
+const maxBlocks int = 50
+
+// The maximum number of characters in a text of a section block
+//
+// [FYI]
+// ref. https://api.slack.com/reference/block-kit/blocks#section
+const maxSectionTextLength int = 3_000
+
+// A text of the button which opens the store page of a video game
+const storeButtonText string = "Open in Steam"
+
+// A notification channel of Slack
+type Channel service.NotificationChannel
+
+// Generate a new notification channel of Slack
+//
+// [FYI]
+// nil is returned if Slack is not configured
+func NewChannel(
+	cfg *config.SlackConfig,
+	dNotifier *videoGamePricesOnSlackNotifier,
+	eNotifier *errorOnSlackNotifier,
+) *Channel {
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	return &Channel{
+		Name:          channelName,
+		DealNotifier:  dNotifier,
+		ErrorNotifier: eNotifier,
+	}
+}
+
+type videoGamePricesOnSlackNotifier struct {
+	cfg        *config.SlackConfig
+	httpClient service.HTTPClient
+}
+
+var _ service.DealNotifier = (*videoGamePricesOnSlackNotifier)(nil)
+
+// Generate a new video game prices on Slack notifier
+func NewVideoGamePricesOnSlackNotifier(
+	cfg *config.SlackConfig,
+	httpClient service.HTTPClient,
+) *videoGamePricesOnSlackNotifier {
+	return &videoGamePricesOnSlackNotifier{
+		cfg:        cfg,
+		httpClient: httpClient,
+	}
+}
+
+// Notify video game prices on Slack
+//
+// [FYI]
+// Each video game is shown in a section block with a button to open its store page.
+// A message has a limitation of 50 blocks, so the blocks are divided into multiple messages.
+// The rate limiter is set to 1 request per second
+// ref. https://api.slack.com/apis/rate-limits
+func (n *videoGamePricesOnSlackNotifier) NotifyDeals(
+	ctx context.Context,
+	input *service.NotifyDealsInput,
+) (*service.NotifyDealsOutput, error) {
+	// Build messages of recommended and skipped video games
+	//
+	// [FYI]
+	// The message of recommended video games is omitted if only skipped video games or the weekly digest are notified
+	bodies := make([]*model.SlackMessageBody, 0)
+	if len(input.Contents) > 0 || (len(input.SkippedContents) == 0 && input.Digest == nil) {
+		bodies = append(bodies, buildMessageBodies(
+			"The recommended video games to buy now are as follows:",
+			buildDealBlocks(input.Contents),
+		)...)
+	}
+	if input.Basket != nil {
+		bodies = append(bodies, buildMessageBodies("Recommended basket", buildBasketBlocks(input.Basket))...)
+	}
+	if input.Digest != nil {
+		bodies = append(bodies, buildMessageBodies("Weekly digest", buildDigestBlocks(input.Digest))...)
+	}
+	if len(input.SkippedContents) > 0 {
+		bodies = append(bodies, buildMessageBodies(
+			"The following video games were skipped:",
+			buildSkippedBlocks(input.SkippedContents),
+		)...)
+	}
+
+	limiter := rate.NewLimiter(1, 1)
+	for _, body := range bodies {
+		if err := limiter.Wait(ctx); err != nil {
+			slog.ErrorContext(ctx, "failed to wait for the rate limiter", slog.Any("error", err))
+			return nil, err
+		}
+
+		if err := sendMessage(ctx, n.httpClient, n.cfg.SlackWebhookURL, body); err != nil {
+			return nil, err
+		}
+	}
+
+	return &service.NotifyDealsOutput{}, nil
+}
+
+type errorOnSlackNotifier struct {
+	cfg        *config.SlackConfig
+	httpClient service.HTTPClient
+}
+
+var _ service.ErrorNotifier = (*errorOnSlackNotifier)(nil)
+
+// Generate a new error on Slack notifier
+func NewErrorOnSlackNotifier(
+	cfg *config.SlackConfig,
+	httpClient service.HTTPClient,
+) *errorOnSlackNotifier {
+	return &errorOnSlackNotifier{
+		cfg:        cfg,
+		httpClient: httpClient,
+	}
+}
+
+// Notify an error on Slack
+func (n *errorOnSlackNotifier) NotifyError(
+	ctx context.Context,
+	input *service.NotifyErrorInput,
+) (*service.NotifyErrorOutput, error) {
+	body := &model.SlackMessageBody{
+		Text: truncate("An error occurred: "+input.GeneratedError.Error(), maxSectionTextLength),
+		Blocks: []*model.SlackBlock{
+			newHeaderBlock("An error occurred:"),
+			newSectionBlock(escape(input.GeneratedError.Error()), 0),
+		},
+	}
+	if err := sendMessage(ctx, n.httpClient, n.cfg.SlackWebhookURL, body); err != nil {
+		return nil, err
+	}
+
+	return &service.NotifyErrorOutput{}, nil
+}
+
+// Send a message to an incoming webhook of Slack
+//
+// [FYI]
+// Slack responds with 200 and "ok" if the message is posted
+func sendMessage(
+	ctx context.Context,
+	httpClient service.HTTPClient,
+	webhookURL string,
+	body *model.SlackMessageBody,
+) error {
+	reqJSON, err := json.Marshal(body)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to marshal a Slack message body", slog.Any("error", err))
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewBuffer(reqJSON))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create a Slack webhook request", slog.Any("error", err))
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to send a Slack webhook request", slog.Any("error", err))
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		slog.ErrorContext(ctx, "failed to send a Slack webhook request", slog.Any("status_code", res.StatusCode))
+		return errUnexpectedStatusCode
+	}
+
+	return nil
+}
+
+// Build message bodies from blocks under a header
+//
+// [FYI]
+// The blocks are divided into multiple messages by 50 blocks including the header
+func buildMessageBodies(header string, blocks []*model.SlackBlock) []*model.SlackMessageBody {
+	blocks = append([]*model.SlackBlock{newHeaderBlock(header)}, blocks...)
+	bodies := make([]*model.SlackMessageBody, 0, len(blocks)/maxBlocks+1)
+	for chunk := range slices.Chunk(blocks, maxBlocks) {
+		bodies = append(bodies, &model.SlackMessageBody{
+			Text:   header,
+			Blocks: chunk,
+		})
+	}
+
+	return bodies
+}
+
+// Build blocks of recommended video games grouped into sections by their deal classes
+func buildDealBlocks(contents map[model.SteamAppID]*model.DealContent) []*model.SlackBlock {
+	blocks := make([]*model.SlackBlock, 0, len(contents)+len(model.DealClasses))
+	appIDs := model.SortContentAppIDs(contents)
+	for _, class := range model.DealClasses {
+		heading := false
+		for _, appID := range appIDs {
+			v := contents[appID]
+			if v.DealClass != class {
+				continue
+			}
+
+			if !heading {
+				blocks = append(blocks, newSectionBlock(fmt.Sprintf("*%s*", class.Heading()), 0))
+				heading = true
+			}
+
+			blocks = append(blocks, newSectionBlock(buildContent(v), appID))
+		}
+	}
+
+	return blocks
+}
+
+// Build a text of a video game in a section block
+//
+// [FYI]
+// The lowest price is shown as "-" if it is not set
+// e.g. "*Title*\nCurrent Price: *¥250*  |  Lowest Price: *¥1,500*  |  Discount: *-75% (¥1,000 → ¥250)*"
+func buildContent(v *model.DealContent) string {
+	lowestPrice := "-"
+	if v.LowestPrice != nil {
+		lowestPrice = v.LowestPrice.Format()
+	}
+
+	content := fmt.Sprintf(
+		"*%s*\nCurrent Price: *%s*  |  Lowest Price: *%s*",
+		escape(v.Title),
+		v.CurrentPrice.Format(),
+		lowestPrice,
+	)
+	if v.DiscountPercent > 0 {
+		content += fmt.Sprintf(
+			"  |  Discount: *-%d%% (%s → %s)*",
+			v.DiscountPercent,
+			v.RegularPrice.Format(),
+			v.CurrentPrice.Format(),
+		)
+	}
+	if len(v.TriggeredRules) > 0 {
+		rules := make([]string, 0, len(v.TriggeredRules))
+		for _, r := range v.TriggeredRules {
+			rules = append(rules, escape(string(r)))
+		}
+		content += fmt.Sprintf("  |  Triggered by: *%s*", strings.Join(rules, ", "))
+	}
+
+	return content
+}
+
+// Build blocks of a recommended basket of deals within the monthly budget
+func buildBasketBlocks(basket *model.Basket) []*model.SlackBlock {
+	blocks := make([]*model.SlackBlock, 0, len(basket.Items)+2)
+	blocks = append(blocks, newContextBlock(fmt.Sprintf(
+		"Monthly Budget: *%s*  |  Spent This Month: *%s*  |  Available: *%s*",
+		basket.Budget.Format(),
+		basket.Spent.Format(),
+		basket.Available().Format(),
+	)))
+	if len(basket.Items) == 0 {
+		blocks = append(blocks, newSectionBlock("No deals fit in the available budget", 0))
+		return blocks
+	}
+
+	for _, v := range basket.Items {
+		content := fmt.Sprintf("*%s*\nPrice: *%s*", escape(v.Title), v.Price.Format())
+		if v.Priority > 0 {
+			content += fmt.Sprintf("  |  Priority: *%d*", v.Priority)
+		}
+		blocks = append(blocks, newSectionBlock(content, v.AppID))
+	}
+	blocks = append(blocks, newContextBlock(fmt.Sprintf(
+		"Total: *%s*  |  Left After Buying: *%s*",
+		basket.Total.Format(),
+		basket.Left().Format(),
+	)))
+
+	return blocks
+}
+
+// Build blocks of the weekly digest
+func buildDigestBlocks(digest *model.Digest) []*model.SlackBlock {
+	blocks := make([]*model.SlackBlock, 0, len(digest.QueuedDeals)+len(digest.DiscountedGames)+3)
+	blocks = append(blocks, newSectionBlock("*Deals since the last digest*", 0))
+	if len(digest.QueuedDeals) == 0 {
+		blocks = append(blocks, newSectionBlock("No deals were found since the last digest", 0))
+	}
+	for _, v := range digest.QueuedDeals {
+		blocks = append(blocks, newSectionBlock(buildContent(v.Content), v.AppID))
+	}
+
+	blocks = append(blocks, newSectionBlock("*Currently discounted video games*", 0))
+	if len(digest.DiscountedGames) == 0 {
+		blocks = append(blocks, newSectionBlock("No video games on the wishlist are discounted now", 0))
+	}
+	for _, v := range digest.DiscountedGames {
+		blocks = append(blocks, newSectionBlock(fmt.Sprintf(
+			"*%s*\nDiscount: *-%d%% (%s → %s)*  |  Savings: *%s*",
+			escape(v.Title),
+			v.DiscountPercent,
+			v.RegularPrice.Format(),
+			v.CurrentPrice.Format(),
+			v.Savings().Format(),
+		), v.AppID))
+	}
+
+	if digest.TotalSavings != nil {
+		blocks = append(blocks, newContextBlock(fmt.Sprintf(
+			"Discounted Video Games: *%d*  |  Total Savings: *%s*",
+			len(digest.DiscountedGames),
+			digest.TotalSavings.Format(),
+		)))
+	}
+
+	return blocks
+}
+
+// Build blocks of video games skipped because they cannot be retrieved from the Steam Store
+func buildSkippedBlocks(skippedContents map[model.SteamAppID]*model.SkippedContent) []*model.SlackBlock {
+	blocks := make([]*model.SlackBlock, 0, len(skippedContents))
+	for _, k := range slices.Sorted(maps.Keys(skippedContents)) {
+		v := skippedContents[k]
+		content := fmt.Sprintf("*App ID: %d*\nReason: %s", v.AppID, escape(v.Reason))
+		if v.Title != "" {
+			content = fmt.Sprintf("*%s* (App ID: %d)\nReason: %s", escape(v.Title), v.AppID, escape(v.Reason))
+		}
+		blocks = append(blocks, newSectionBlock(content, v.AppID))
+	}
+
+	return blocks
+}
+
+// Generate a new header block
+func newHeaderBlock(text string) *model.SlackBlock {
+	return &model.SlackBlock{
+		Type: model.SlackBlockTypeHeader,
+		Text: &model.SlackText{
+			Type: model.SlackTextTypePlain,
+			Text: text,
+		},
+	}
+}
+
+// Generate a new section block of a markdown text
+//
+// [FYI]
+// A button to open the store page is added if the app ID is not 0,
+// and the text is truncated not to be rejected by Slack (e.g. a long error or reason)
+func newSectionBlock(text string, appID model.SteamAppID) *model.SlackBlock {
+	block := &model.SlackBlock{
+		Type: model.SlackBlockTypeSection,
+		Text: &model.SlackText{
+			Type: model.SlackTextTypeMarkdown,
+			Text: truncate(text, maxSectionTextLength),
+		},
+	}
+	if appID != 0 {
+		block.Accessory = &model.SlackButton{
+			Type: model.SlackElementButton,
+			Text: &model.SlackText{
+				Type: model.SlackTextTypePlain,
+				Text: storeButtonText,
+			},
+			URL: appID.StoreURL(),
+		}
+	}
+
+	return block
+}
+
+// Generate a new context block of a markdown text
+func newContextBlock(text string) *model.SlackBlock {
+	return &model.SlackBlock{
+		Type: model.SlackBlockTypeContext,
+		Elements: []*model.SlackText{
+			{
+				Type: model.SlackTextTypeMarkdown,
+				Text: text,
+			},
+		},
+	}
+}
+
+// Escape control characters of a markdown text of Slack
+//
+// [FYI]
+// ref. https://api.slack.com/reference/surfaces/formatting#escaping
+func escape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+// Truncate a text to the maximum number of characters with an ellipsis
+//
+// e.g. ("abcdef", 4) -> "abc…"
+func truncate(s string, maxLength int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLength {
+		return s
+	}
+
+	return string(runes[:maxLength-1]) + "…"
+}
@@ -0,0 +1,412 @@
+package slack
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	httpclient "github.com/TsubasaBneAus/steam_game_price_notifier/app/external/httpclient/mock"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNotifyVideoGamePricesOnSlack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Positive case: Successfully notify video game prices on Slack", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				got := req.URL.String()
+				want := "https://hooks.slack.com/services/dummy_slack_webhook"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				body := &model.SlackMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				wantBody := &model.SlackMessageBody{
+					Text: "The recommended video games to buy now are as follows:",
+					Blocks: []*model.SlackBlock{
+						{
+							Type: model.SlackBlockTypeHeader,
+							Text: &model.SlackText{
+								Type: model.SlackTextTypePlain,
+								Text: "The recommended video games to buy now are as follows:",
+							},
+						},
+						{
+							Type: model.SlackBlockTypeSection,
+							Text: &model.SlackText{
+								Type: model.SlackTextTypeMarkdown,
+								Text: "*New all-time lows*",
+							},
+						},
+						{
+							Type: model.SlackBlockTypeSection,
+							Text: &model.SlackText{
+								Type: model.SlackTextTypeMarkdown,
+								Text: "*Tom &amp; Jerry &lt;Remastered&gt;*\n" +
+									"Current Price: *¥250*  |  Lowest Price: *¥1,500*  |  Discount: *-75% (¥1,000 → ¥250)*",
+							},
+							Accessory: &model.SlackButton{
+								Type: model.SlackElementButton,
+								Text: &model.SlackText{
+									Type: model.SlackTextTypePlain,
+									Text: "Open in Steam",
+								},
+								URL: "https://store.steampowered.com/app/1/",
+							},
+						},
+					},
+				}
+				if diff := cmp.Diff(body, wantBody); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SlackConfig{
+			SlackWebhookURL: "https://hooks.slack.com/services/dummy_slack_webhook",
+		}
+		n := NewVideoGamePricesOnSlackNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				1: {
+					Title:           "Tom & Jerry <Remastered>",
+					CurrentPrice:    model.Money{Currency: "JPY", Amount: 250},
+					RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+					LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+					DiscountPercent: 75,
+					DealClass:       model.DealClassNewLow,
+				},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: Blocks are divided into multiple messages by 50 blocks", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		got := make([]int, 0, 2)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &model.SlackMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+				got = append(got, len(body.Blocks))
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			}).
+			Times(2)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SlackConfig{
+			SlackWebhookURL: "https://hooks.slack.com/services/dummy_slack_webhook",
+		}
+		n := NewVideoGamePricesOnSlackNotifier(cfg, m)
+		contents := make(map[model.SteamAppID]*model.DealContent, 60)
+		for i := range 60 {
+			contents[model.SteamAppID(i+1)] = &model.DealContent{
+				Title:        "dummy_title",
+				CurrentPrice: model.Money{Currency: "JPY", Amount: 1000},
+				DealClass:    model.DealClassNewLow,
+			}
+		}
+		input := &service.NotifyDealsInput{
+			Contents: contents,
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+
+		// A header, a heading of the deal class and 60 video games
+		want := []int{50, 12}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: Only skipped video games are notified", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &model.SlackMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				wantBody := &model.SlackMessageBody{
+					Text: "The following video games were skipped:",
+					Blocks: []*model.SlackBlock{
+						{
+							Type: model.SlackBlockTypeHeader,
+							Text: &model.SlackText{
+								Type: model.SlackTextTypePlain,
+								Text: "The following video games were skipped:",
+							},
+						},
+						{
+							Type: model.SlackBlockTypeSection,
+							Text: &model.SlackText{
+								Type: model.SlackTextTypeMarkdown,
+								Text: "*dummy_title* (App ID: 2)\nReason: dummy_reason",
+							},
+							Accessory: &model.SlackButton{
+								Type: model.SlackElementButton,
+								Text: &model.SlackText{
+									Type: model.SlackTextTypePlain,
+									Text: "Open in Steam",
+								},
+								URL: "https://store.steampowered.com/app/2/",
+							},
+						},
+					},
+				}
+				if diff := cmp.Diff(body, wantBody); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SlackConfig{
+			SlackWebhookURL: "https://hooks.slack.com/services/dummy_slack_webhook",
+		}
+		n := NewVideoGamePricesOnSlackNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+			SkippedContents: map[model.SteamAppID]*model.SkippedContent{
+				2: {
+					AppID:  2,
+					Title:  "dummy_title",
+					Reason: "dummy_reason",
+				},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Negative case: Failed to send a Slack webhook request", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		wantErr := errors.New("unexpected error")
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(nil, wantErr)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SlackConfig{
+			SlackWebhookURL: "https://hooks.slack.com/services/dummy_slack_webhook",
+		}
+		n := NewVideoGamePricesOnSlackNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+		}
+		if _, gotErr := n.NotifyDeals(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Get a status code except 200", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       http.NoBody,
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SlackConfig{
+			SlackWebhookURL: "https://hooks.slack.com/services/dummy_slack_webhook",
+		}
+		n := NewVideoGamePricesOnSlackNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+		}
+		wantErr := errUnexpectedStatusCode
+		if _, gotErr := n.NotifyDeals(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+}
+
+func TestNotifyErrorOnSlack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Positive case: Successfully notify an error on Slack", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &model.SlackMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				got := body.Text
+				want := "An error occurred: dummy_error"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SlackConfig{
+			SlackWebhookURL: "https://hooks.slack.com/services/dummy_slack_webhook",
+		}
+		n := NewErrorOnSlackNotifier(cfg, m)
+		input := &service.NotifyErrorInput{
+			GeneratedError: errors.New("dummy_error"),
+		}
+		if _, err := n.NotifyError(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: A long error is truncated not to be rejected by Slack", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &model.SlackMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				for _, block := range body.Blocks {
+					if block.Text == nil {
+						continue
+					}
+					if got := utf8.RuneCountInString(block.Text.Text); got > maxSectionTextLength {
+						t.Errorf("\ngot: %v\nwant: %v or less", got, maxSectionTextLength)
+					}
+				}
+				got := body.Blocks[1].Text.Text
+				if !strings.HasSuffix(got, "…") {
+					t.Errorf("\ngot: %v\nwant: a text ending with an ellipsis", got)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SlackConfig{
+			SlackWebhookURL: "https://hooks.slack.com/services/dummy_slack_webhook",
+		}
+		n := NewErrorOnSlackNotifier(cfg, m)
+		input := &service.NotifyErrorInput{
+			GeneratedError: errors.New(strings.Repeat("a", maxSectionTextLength+1)),
+		}
+		if _, err := n.NotifyError(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Negative case: Get a status code except 200", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Body:       http.NoBody,
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SlackConfig{
+			SlackWebhookURL: "https://hooks.slack.com/services/dummy_slack_webhook",
+		}
+		n := NewErrorOnSlackNotifier(cfg, m)
+		input := &service.NotifyErrorInput{
+			GeneratedError: errors.New("dummy_error"),
+		}
+		wantErr := errUnexpectedStatusCode
+		if _, gotErr := n.NotifyError(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+}
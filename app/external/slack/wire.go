@@ -0,0 +1,10 @@
+package slack
+
+import "github.com/google/wire"
+
+// A wire set for the slack package
+var Set = wire.NewSet(
+	NewVideoGamePricesOnSlackNotifier,
+	NewErrorOnSlackNotifier,
+	NewChannel,
+)
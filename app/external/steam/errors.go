@@ -2,4 +2,9 @@ package steam
 
 import "errors"
 
-var errUnexpectedStatusCode = errors.New("unexpected status code")
+var (
+	errUnexpectedStatusCode = errors.New("unexpected status code")
+	errBatchRejected        = errors.New("batch rejected by the Steam Store")
+	errInvalidSteamUserID   = errors.New("invalid Steam user ID")
+	errSteamUserNotFound    = errors.New("steam user not found")
+)
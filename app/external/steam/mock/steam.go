@@ -17,6 +17,69 @@ import (
 	gomock "go.uber.org/mock/gomock"
 )
 
+// MockSteamUserIDResolver is a mock of SteamUserIDResolver interface.
+type MockSteamUserIDResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockSteamUserIDResolverMockRecorder
+	isgomock struct{}
+}
+
+// MockSteamUserIDResolverMockRecorder is the mock recorder for MockSteamUserIDResolver.
+type MockSteamUserIDResolverMockRecorder struct {
+	mock *MockSteamUserIDResolver
+}
+
+// NewMockSteamUserIDResolver creates a new mock instance.
+func NewMockSteamUserIDResolver(ctrl *gomock.Controller) *MockSteamUserIDResolver {
+	mock := &MockSteamUserIDResolver{ctrl: ctrl}
+	mock.recorder = &MockSteamUserIDResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSteamUserIDResolver) EXPECT() *MockSteamUserIDResolverMockRecorder {
+	return m.recorder
+}
+
+// ResolveSteamUserID mocks base method.
+func (m *MockSteamUserIDResolver) ResolveSteamUserID(ctx context.Context, input *service.ResolveSteamUserIDInput) (*service.ResolveSteamUserIDOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveSteamUserID", ctx, input)
+	ret0, _ := ret[0].(*service.ResolveSteamUserIDOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveSteamUserID indicates an expected call of ResolveSteamUserID.
+func (mr *MockSteamUserIDResolverMockRecorder) ResolveSteamUserID(ctx, input any) *MockSteamUserIDResolverResolveSteamUserIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveSteamUserID", reflect.TypeOf((*MockSteamUserIDResolver)(nil).ResolveSteamUserID), ctx, input)
+	return &MockSteamUserIDResolverResolveSteamUserIDCall{Call: call}
+}
+
+// MockSteamUserIDResolverResolveSteamUserIDCall wrap *gomock.Call
+type MockSteamUserIDResolverResolveSteamUserIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSteamUserIDResolverResolveSteamUserIDCall) Return(arg0 *service.ResolveSteamUserIDOutput, arg1 error) *MockSteamUserIDResolverResolveSteamUserIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSteamUserIDResolverResolveSteamUserIDCall) Do(f func(context.Context, *service.ResolveSteamUserIDInput) (*service.ResolveSteamUserIDOutput, error)) *MockSteamUserIDResolverResolveSteamUserIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSteamUserIDResolverResolveSteamUserIDCall) DoAndReturn(f func(context.Context, *service.ResolveSteamUserIDInput) (*service.ResolveSteamUserIDOutput, error)) *MockSteamUserIDResolverResolveSteamUserIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockSteamWishlistGetter is a mock of SteamWishlistGetter interface.
 type MockSteamWishlistGetter struct {
 	ctrl     *gomock.Controller
@@ -80,6 +143,69 @@ func (c *MockSteamWishlistGetterGetSteamWishlistCall) DoAndReturn(f func(context
 	return c
 }
 
+// MockSteamOwnedGamesGetter is a mock of SteamOwnedGamesGetter interface.
+type MockSteamOwnedGamesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSteamOwnedGamesGetterMockRecorder
+	isgomock struct{}
+}
+
+// MockSteamOwnedGamesGetterMockRecorder is the mock recorder for MockSteamOwnedGamesGetter.
+type MockSteamOwnedGamesGetterMockRecorder struct {
+	mock *MockSteamOwnedGamesGetter
+}
+
+// NewMockSteamOwnedGamesGetter creates a new mock instance.
+func NewMockSteamOwnedGamesGetter(ctrl *gomock.Controller) *MockSteamOwnedGamesGetter {
+	mock := &MockSteamOwnedGamesGetter{ctrl: ctrl}
+	mock.recorder = &MockSteamOwnedGamesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSteamOwnedGamesGetter) EXPECT() *MockSteamOwnedGamesGetterMockRecorder {
+	return m.recorder
+}
+
+// GetSteamOwnedGames mocks base method.
+func (m *MockSteamOwnedGamesGetter) GetSteamOwnedGames(ctx context.Context, input *service.GetSteamOwnedGamesInput) (*service.GetSteamOwnedGamesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSteamOwnedGames", ctx, input)
+	ret0, _ := ret[0].(*service.GetSteamOwnedGamesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSteamOwnedGames indicates an expected call of GetSteamOwnedGames.
+func (mr *MockSteamOwnedGamesGetterMockRecorder) GetSteamOwnedGames(ctx, input any) *MockSteamOwnedGamesGetterGetSteamOwnedGamesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSteamOwnedGames", reflect.TypeOf((*MockSteamOwnedGamesGetter)(nil).GetSteamOwnedGames), ctx, input)
+	return &MockSteamOwnedGamesGetterGetSteamOwnedGamesCall{Call: call}
+}
+
+// MockSteamOwnedGamesGetterGetSteamOwnedGamesCall wrap *gomock.Call
+type MockSteamOwnedGamesGetterGetSteamOwnedGamesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSteamOwnedGamesGetterGetSteamOwnedGamesCall) Return(arg0 *service.GetSteamOwnedGamesOutput, arg1 error) *MockSteamOwnedGamesGetterGetSteamOwnedGamesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSteamOwnedGamesGetterGetSteamOwnedGamesCall) Do(f func(context.Context, *service.GetSteamOwnedGamesInput) (*service.GetSteamOwnedGamesOutput, error)) *MockSteamOwnedGamesGetterGetSteamOwnedGamesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSteamOwnedGamesGetterGetSteamOwnedGamesCall) DoAndReturn(f func(context.Context, *service.GetSteamOwnedGamesInput) (*service.GetSteamOwnedGamesOutput, error)) *MockSteamOwnedGamesGetterGetSteamOwnedGamesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockSteamVideoGameDetailsGetter is a mock of SteamVideoGameDetailsGetter interface.
 type MockSteamVideoGameDetailsGetter struct {
 	ctrl     *gomock.Controller
@@ -142,3 +268,129 @@ func (c *MockSteamVideoGameDetailsGetterGetSteamVideoGameDetailsCall) DoAndRetur
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSteamVideoGamePricesGetter is a mock of SteamVideoGamePricesGetter interface.
+type MockSteamVideoGamePricesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSteamVideoGamePricesGetterMockRecorder
+	isgomock struct{}
+}
+
+// MockSteamVideoGamePricesGetterMockRecorder is the mock recorder for MockSteamVideoGamePricesGetter.
+type MockSteamVideoGamePricesGetterMockRecorder struct {
+	mock *MockSteamVideoGamePricesGetter
+}
+
+// NewMockSteamVideoGamePricesGetter creates a new mock instance.
+func NewMockSteamVideoGamePricesGetter(ctrl *gomock.Controller) *MockSteamVideoGamePricesGetter {
+	mock := &MockSteamVideoGamePricesGetter{ctrl: ctrl}
+	mock.recorder = &MockSteamVideoGamePricesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSteamVideoGamePricesGetter) EXPECT() *MockSteamVideoGamePricesGetterMockRecorder {
+	return m.recorder
+}
+
+// GetSteamVideoGamePrices mocks base method.
+func (m *MockSteamVideoGamePricesGetter) GetSteamVideoGamePrices(ctx context.Context, input *service.GetSteamVideoGamePricesInput) (*service.GetSteamVideoGamePricesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSteamVideoGamePrices", ctx, input)
+	ret0, _ := ret[0].(*service.GetSteamVideoGamePricesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSteamVideoGamePrices indicates an expected call of GetSteamVideoGamePrices.
+func (mr *MockSteamVideoGamePricesGetterMockRecorder) GetSteamVideoGamePrices(ctx, input any) *MockSteamVideoGamePricesGetterGetSteamVideoGamePricesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSteamVideoGamePrices", reflect.TypeOf((*MockSteamVideoGamePricesGetter)(nil).GetSteamVideoGamePrices), ctx, input)
+	return &MockSteamVideoGamePricesGetterGetSteamVideoGamePricesCall{Call: call}
+}
+
+// MockSteamVideoGamePricesGetterGetSteamVideoGamePricesCall wrap *gomock.Call
+type MockSteamVideoGamePricesGetterGetSteamVideoGamePricesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSteamVideoGamePricesGetterGetSteamVideoGamePricesCall) Return(arg0 *service.GetSteamVideoGamePricesOutput, arg1 error) *MockSteamVideoGamePricesGetterGetSteamVideoGamePricesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSteamVideoGamePricesGetterGetSteamVideoGamePricesCall) Do(f func(context.Context, *service.GetSteamVideoGamePricesInput) (*service.GetSteamVideoGamePricesOutput, error)) *MockSteamVideoGamePricesGetterGetSteamVideoGamePricesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSteamVideoGamePricesGetterGetSteamVideoGamePricesCall) DoAndReturn(f func(context.Context, *service.GetSteamVideoGamePricesInput) (*service.GetSteamVideoGamePricesOutput, error)) *MockSteamVideoGamePricesGetterGetSteamVideoGamePricesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSteamReviewSummaryGetter is a mock of SteamReviewSummaryGetter interface.
+type MockSteamReviewSummaryGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSteamReviewSummaryGetterMockRecorder
+	isgomock struct{}
+}
+
+// MockSteamReviewSummaryGetterMockRecorder is the mock recorder for MockSteamReviewSummaryGetter.
+type MockSteamReviewSummaryGetterMockRecorder struct {
+	mock *MockSteamReviewSummaryGetter
+}
+
+// NewMockSteamReviewSummaryGetter creates a new mock instance.
+func NewMockSteamReviewSummaryGetter(ctrl *gomock.Controller) *MockSteamReviewSummaryGetter {
+	mock := &MockSteamReviewSummaryGetter{ctrl: ctrl}
+	mock.recorder = &MockSteamReviewSummaryGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSteamReviewSummaryGetter) EXPECT() *MockSteamReviewSummaryGetterMockRecorder {
+	return m.recorder
+}
+
+// GetSteamReviewSummary mocks base method.
+func (m *MockSteamReviewSummaryGetter) GetSteamReviewSummary(ctx context.Context, input *service.GetSteamReviewSummaryInput) (*service.GetSteamReviewSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSteamReviewSummary", ctx, input)
+	ret0, _ := ret[0].(*service.GetSteamReviewSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSteamReviewSummary indicates an expected call of GetSteamReviewSummary.
+func (mr *MockSteamReviewSummaryGetterMockRecorder) GetSteamReviewSummary(ctx, input any) *MockSteamReviewSummaryGetterGetSteamReviewSummaryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSteamReviewSummary", reflect.TypeOf((*MockSteamReviewSummaryGetter)(nil).GetSteamReviewSummary), ctx, input)
+	return &MockSteamReviewSummaryGetterGetSteamReviewSummaryCall{Call: call}
+}
+
+// MockSteamReviewSummaryGetterGetSteamReviewSummaryCall wrap *gomock.Call
+type MockSteamReviewSummaryGetterGetSteamReviewSummaryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSteamReviewSummaryGetterGetSteamReviewSummaryCall) Return(arg0 *service.GetSteamReviewSummaryOutput, arg1 error) *MockSteamReviewSummaryGetterGetSteamReviewSummaryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSteamReviewSummaryGetterGetSteamReviewSummaryCall) Do(f func(context.Context, *service.GetSteamReviewSummaryInput) (*service.GetSteamReviewSummaryOutput, error)) *MockSteamReviewSummaryGetterGetSteamReviewSummaryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSteamReviewSummaryGetterGetSteamReviewSummaryCall) DoAndReturn(f func(context.Context, *service.GetSteamReviewSummaryInput) (*service.GetSteamReviewSummaryOutput, error)) *MockSteamReviewSummaryGetterGetSteamReviewSummaryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
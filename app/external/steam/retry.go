@@ -0,0 +1,172 @@
+package steam
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/internal/retry"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// The initial and the minimum rate of requests to Steam per second
+	//
+	// [FYI]
+	// There is no recommended rate limit due to the unofficial Steam Store API
+	initialRequestsPerSecond rate.Limit = 5
+	minRequestsPerSecond     rate.Limit = 0.5
+)
+
+// An adaptive rate limiter which slows down when Steam throttles requests
+//
+// [FYI]
+// The rate is halved every time a request is throttled, down to the minimum rate,
+// and it is restored gradually by 10% of the initial rate every time a request succeeds
+type adaptiveLimiter struct {
+	mu      sync.Mutex
+	limiter *rate.Limiter
+}
+
+// Generate a new adaptiveLimiter
+func newAdaptiveLimiter() *adaptiveLimiter {
+	return &adaptiveLimiter{
+		limiter: rate.NewLimiter(initialRequestsPerSecond, 1),
+	}
+}
+
+// Wait until a request is allowed
+func (l *adaptiveLimiter) Wait(ctx context.Context) error {
+	return l.limiter.Wait(ctx)
+}
+
+// Slow down the rate because a request is throttled
+func (l *adaptiveLimiter) Throttle() rate.Limit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limit := max(l.limiter.Limit()/2, minRequestsPerSecond)
+	l.limiter.SetLimit(limit)
+
+	return limit
+}
+
+// Speed up the rate gradually because a request succeeds
+func (l *adaptiveLimiter) Recover() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.limiter.Limit() < initialRequestsPerSecond {
+		l.limiter.SetLimit(min(l.limiter.Limit()+initialRequestsPerSecond/10, initialRequestsPerSecond))
+	}
+}
+
+// Get the current rate
+func (l *adaptiveLimiter) Limit() rate.Limit {
+	return l.limiter.Limit()
+}
+
+// A HTTP client which retries requests to Steam
+//
+// [FYI]
+// Requests are retried with exponential backoff and full jitter if Steam responds with 429 or 5xx,
+// and Retry-After is honoured if it is set
+type retryableHTTPClient struct {
+	cfg        *config.SteamConfig
+	httpClient service.HTTPClient
+	limiter    *adaptiveLimiter
+}
+
+var _ service.HTTPClient = (*retryableHTTPClient)(nil)
+
+// Generate a new retryableHTTPClient
+//
+// [FYI]
+// The client is shared by all Steam getters, so that they are slowed down together by the same adaptive rate limiter
+func NewRetryableHTTPClient(
+	cfg *config.SteamConfig,
+	httpClient service.HTTPClient,
+) *retryableHTTPClient {
+	return &retryableHTTPClient{
+		cfg:        cfg,
+		httpClient: httpClient,
+		limiter:    newAdaptiveLimiter(),
+	}
+}
+
+// Send a HTTP request and retry it if it fails temporarily
+//
+// [FYI]
+// The response of the last attempt is returned as it is if all attempts fail,
+// so that the caller handles an unexpected status code in the same way as without retries
+func (c *retryableHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		if err := c.limiter.Wait(ctx); err != nil {
+			slog.ErrorContext(ctx, "failed to wait the rate limiter", slog.Any("error", err))
+			return nil, err
+		}
+
+		attemptReq, err := cloneRequest(req)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to clone a request to retry it", slog.Any("error", err))
+			return nil, err
+		}
+
+		res, err := c.httpClient.Do(attemptReq)
+		if err != nil {
+			return nil, err
+		}
+
+		if !retry.IsRetryableStatusCode(res.StatusCode) {
+			c.limiter.Recover()
+			return res, nil
+		}
+
+		if res.StatusCode == http.StatusTooManyRequests {
+			limit := c.limiter.Throttle()
+			slog.WarnContext(ctx, "requests are throttled by Steam", slog.Any("requests_per_second", float64(limit)))
+		}
+
+		if attempt >= c.cfg.SteamMaxRetries {
+			return res, nil
+		}
+
+		delay := retry.Delay(c.cfg.SteamRetryBaseDelay, attempt, res.Header.Get("Retry-After"))
+		slog.WarnContext(
+			ctx,
+			"retry a request to Steam",
+			slog.String("url", req.URL.Redacted()),
+			slog.Int("status_code", res.StatusCode),
+			slog.Int("attempt", attempt+1),
+			slog.Duration("delay", delay),
+		)
+
+		// Drain and close the body to reuse the connection
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		if err := retry.Sleep(ctx, delay); err != nil {
+			slog.ErrorContext(ctx, "failed to wait for a retry", slog.Any("error", err))
+			return nil, err
+		}
+	}
+}
+
+// Clone a request to send it again
+func cloneRequest(req *http.Request) (*http.Request, error) {
+	cloned := req.Clone(req.Context())
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		cloned.Body = body
+	}
+
+	return cloned, nil
+}
@@ -0,0 +1,183 @@
+package steam
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	httpclient "github.com/TsubasaBneAus/steam_game_price_notifier/app/external/httpclient/mock"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/time/rate"
+)
+
+func TestRetryableHTTPClientDo(t *testing.T) {
+	t.Parallel()
+
+	// Build a response with a status code and headers
+	newResponse := func(statusCode int, header http.Header) *http.Response {
+		return &http.Response{
+			StatusCode: statusCode,
+			Header:     header,
+			Body:       io.NopCloser(bytes.NewBufferString("")),
+		}
+	}
+
+	t.Run("Positive case: Retry a request which fails with 503", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		gomock.InOrder(
+			m.EXPECT().Do(gomock.Any()).Return(newResponse(http.StatusServiceUnavailable, http.Header{}), nil),
+			m.EXPECT().Do(gomock.Any()).Return(newResponse(http.StatusOK, http.Header{}), nil),
+		)
+
+		// Execute the method to be tested
+		cfg := &config.SteamConfig{
+			SteamMaxRetries:     3,
+			SteamRetryBaseDelay: time.Millisecond,
+		}
+		c := NewRetryableHTTPClient(cfg, m)
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, steamStoreVideoGameDetailsURL, nil)
+		if err != nil {
+			t.Fatalf("failed to create a request: %v", err)
+		}
+		res, err := c.Do(req)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if res.StatusCode != http.StatusOK {
+			t.Errorf("\ngot: %v\nwant: %v", res.StatusCode, http.StatusOK)
+		}
+	})
+
+	t.Run("Positive case: Slow down the rate and honour Retry-After if a request is throttled", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		gomock.InOrder(
+			m.EXPECT().Do(gomock.Any()).Return(newResponse(http.StatusTooManyRequests, http.Header{"Retry-After": {"0"}}), nil),
+			m.EXPECT().Do(gomock.Any()).Return(newResponse(http.StatusOK, http.Header{}), nil),
+		)
+
+		// Execute the method to be tested
+		//
+		// [FYI]
+		// The base delay is long enough to make the test time out if Retry-After is not honoured
+		cfg := &config.SteamConfig{
+			SteamMaxRetries:     3,
+			SteamRetryBaseDelay: time.Hour,
+		}
+		c := NewRetryableHTTPClient(cfg, m)
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, steamStoreVideoGameDetailsURL, nil)
+		if err != nil {
+			t.Fatalf("failed to create a request: %v", err)
+		}
+		res, err := c.Do(req)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if res.StatusCode != http.StatusOK {
+			t.Errorf("\ngot: %v\nwant: %v", res.StatusCode, http.StatusOK)
+		}
+
+		// The rate is halved by the throttled request and restored by 10% by the successful request
+		if got, want := c.limiter.Limit(), rate.Limit(3); got != want {
+			t.Errorf("\ngot: %v\nwant: %v", got, want)
+		}
+	})
+
+	t.Run("Positive case: A request throttled by a getter slows down the other getters sharing the client", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.EXPECT().Do(gomock.Any()).Return(newResponse(http.StatusTooManyRequests, http.Header{}), nil)
+
+		// Execute the method to be tested
+		cfg := &config.SteamConfig{
+			SteamMaxRetries:     0,
+			SteamRetryBaseDelay: time.Millisecond,
+		}
+		c := NewRetryableHTTPClient(cfg, m)
+		wg := NewSteamWishlistGetter(cfg, c)
+		pg := NewSteamVideoGamePricesGetter(cfg, c)
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, steamStoreVideoGameDetailsURL, nil)
+		if err != nil {
+			t.Fatalf("failed to create a request: %v", err)
+		}
+		res, err := wg.httpClient.Do(req)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if res.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("\ngot: %v\nwant: %v", res.StatusCode, http.StatusTooManyRequests)
+		}
+
+		// The rate of the other getter is halved as well
+		if got, want := pg.httpClient.(*retryableHTTPClient).limiter.Limit(), rate.Limit(2.5); got != want {
+			t.Errorf("\ngot: %v\nwant: %v", got, want)
+		}
+	})
+
+	t.Run("Positive case: Do not retry a request which fails with 404", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.EXPECT().Do(gomock.Any()).Return(newResponse(http.StatusNotFound, http.Header{}), nil)
+
+		// Execute the method to be tested
+		cfg := &config.SteamConfig{
+			SteamMaxRetries:     3,
+			SteamRetryBaseDelay: time.Millisecond,
+		}
+		c := NewRetryableHTTPClient(cfg, m)
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, steamStoreVideoGameDetailsURL, nil)
+		if err != nil {
+			t.Fatalf("failed to create a request: %v", err)
+		}
+		res, err := c.Do(req)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if res.StatusCode != http.StatusNotFound {
+			t.Errorf("\ngot: %v\nwant: %v", res.StatusCode, http.StatusNotFound)
+		}
+	})
+
+	t.Run("Negative case: Return the last response if all attempts fail", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.EXPECT().Do(gomock.Any()).Return(newResponse(http.StatusInternalServerError, http.Header{}), nil).Times(3)
+
+		// Execute the method to be tested
+		cfg := &config.SteamConfig{
+			SteamMaxRetries:     2,
+			SteamRetryBaseDelay: time.Millisecond,
+		}
+		c := NewRetryableHTTPClient(cfg, m)
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, steamStoreVideoGameDetailsURL, nil)
+		if err != nil {
+			t.Fatalf("failed to create a request: %v", err)
+		}
+		res, err := c.Do(req)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if res.StatusCode != http.StatusInternalServerError {
+			t.Errorf("\ngot: %v\nwant: %v", res.StatusCode, http.StatusInternalServerError)
+		}
+	})
+}
@@ -3,10 +3,15 @@ package steam
 import (
 	"context"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
@@ -14,10 +19,185 @@ import (
 )
 
 const (
+	steamResolveVanityURLURL      string = "https://api.steampowered.com/ISteamUser/ResolveVanityURL/v1/"
+	steamCommunityProfileURL      string = "https://steamcommunity.com/id/"
 	steamStoreWishlistURL         string = "https://api.steampowered.com/IWishlistService/GetWishlist/v1/"
+	steamOwnedGamesURL            string = "https://api.steampowered.com/IPlayerService/GetOwnedGames/v1/"
 	steamStoreVideoGameDetailsURL string = "https://store.steampowered.com/api/appdetails/"
+	steamStoreReviewsURL          string = "https://store.steampowered.com/appreviews/"
+
+	// A success code of ISteamUser/ResolveVanityURL when no profile matches a vanity name
+	resolveVanityURLNoMatch int = 42
+
+	// The maximum number of app IDs in a request to get prices of video games
+	maxAppIDsPerPricesRequest int = 100
+
+	// Country codes to check whether a video game is available in another region
+	fallbackCountryCode       string = "us"
+	secondFallbackCountryCode string = "jp"
 )
 
+type steamUserIDResolver struct {
+	cfg        *config.SteamConfig
+	httpClient service.HTTPClient
+}
+
+var _ service.SteamUserIDResolver = (*steamUserIDResolver)(nil)
+
+// Generate a new SteamUserIDResolver
+func NewSteamUserIDResolver(
+	cfg *config.SteamConfig,
+	httpClient *retryableHTTPClient,
+) *steamUserIDResolver {
+	return &steamUserIDResolver{
+		cfg:        cfg,
+		httpClient: httpClient,
+	}
+}
+
+// Resolve a Steam user ID into a SteamID64
+//
+// [FYI]
+// A SteamID64 is returned as it is without any requests.
+// A vanity name is resolved with ISteamUser/ResolveVanityURL of the Steam Web API if the Web API key is set,
+// otherwise with the Steam Community profile in the XML format which does not require the key
+func (r *steamUserIDResolver) ResolveSteamUserID(
+	ctx context.Context,
+	input *service.ResolveSteamUserIDInput,
+) (*service.ResolveSteamUserIDOutput, error) {
+	if model.IsSteamID64(input.SteamUserID) {
+		return &service.ResolveSteamUserIDOutput{
+			SteamID64: input.SteamUserID,
+		}, nil
+	}
+
+	if !model.IsSteamVanityName(input.SteamUserID) {
+		err := fmt.Errorf("%w: %s", errInvalidSteamUserID, input.SteamUserID)
+		slog.ErrorContext(ctx, "failed to validate a Steam user ID", slog.Any("error", err))
+		return nil, err
+	}
+
+	resolve := r.resolveWithCommunityProfile
+	if r.cfg.SteamWebAPIKey != "" {
+		resolve = r.resolveWithWebAPI
+	}
+	steamID64, err := resolve(ctx, input.SteamUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the resolved ID not to send an invalid ID to the Steam Store
+	if !model.IsSteamID64(steamID64) {
+		err := fmt.Errorf("%w: %s", errInvalidSteamUserID, steamID64)
+		slog.ErrorContext(ctx, "failed to validate a resolved Steam user ID", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &service.ResolveSteamUserIDOutput{
+		SteamID64: steamID64,
+	}, nil
+}
+
+// Resolve a vanity name with ISteamUser/ResolveVanityURL of the Steam Web API
+func (r *steamUserIDResolver) resolveWithWebAPI(ctx context.Context, vanityName string) (string, error) {
+	reqURL, err := url.Parse(steamResolveVanityURLURL)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build a Steam Web API URL", slog.Any("error", err))
+		return "", err
+	}
+
+	q := reqURL.Query()
+	q.Set("key", r.cfg.SteamWebAPIKey)
+	q.Set("vanityurl", vanityName)
+	reqURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create a Steam Web API request", slog.Any("error", err))
+		return "", err
+	}
+
+	res, err := r.httpClient.Do(req)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to send a Steam Web API request", slog.Any("error", err))
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		slog.ErrorContext(
+			ctx,
+			"unexpected status code in the Steam Web API response",
+			slog.Any("status_code", res.StatusCode),
+		)
+		return "", errUnexpectedStatusCode
+	}
+
+	resolved := &model.SteamResolveVanityURLResponse{}
+	if err := json.NewDecoder(res.Body).Decode(resolved); err != nil {
+		slog.ErrorContext(ctx, "failed to unmarshal a Steam Web API response", slog.Any("error", err))
+		return "", err
+	}
+
+	if resolved.Response == nil || resolved.Response.Success == resolveVanityURLNoMatch {
+		err := fmt.Errorf("%w: %s", errSteamUserNotFound, vanityName)
+		slog.ErrorContext(ctx, "failed to resolve a vanity name", slog.Any("error", err))
+		return "", err
+	}
+
+	return resolved.Response.SteamID, nil
+}
+
+// Resolve a vanity name with the Steam Community profile in the XML format
+func (r *steamUserIDResolver) resolveWithCommunityProfile(ctx context.Context, vanityName string) (string, error) {
+	reqURL, err := url.JoinPath(steamCommunityProfileURL, vanityName)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build a Steam Community profile URL", slog.Any("error", err))
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL+"?xml=1", nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create a Steam Community profile request", slog.Any("error", err))
+		return "", err
+	}
+
+	res, err := r.httpClient.Do(req)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to send a Steam Community profile request", slog.Any("error", err))
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		slog.ErrorContext(
+			ctx,
+			"unexpected status code in the Steam Community profile response",
+			slog.Any("status_code", res.StatusCode),
+		)
+		return "", errUnexpectedStatusCode
+	}
+
+	profile := &model.SteamCommunityProfile{}
+	if err := xml.NewDecoder(res.Body).Decode(profile); err != nil {
+		slog.ErrorContext(ctx, "failed to unmarshal a Steam Community profile response", slog.Any("error", err))
+		return "", err
+	}
+
+	if profile.SteamID64 == "" {
+		err := fmt.Errorf("%w: %s", errSteamUserNotFound, vanityName)
+		slog.ErrorContext(
+			ctx,
+			"failed to resolve a vanity name",
+			slog.String("reason", profile.Error),
+			slog.Any("error", err),
+		)
+		return "", err
+	}
+
+	return profile.SteamID64, nil
+}
+
 type steamWishlistGetter struct {
 	cfg        *config.SteamConfig
 	httpClient service.HTTPClient
@@ -28,7 +208,7 @@ var _ service.SteamWishlistGetter = (*steamWishlistGetter)(nil)
 // Generate a new SteamWishlistGetter
 func NewSteamWishlistGetter(
 	cfg *config.SteamConfig,
-	httpClient service.HTTPClient,
+	httpClient *retryableHTTPClient,
 ) *steamWishlistGetter {
 	return &steamWishlistGetter{
 		cfg:        cfg,
@@ -41,6 +221,13 @@ func (wg *steamWishlistGetter) GetSteamWishlist(
 	ctx context.Context,
 	input *service.GetSteamWishlistInput,
 ) (*service.GetSteamWishlistOutput, error) {
+	// Validate the Steam user ID before sending a request because vanity names must be resolved in advance
+	if !model.IsSteamID64(input.SteamUserID) {
+		err := fmt.Errorf("%w: %s", errInvalidSteamUserID, input.SteamUserID)
+		slog.ErrorContext(ctx, "failed to validate a Steam user ID", slog.Any("error", err))
+		return nil, err
+	}
+
 	reqURL, err := url.Parse(steamStoreWishlistURL)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to build a Steam Store wishlist URL", slog.Any("error", err))
@@ -48,7 +235,7 @@ func (wg *steamWishlistGetter) GetSteamWishlist(
 	}
 
 	q := reqURL.Query()
-	q.Set("steamid", wg.cfg.SteamUserID)
+	q.Set("steamid", input.SteamUserID)
 	reqURL.RawQuery = q.Encode()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
@@ -88,6 +275,98 @@ func (wg *steamWishlistGetter) GetSteamWishlist(
 	}, nil
 }
 
+type steamOwnedGamesGetter struct {
+	cfg        *config.SteamConfig
+	httpClient service.HTTPClient
+}
+
+var _ service.SteamOwnedGamesGetter = (*steamOwnedGamesGetter)(nil)
+
+// Generate a new SteamOwnedGamesGetter
+func NewSteamOwnedGamesGetter(
+	cfg *config.SteamConfig,
+	httpClient *retryableHTTPClient,
+) *steamOwnedGamesGetter {
+	return &steamOwnedGamesGetter{
+		cfg:        cfg,
+		httpClient: httpClient,
+	}
+}
+
+// Get owned games of a Steam account with the Steam Web API
+//
+// [FYI]
+// IPlayerService/GetOwnedGames requires the Steam Web API key.
+// Therefore, no owned games are returned without sending a request if the key is not set
+func (og *steamOwnedGamesGetter) GetSteamOwnedGames(
+	ctx context.Context,
+	input *service.GetSteamOwnedGamesInput,
+) (*service.GetSteamOwnedGamesOutput, error) {
+	if og.cfg.SteamWebAPIKey == "" {
+		slog.WarnContext(ctx, "skipped getting owned games because the Steam Web API key is not set")
+		return &service.GetSteamOwnedGamesOutput{
+			OwnedGames: &model.SteamOwnedGames{
+				Response: &model.SteamOwnedGamesResponse{},
+			},
+		}, nil
+	}
+
+	if !model.IsSteamID64(input.SteamID64) {
+		err := fmt.Errorf("%w: %s", errInvalidSteamUserID, input.SteamID64)
+		slog.ErrorContext(ctx, "failed to validate a Steam user ID", slog.Any("error", err))
+		return nil, err
+	}
+
+	reqURL, err := url.Parse(steamOwnedGamesURL)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build a Steam Web API URL", slog.Any("error", err))
+		return nil, err
+	}
+
+	q := reqURL.Query()
+	q.Set("key", og.cfg.SteamWebAPIKey)
+	q.Set("steamid", input.SteamID64)
+	q.Set("include_played_free_games", "true")
+	reqURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create a Steam Web API request", slog.Any("error", err))
+		return nil, err
+	}
+
+	res, err := og.httpClient.Do(req)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to send a Steam Web API request", slog.Any("error", err))
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		slog.ErrorContext(
+			ctx,
+			"unexpected status code in the Steam Web API response",
+			slog.Any("status_code", res.StatusCode),
+		)
+		return nil, errUnexpectedStatusCode
+	}
+
+	ownedGames := &model.SteamOwnedGames{}
+	if err := json.NewDecoder(res.Body).Decode(ownedGames); err != nil {
+		slog.ErrorContext(ctx, "failed to unmarshal a Steam Web API response", slog.Any("error", err))
+		return nil, err
+	}
+
+	// The response is empty if the game details of the Steam Community profile are private
+	if ownedGames.Response == nil {
+		ownedGames.Response = &model.SteamOwnedGamesResponse{}
+	}
+
+	return &service.GetSteamOwnedGamesOutput{
+		OwnedGames: ownedGames,
+	}, nil
+}
+
 type steamVideoGameDetailsGetter struct {
 	cfg        *config.SteamConfig
 	httpClient service.HTTPClient
@@ -98,7 +377,7 @@ var _ service.SteamVideoGameDetailsGetter = (*steamVideoGameDetailsGetter)(nil)
 // Generate a new SteamVideoGameDetailsGetter
 func NewSteamVideoGameDetailsGetter(
 	cfg *config.SteamConfig,
-	httpClient service.HTTPClient,
+	httpClient *retryableHTTPClient,
 ) *steamVideoGameDetailsGetter {
 	return &steamVideoGameDetailsGetter{
 		cfg:        cfg,
@@ -122,7 +401,7 @@ func (vg *steamVideoGameDetailsGetter) GetSteamVideoGameDetails(
 	}
 
 	q := reqURL.Query()
-	q.Set("cc", "jp")
+	q.Set("cc", vg.cfg.SteamCountryCode)
 	q.Set("appids", strconv.FormatUint(uint64(input.AppID), 10))
 	reqURL.RawQuery = q.Encode()
 
@@ -158,42 +437,379 @@ func (vg *steamVideoGameDetailsGetter) GetSteamVideoGameDetails(
 	// Unmarshal the response
 	//
 	// [FYI]
-	// Map is used because the response does not have fixed name keys
-	videoGameDetails := make(map[string]any, 1)
-	decoder := json.NewDecoder(res.Body)
-	decoder.UseNumber()
-	if err := decoder.Decode(&videoGameDetails); err != nil {
-		slog.ErrorContext(
+	// A malformed response is reported as ErrSteamMalformedResponse so that the video game can be skipped
+	var videoGameDetails model.SteamStoreVideoGameDetailsResponse
+	if err := json.NewDecoder(res.Body).Decode(&videoGameDetails); err != nil {
+		slog.WarnContext(
 			ctx,
 			"failed to unmarshal a Steam Store video game details response",
+			slog.Any("app_id", input.AppID),
 			slog.Any("error", err),
 		)
-		return nil, err
+		return nil, fmt.Errorf("%w: %w", model.ErrSteamMalformedResponse, err)
 	}
 
 	// Extract the data from the response
-	appID := videoGameDetails[strconv.FormatUint(uint64(input.AppID), 10)].(map[string]any)
-	data := appID["data"].(map[string]any)
-	releaseDate := data["release_date"].(map[string]any)
+	result, ok := videoGameDetails[strconv.FormatUint(uint64(input.AppID), 10)]
+	if !ok || result == nil {
+		slog.WarnContext(ctx, "no result in the Steam Store video game details response", slog.Any("app_id", input.AppID))
+		return nil, model.ErrSteamMalformedResponse
+	}
+
+	if !result.Success {
+		return nil, checkVideoGameAvailability(ctx, vg.cfg, vg.httpClient, input.AppID)
+	}
 
-	steamCurrentPrice := &model.SteamCurrentPrice{}
-	if data["price_overview"] == nil {
-		// The current price of a video game is set to nil if the price is not available
-		// e.g. free-to-play games, bundle games, games that are not sold yet, etc.
-		steamCurrentPrice = nil
-	} else {
-		priceOverview := data["price_overview"].(map[string]any)
-		steamCurrentPrice.Number = priceOverview["final"].(json.Number)
+	data := result.Data
+	if data == nil || data.Name == "" || data.ReleaseDate == nil {
+		slog.WarnContext(ctx, "missing data in the Steam Store video game details response", slog.Any("app_id", input.AppID))
+		return nil, model.ErrSteamMalformedResponse
 	}
 
 	return &service.GetSteamVideoGameDetailsOutput{
 		VideoGameDetails: &model.SteamStoreVideoGameDetails{
-			AppID:        input.AppID,
-			Title:        data["name"].(string),
-			CurrentPrice: steamCurrentPrice,
+			AppID: input.AppID,
+			Title: data.Name,
+			// The current price of a video game is set to nil if the price is not available
+			// e.g. free-to-play games, bundle games, games that are not sold yet, etc.
+			CurrentPrice: data.PriceOverview.ToSteamCurrentPrice(),
 			ReleaseDate: &model.SteamReleaseDate{
-				Date: releaseDate["date"].(string),
+				Date: data.ReleaseDate.Date,
 			},
 		},
 	}, nil
 }
+
+type steamReviewSummaryGetter struct {
+	cfg        *config.SteamConfig
+	httpClient service.HTTPClient
+}
+
+var _ service.SteamReviewSummaryGetter = (*steamReviewSummaryGetter)(nil)
+
+// Generate a new SteamReviewSummaryGetter
+func NewSteamReviewSummaryGetter(
+	cfg *config.SteamConfig,
+	httpClient *retryableHTTPClient,
+) *steamReviewSummaryGetter {
+	return &steamReviewSummaryGetter{
+		cfg:        cfg,
+		httpClient: httpClient,
+	}
+}
+
+// Get a review summary of a video game from the Steam Store
+//
+// [FYI]
+// Reviews in all languages and of all purchase types are summarized, and no reviews themselves are requested
+func (rs *steamReviewSummaryGetter) GetSteamReviewSummary(
+	ctx context.Context,
+	input *service.GetSteamReviewSummaryInput,
+) (*service.GetSteamReviewSummaryOutput, error) {
+	reqURL, err := url.Parse(steamStoreReviewsURL + strconv.FormatUint(uint64(input.AppID), 10))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build a Steam Store reviews URL", slog.Any("error", err))
+		return nil, err
+	}
+
+	q := reqURL.Query()
+	q.Set("json", "1")
+	q.Set("language", "all")
+	q.Set("purchase_type", "all")
+	q.Set("num_per_page", "0")
+	reqURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create a Steam Store reviews request", slog.Any("error", err))
+		return nil, err
+	}
+
+	res, err := rs.httpClient.Do(req)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to send a Steam Store reviews request", slog.Any("error", err))
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		slog.ErrorContext(
+			ctx,
+			"unexpected status code in the Steam Store reviews response",
+			slog.Any("status_code", res.StatusCode),
+		)
+		return nil, errUnexpectedStatusCode
+	}
+
+	var reviews model.SteamStoreReviewsResponse
+	if err := json.NewDecoder(res.Body).Decode(&reviews); err != nil {
+		slog.ErrorContext(ctx, "failed to unmarshal a Steam Store reviews response", slog.Any("error", err))
+		return nil, err
+	}
+
+	if reviews.Success != 1 || reviews.QuerySummary == nil {
+		slog.WarnContext(ctx, "no summary in the Steam Store reviews response", slog.Any("app_id", input.AppID))
+		return nil, model.ErrSteamMalformedResponse
+	}
+
+	return &service.GetSteamReviewSummaryOutput{
+		ReviewSummary: reviews.QuerySummary,
+	}, nil
+}
+
+// Check why a video game is not available on the Steam Store
+//
+// [FYI]
+// The Steam Store returns "success": false for both region-locked and delisted video games.
+// They are distinguished by requesting the same video game in another region.
+// A failure of the check (e.g. a transport error or 5xx) is reported as ErrSteamVideoGameAvailabilityUnknown
+// so that the video game can be skipped, unless the context is done
+func checkVideoGameAvailability(
+	ctx context.Context,
+	cfg *config.SteamConfig,
+	httpClient service.HTTPClient,
+	appID model.SteamAppID,
+) error {
+	err := requestVideoGameAvailability(ctx, cfg, httpClient, appID)
+	if err == nil || model.IsSteamVideoGameUnavailable(err) || ctx.Err() != nil {
+		return err
+	}
+
+	slog.WarnContext(
+		ctx,
+		"failed to check the availability of a video game",
+		slog.Any("app_id", appID),
+		slog.Any("error", err),
+	)
+	return fmt.Errorf("%w: %w", model.ErrSteamVideoGameAvailabilityUnknown, err)
+}
+
+// Request a video game in another region to check why it is not available on the Steam Store
+func requestVideoGameAvailability(
+	ctx context.Context,
+	cfg *config.SteamConfig,
+	httpClient service.HTTPClient,
+	appID model.SteamAppID,
+) error {
+	countryCode := fallbackCountryCode
+	if strings.EqualFold(cfg.SteamCountryCode, fallbackCountryCode) {
+		countryCode = secondFallbackCountryCode
+	}
+
+	reqURL, err := url.Parse(steamStoreVideoGameDetailsURL)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build a Steam Store video game availability URL", slog.Any("error", err))
+		return err
+	}
+
+	q := reqURL.Query()
+	q.Set("cc", countryCode)
+	q.Set("filters", "basic")
+	q.Set("appids", strconv.FormatUint(uint64(appID), 10))
+	reqURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create a Steam Store video game availability request", slog.Any("error", err))
+		return err
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to send a Steam Store video game availability request", slog.Any("error", err))
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		slog.ErrorContext(
+			ctx,
+			"unexpected status code in the Steam Store video game availability response",
+			slog.Any("status_code", res.StatusCode),
+		)
+		return errUnexpectedStatusCode
+	}
+
+	var availability model.SteamStoreVideoGameDetailsResponse
+	if err := json.NewDecoder(res.Body).Decode(&availability); err != nil {
+		slog.WarnContext(ctx, "failed to unmarshal a Steam Store video game availability response", slog.Any("error", err))
+		return fmt.Errorf("%w: %w", model.ErrSteamMalformedResponse, err)
+	}
+
+	if result, ok := availability[strconv.FormatUint(uint64(appID), 10)]; ok && result != nil && result.Success {
+		slog.WarnContext(ctx, "a video game is not available in the region", slog.Any("app_id", appID))
+		return model.ErrSteamVideoGameUnavailableInRegion
+	}
+
+	slog.WarnContext(ctx, "a video game is delisted from the Steam Store", slog.Any("app_id", appID))
+	return model.ErrSteamVideoGameDelisted
+}
+
+type steamVideoGamePricesGetter struct {
+	cfg        *config.SteamConfig
+	httpClient service.HTTPClient
+}
+
+var _ service.SteamVideoGamePricesGetter = (*steamVideoGamePricesGetter)(nil)
+
+// Generate a new SteamVideoGamePricesGetter
+func NewSteamVideoGamePricesGetter(
+	cfg *config.SteamConfig,
+	httpClient *retryableHTTPClient,
+) *steamVideoGamePricesGetter {
+	return &steamVideoGamePricesGetter{
+		cfg:        cfg,
+		httpClient: httpClient,
+	}
+}
+
+// Get prices of video games from the Steam Store in batches
+//
+// [FYI]
+// The app details API accepts multiple app IDs only when it is filtered by price overviews.
+// The app IDs are divided into batches, and a batch is split into halves when the Steam Store rejects it.
+// A video game rejected on its own is reported as unavailable.
+func (pg *steamVideoGamePricesGetter) GetSteamVideoGamePrices(
+	ctx context.Context,
+	input *service.GetSteamVideoGamePricesInput,
+) (*service.GetSteamVideoGamePricesOutput, error) {
+	output := &service.GetSteamVideoGamePricesOutput{
+		VideoGamePrices:       make(map[model.SteamAppID]*model.SteamCurrentPrice, len(input.AppIDs)),
+		UnavailableVideoGames: make(map[model.SteamAppID]error),
+	}
+	for appIDs := range slices.Chunk(input.AppIDs, maxAppIDsPerPricesRequest) {
+		if err := pg.getSteamVideoGamePrices(ctx, appIDs, output); err != nil {
+			slog.ErrorContext(ctx, "failed to get prices of video games from the Steam Store", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+// Get prices of video games in a batch and split the batch into halves if it is rejected
+func (pg *steamVideoGamePricesGetter) getSteamVideoGamePrices(
+	ctx context.Context,
+	appIDs []model.SteamAppID,
+	output *service.GetSteamVideoGamePricesOutput,
+) error {
+	priceOverviews, err := pg.requestSteamVideoGamePrices(ctx, appIDs)
+	if errors.Is(err, errBatchRejected) && len(appIDs) > 1 {
+		slog.WarnContext(
+			ctx,
+			"a batch is rejected by the Steam Store, so it is split into halves",
+			slog.Int("batch_size", len(appIDs)),
+		)
+
+		half := len(appIDs) / 2
+		if err := pg.getSteamVideoGamePrices(ctx, appIDs[:half], output); err != nil {
+			return err
+		}
+
+		return pg.getSteamVideoGamePrices(ctx, appIDs[half:], output)
+	}
+	if errors.Is(err, errBatchRejected) {
+		// A video game which is rejected on its own is skipped not to stop the other video games
+		slog.WarnContext(ctx, "a video game is rejected by the Steam Store", slog.Any("app_id", appIDs[0]))
+		output.UnavailableVideoGames[appIDs[0]] = model.ErrSteamMalformedResponse
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, appID := range appIDs {
+		result, ok := priceOverviews[strconv.FormatUint(uint64(appID), 10)]
+		if !ok || result == nil {
+			slog.WarnContext(ctx, "no result in the Steam Store video game prices response", slog.Any("app_id", appID))
+			output.UnavailableVideoGames[appID] = model.ErrSteamMalformedResponse
+			continue
+		}
+
+		if !result.Success {
+			err := checkVideoGameAvailability(ctx, pg.cfg, pg.httpClient, appID)
+			if !model.IsSteamVideoGameUnavailable(err) {
+				return err
+			}
+
+			output.UnavailableVideoGames[appID] = err
+			continue
+		}
+
+		var priceOverview *model.SteamStorePriceOverview
+		if result.Data != nil {
+			priceOverview = result.Data.PriceOverview
+		}
+		output.VideoGamePrices[appID] = priceOverview.ToSteamCurrentPrice()
+	}
+
+	return nil
+}
+
+// Send a request to get prices of video games in a batch
+func (pg *steamVideoGamePricesGetter) requestSteamVideoGamePrices(
+	ctx context.Context,
+	appIDs []model.SteamAppID,
+) (model.SteamStorePriceOverviewResponse, error) {
+	reqURL, err := url.Parse(steamStoreVideoGameDetailsURL)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build a Steam Store video game prices URL", slog.Any("error", err))
+		return nil, err
+	}
+
+	formattedAppIDs := make([]string, 0, len(appIDs))
+	for _, appID := range appIDs {
+		formattedAppIDs = append(formattedAppIDs, strconv.FormatUint(uint64(appID), 10))
+	}
+
+	q := reqURL.Query()
+	q.Set("cc", pg.cfg.SteamCountryCode)
+	q.Set("filters", "price_overview")
+	q.Set("appids", strings.Join(formattedAppIDs, ","))
+	reqURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create a Steam Store video game prices request", slog.Any("error", err))
+		return nil, err
+	}
+
+	res, err := pg.httpClient.Do(req)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to send a Steam Store video game prices request", slog.Any("error", err))
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusBadRequest {
+		return nil, errBatchRejected
+	}
+
+	if res.StatusCode != http.StatusOK {
+		slog.ErrorContext(
+			ctx,
+			"unexpected status code in the Steam Store video game prices response",
+			slog.Any("status_code", res.StatusCode),
+		)
+		return nil, errUnexpectedStatusCode
+	}
+
+	// Unmarshal the response
+	//
+	// [FYI]
+	// The Steam Store returns "null" instead of an object if it rejects a batch
+	var priceOverviews model.SteamStorePriceOverviewResponse
+	if err := json.NewDecoder(res.Body).Decode(&priceOverviews); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to unmarshal a Steam Store video game prices response",
+			slog.Any("error", err),
+		)
+		return nil, err
+	}
+	if priceOverviews == nil {
+		return nil, errBatchRejected
+	}
+
+	return priceOverviews, nil
+}
@@ -13,9 +13,201 @@ import (
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"go.uber.org/mock/gomock"
 )
 
+func TestResolveSteamUserID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Positive case: A SteamID64 is returned without any requests", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{}
+		r := NewSteamUserIDResolver(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.ResolveSteamUserIDInput{
+			SteamUserID: "76561197960287930",
+		}
+		got, err := r.ResolveSteamUserID(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.ResolveSteamUserIDOutput{
+			SteamID64: "76561197960287930",
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: Successfully resolve a vanity name with the Steam Web API", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				got := req.URL.String()
+				want := "https://api.steampowered.com/ISteamUser/ResolveVanityURL/v1/?key=dummy_steam_web_api_key&vanityurl=dummy_vanity_name"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"response":{"steamid":"76561197960287930","success":1}}`)),
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamWebAPIKey: "dummy_steam_web_api_key",
+		}
+		r := NewSteamUserIDResolver(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.ResolveSteamUserIDInput{
+			SteamUserID: "dummy_vanity_name",
+		}
+		got, err := r.ResolveSteamUserID(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.ResolveSteamUserIDOutput{
+			SteamID64: "76561197960287930",
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: Successfully resolve a vanity name without the Steam Web API key", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				got := req.URL.String()
+				want := "https://steamcommunity.com/id/dummy_vanity_name?xml=1"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				body := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+					`<profile><steamID64>76561197960287930</steamID64><steamID><![CDATA[Rabscuttle]]></steamID></profile>`
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(body)),
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{}
+		r := NewSteamUserIDResolver(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.ResolveSteamUserIDInput{
+			SteamUserID: "dummy_vanity_name",
+		}
+		got, err := r.ResolveSteamUserID(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.ResolveSteamUserIDOutput{
+			SteamID64: "76561197960287930",
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Negative case: No profile matches a vanity name on the Steam Web API", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"response":{"success":42,"message":"No match"}}`)),
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamWebAPIKey: "dummy_steam_web_api_key",
+		}
+		r := NewSteamUserIDResolver(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.ResolveSteamUserIDInput{
+			SteamUserID: "dummy_vanity_name",
+		}
+		if _, gotErr := r.ResolveSteamUserID(ctx, input); !errors.Is(gotErr, errSteamUserNotFound) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errSteamUserNotFound)
+		}
+	})
+
+	t.Run("Negative case: No profile matches a vanity name on the Steam Community", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(bytes.NewBufferString(
+					`<response><error><![CDATA[The specified profile could not be found.]]></error></response>`,
+				)),
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{}
+		r := NewSteamUserIDResolver(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.ResolveSteamUserIDInput{
+			SteamUserID: "dummy_vanity_name",
+		}
+		if _, gotErr := r.ResolveSteamUserID(ctx, input); !errors.Is(gotErr, errSteamUserNotFound) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errSteamUserNotFound)
+		}
+	})
+
+	t.Run("Negative case: A Steam user ID is neither a SteamID64 nor a vanity name", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{}
+		r := NewSteamUserIDResolver(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.ResolveSteamUserIDInput{
+			SteamUserID: "dummy vanity name?",
+		}
+		if _, gotErr := r.ResolveSteamUserID(ctx, input); !errors.Is(gotErr, errInvalidSteamUserID) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errInvalidSteamUserID)
+		}
+	})
+}
+
 func TestGetSteamWishlist(t *testing.T) {
 	t.Parallel()
 
@@ -30,7 +222,7 @@ func TestGetSteamWishlist(t *testing.T) {
 			Do(gomock.Any()).
 			DoAndReturn(func(req *http.Request) (*http.Response, error) {
 				got := req.URL.String()
-				want := "https://api.steampowered.com/IWishlistService/GetWishlist/v1/?steamid=dummy_steam_user_id"
+				want := "https://api.steampowered.com/IWishlistService/GetWishlist/v1/?steamid=76561197960287930"
 				if diff := cmp.Diff(got, want); diff != "" {
 					t.Errorf("got(-) want(+)\n%s", diff)
 				}
@@ -55,10 +247,13 @@ func TestGetSteamWishlist(t *testing.T) {
 		// Execute the method to be tested
 		ctx := t.Context()
 		cfg := &config.SteamConfig{
-			SteamUserID: "dummy_steam_user_id",
+			SteamUserIDs: []string{"76561197960287930"},
+		}
+		wg := NewSteamWishlistGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamWishlistInput{
+			SteamUserID: "76561197960287930",
 		}
-		wg := NewSteamWishlistGetter(cfg, m)
-		got, err := wg.GetSteamWishlist(ctx, &service.GetSteamWishlistInput{})
+		got, err := wg.GetSteamWishlist(ctx, input)
 		if err != nil {
 			t.Errorf("\ngot: %v\nwant: %v", err, nil)
 		}
@@ -67,10 +262,14 @@ func TestGetSteamWishlist(t *testing.T) {
 				Response: &model.SteamStoreResponse{
 					Items: []*model.SteamStoreItem{
 						{
-							AppID: 105600,
+							AppID:     105600,
+							Priority:  0,
+							DateAdded: 1714468758,
 						},
 						{
-							AppID: 251570,
+							AppID:     251570,
+							Priority:  1,
+							DateAdded: 1714468748,
 						},
 					},
 				},
@@ -92,7 +291,7 @@ func TestGetSteamWishlist(t *testing.T) {
 			Do(gomock.Any()).
 			DoAndReturn(func(req *http.Request) (*http.Response, error) {
 				got := req.URL.String()
-				want := "https://api.steampowered.com/IWishlistService/GetWishlist/v1/?steamid=dummy_steam_user_id"
+				want := "https://api.steampowered.com/IWishlistService/GetWishlist/v1/?steamid=76561197960287930"
 				if diff := cmp.Diff(got, want); diff != "" {
 					t.Errorf("got(-) want(+)\n%s", diff)
 				}
@@ -117,10 +316,13 @@ func TestGetSteamWishlist(t *testing.T) {
 		// Execute the method to be tested
 		ctx := t.Context()
 		cfg := &config.SteamConfig{
-			SteamUserID: "dummy_steam_user_id",
+			SteamUserIDs: []string{"76561197960287930"},
 		}
-		wg := NewSteamWishlistGetter(cfg, m)
-		got, err := wg.GetSteamWishlist(ctx, &service.GetSteamWishlistInput{})
+		wg := NewSteamWishlistGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamWishlistInput{
+			SteamUserID: "76561197960287930",
+		}
+		got, err := wg.GetSteamWishlist(ctx, input)
 		if err != nil {
 			t.Errorf("\ngot: %v\nwant: %v", err, nil)
 		}
@@ -148,7 +350,7 @@ func TestGetSteamWishlist(t *testing.T) {
 			Do(gomock.Any()).
 			DoAndReturn(func(req *http.Request) (*http.Response, error) {
 				got := req.URL.String()
-				want := "https://api.steampowered.com/IWishlistService/GetWishlist/v1/?steamid=dummy_steam_user_id"
+				want := "https://api.steampowered.com/IWishlistService/GetWishlist/v1/?steamid=76561197960287930"
 				if diff := cmp.Diff(got, want); diff != "" {
 					t.Errorf("got(-) want(+)\n%s", diff)
 				}
@@ -159,10 +361,13 @@ func TestGetSteamWishlist(t *testing.T) {
 		// Execute the method to be tested
 		ctx := t.Context()
 		cfg := &config.SteamConfig{
-			SteamUserID: "dummy_steam_user_id",
+			SteamUserIDs: []string{"76561197960287930"},
+		}
+		wg := NewSteamWishlistGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamWishlistInput{
+			SteamUserID: "76561197960287930",
 		}
-		wg := NewSteamWishlistGetter(cfg, m)
-		if _, gotErr := wg.GetSteamWishlist(ctx, &service.GetSteamWishlistInput{}); !errors.Is(gotErr, wantErr) {
+		if _, gotErr := wg.GetSteamWishlist(ctx, input); !errors.Is(gotErr, wantErr) {
 			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
 		}
 	})
@@ -178,7 +383,7 @@ func TestGetSteamWishlist(t *testing.T) {
 			Do(gomock.Any()).
 			DoAndReturn(func(req *http.Request) (*http.Response, error) {
 				got := req.URL.String()
-				want := "https://api.steampowered.com/IWishlistService/GetWishlist/v1/?steamid=dummy_steam_user_id"
+				want := "https://api.steampowered.com/IWishlistService/GetWishlist/v1/?steamid=76561197960287930"
 				if diff := cmp.Diff(got, want); diff != "" {
 					t.Errorf("got(-) want(+)\n%s", diff)
 				}
@@ -192,11 +397,14 @@ func TestGetSteamWishlist(t *testing.T) {
 		// Execute the method to be tested
 		ctx := t.Context()
 		cfg := &config.SteamConfig{
-			SteamUserID: "dummy_steam_user_id",
+			SteamUserIDs: []string{"76561197960287930"},
+		}
+		wg := NewSteamWishlistGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamWishlistInput{
+			SteamUserID: "76561197960287930",
 		}
-		wg := NewSteamWishlistGetter(cfg, m)
 		wantErr := errUnexpectedStatusCode
-		if _, gotErr := wg.GetSteamWishlist(ctx, &service.GetSteamWishlistInput{}); !errors.Is(gotErr, wantErr) {
+		if _, gotErr := wg.GetSteamWishlist(ctx, input); !errors.Is(gotErr, wantErr) {
 			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
 		}
 	})
@@ -212,7 +420,7 @@ func TestGetSteamWishlist(t *testing.T) {
 			Do(gomock.Any()).
 			DoAndReturn(func(req *http.Request) (*http.Response, error) {
 				got := req.URL.String()
-				want := "https://api.steampowered.com/IWishlistService/GetWishlist/v1/?steamid=dummy_steam_user_id"
+				want := "https://api.steampowered.com/IWishlistService/GetWishlist/v1/?steamid=76561197960287930"
 				if diff := cmp.Diff(got, want); diff != "" {
 					t.Errorf("got(-) want(+)\n%s", diff)
 				}
@@ -226,19 +434,43 @@ func TestGetSteamWishlist(t *testing.T) {
 		// Execute the method to be tested
 		ctx := t.Context()
 		cfg := &config.SteamConfig{
-			SteamUserID: "dummy_steam_user_id",
+			SteamUserIDs: []string{"76561197960287930"},
+		}
+		wg := NewSteamWishlistGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamWishlistInput{
+			SteamUserID: "76561197960287930",
 		}
-		wg := NewSteamWishlistGetter(cfg, m)
-		if _, err := wg.GetSteamWishlist(ctx, &service.GetSteamWishlistInput{}); err == nil {
+		if _, err := wg.GetSteamWishlist(ctx, input); err == nil {
 			t.Errorf("\ngot: %v\nwant: an error generated by the library", nil)
 		}
 	})
+
+	t.Run("Negative case: A Steam user ID is not a SteamID64", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs: []string{"dummy_vanity_name"},
+		}
+		wg := NewSteamWishlistGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamWishlistInput{
+			SteamUserID: "dummy_vanity_name",
+		}
+		if _, gotErr := wg.GetSteamWishlist(ctx, input); !errors.Is(gotErr, errInvalidSteamUserID) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errInvalidSteamUserID)
+		}
+	})
 }
 
-func TestGetSteamVideoGameDetails(t *testing.T) {
+func TestGetSteamOwnedGames(t *testing.T) {
 	t.Parallel()
 
-	t.Run("Positive case: Successfully get video game details from the Steam Store", func(t *testing.T) {
+	t.Run("Positive case: Successfully get owned games of a Steam account", func(t *testing.T) {
 		t.Parallel()
 
 		// Create a mock for the HTTP client
@@ -249,20 +481,21 @@ func TestGetSteamVideoGameDetails(t *testing.T) {
 			Do(gomock.Any()).
 			DoAndReturn(func(req *http.Request) (*http.Response, error) {
 				got := req.URL.String()
-				want := "https://store.steampowered.com/api/appdetails/?appids=2701660&cc=jp"
+				want := "https://api.steampowered.com/IPlayerService/GetOwnedGames/v1/" +
+					"?include_played_free_games=true&key=dummy_steam_web_api_key&steamid=76561197960287930"
 				if diff := cmp.Diff(got, want); diff != "" {
 					t.Errorf("got(-) want(+)\n%s", diff)
 				}
 
-				jsonFile, err := os.Open("./testdata/video_game_details.json")
+				jsonFile, err := os.Open("./testdata/owned_games.json")
 				if err != nil {
-					t.Fatalf("failed to open video_game_details.json: %v", err)
+					t.Fatalf("failed to open owned_games.json: %v", err)
 				}
 				defer jsonFile.Close()
 
 				buffer := bytes.Buffer{}
 				if _, err := io.Copy(&buffer, jsonFile); err != nil {
-					t.Fatalf("failed to read video_game_details.json: %v", err)
+					t.Fatalf("failed to read owned_games.json: %v", err)
 				}
 
 				return &http.Response{
@@ -271,28 +504,31 @@ func TestGetSteamVideoGameDetails(t *testing.T) {
 				}, nil
 			})
 
-		// Execute the method to be tested (Skip checking the response)
+		// Execute the method to be tested
 		ctx := t.Context()
 		cfg := &config.SteamConfig{
-			SteamUserID: "dummy_steam_user_id",
+			SteamWebAPIKey: "dummy_steam_web_api_key",
 		}
-		vg := NewSteamVideoGameDetailsGetter(cfg, m)
-		input := &service.GetSteamVideoGameDetailsInput{
-			AppID: 2701660,
+		og := NewSteamOwnedGamesGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamOwnedGamesInput{
+			SteamID64: "76561197960287930",
 		}
-		got, err := vg.GetSteamVideoGameDetails(ctx, input)
+		got, err := og.GetSteamOwnedGames(ctx, input)
 		if err != nil {
 			t.Errorf("\ngot: %v\nwant: %v", err, nil)
 		}
-		want := &service.GetSteamVideoGameDetailsOutput{
-			VideoGameDetails: &model.SteamStoreVideoGameDetails{
-				AppID: 2701660,
-				Title: "DRAGON QUEST III HD-2D Remake",
-				CurrentPrice: &model.SteamCurrentPrice{
-					Number: "767800",
-				},
-				ReleaseDate: &model.SteamReleaseDate{
-					Date: "14 Nov, 2024",
+		want := &service.GetSteamOwnedGamesOutput{
+			OwnedGames: &model.SteamOwnedGames{
+				Response: &model.SteamOwnedGamesResponse{
+					GameCount: 2,
+					Games: []*model.SteamOwnedGame{
+						{
+							AppID: 105600,
+						},
+						{
+							AppID: 251570,
+						},
+					},
 				},
 			},
 		}
@@ -301,37 +537,68 @@ func TestGetSteamVideoGameDetails(t *testing.T) {
 		}
 	})
 
-	t.Run("Negative case: Fail to send a request", func(t *testing.T) {
+	t.Run("Positive case: No owned games are returned for a private profile", func(t *testing.T) {
 		t.Parallel()
 
 		// Create a mock for the HTTP client
 		ctrl := gomock.NewController(t)
 		m := httpclient.NewMockHTTPClient(ctrl)
-		wantErr := errors.New("unexpected error")
 		m.
 			EXPECT().
 			Do(gomock.Any()).
-			DoAndReturn(func(req *http.Request) (*http.Response, error) {
-				got := req.URL.String()
-				want := "https://store.steampowered.com/api/appdetails/?appids=2701660&cc=jp"
-				if diff := cmp.Diff(got, want); diff != "" {
-					t.Errorf("got(-) want(+)\n%s", diff)
-				}
-
-				return nil, wantErr
-			})
+			Return(&http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"response":{}}`)),
+			}, nil)
 
 		// Execute the method to be tested
 		ctx := t.Context()
 		cfg := &config.SteamConfig{
-			SteamUserID: "dummy_steam_user_id",
+			SteamWebAPIKey: "dummy_steam_web_api_key",
 		}
-		vg := NewSteamVideoGameDetailsGetter(cfg, m)
-		input := &service.GetSteamVideoGameDetailsInput{
-			AppID: 2701660,
+		og := NewSteamOwnedGamesGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamOwnedGamesInput{
+			SteamID64: "76561197960287930",
 		}
-		if _, gotErr := vg.GetSteamVideoGameDetails(ctx, input); !errors.Is(gotErr, wantErr) {
-			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		got, err := og.GetSteamOwnedGames(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetSteamOwnedGamesOutput{
+			OwnedGames: &model.SteamOwnedGames{
+				Response: &model.SteamOwnedGamesResponse{},
+			},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: No requests are sent without the Steam Web API key", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{}
+		og := NewSteamOwnedGamesGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamOwnedGamesInput{
+			SteamID64: "76561197960287930",
+		}
+		got, err := og.GetSteamOwnedGames(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetSteamOwnedGamesOutput{
+			OwnedGames: &model.SteamOwnedGames{
+				Response: &model.SteamOwnedGamesResponse{},
+			},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
 		}
 	})
 
@@ -344,35 +611,30 @@ func TestGetSteamVideoGameDetails(t *testing.T) {
 		m.
 			EXPECT().
 			Do(gomock.Any()).
-			DoAndReturn(func(req *http.Request) (*http.Response, error) {
-				got := req.URL.String()
-				want := "https://store.steampowered.com/api/appdetails/?appids=2701660&cc=jp"
-				if diff := cmp.Diff(got, want); diff != "" {
-					t.Errorf("got(-) want(+)\n%s", diff)
-				}
-
-				return &http.Response{
-					StatusCode: http.StatusInternalServerError,
-					Body:       http.NoBody,
-				}, nil
-			})
+			Return(&http.Response{
+				StatusCode: http.StatusForbidden,
+				Body:       io.NopCloser(bytes.NewBufferString("")),
+			}, nil)
 
 		// Execute the method to be tested
 		ctx := t.Context()
 		cfg := &config.SteamConfig{
-			SteamUserID: "dummy_steam_user_id",
+			SteamWebAPIKey: "dummy_steam_web_api_key",
 		}
-		vg := NewSteamVideoGameDetailsGetter(cfg, m)
-		input := &service.GetSteamVideoGameDetailsInput{
-			AppID: 2701660,
+		og := NewSteamOwnedGamesGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamOwnedGamesInput{
+			SteamID64: "76561197960287930",
 		}
-		wantErr := errUnexpectedStatusCode
-		if _, gotErr := vg.GetSteamVideoGameDetails(ctx, input); !errors.Is(gotErr, wantErr) {
-			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		if _, gotErr := og.GetSteamOwnedGames(ctx, input); !errors.Is(gotErr, errUnexpectedStatusCode) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errUnexpectedStatusCode)
 		}
 	})
+}
 
-	t.Run("Negative case: Fail to unmarshal a response", func(t *testing.T) {
+func TestGetSteamVideoGameDetails(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Positive case: Successfully get video game details from the Steam Store", func(t *testing.T) {
 		t.Parallel()
 
 		// Create a mock for the HTTP client
@@ -388,23 +650,873 @@ func TestGetSteamVideoGameDetails(t *testing.T) {
 					t.Errorf("got(-) want(+)\n%s", diff)
 				}
 
+				jsonFile, err := os.Open("./testdata/video_game_details.json")
+				if err != nil {
+					t.Fatalf("failed to open video_game_details.json: %v", err)
+				}
+				defer jsonFile.Close()
+
+				buffer := bytes.Buffer{}
+				if _, err := io.Copy(&buffer, jsonFile); err != nil {
+					t.Fatalf("failed to read video_game_details.json: %v", err)
+				}
+
 				return &http.Response{
 					StatusCode: http.StatusOK,
-					Body:       io.NopCloser(bytes.NewReader([]byte("invalid JSON"))),
+					Body:       io.NopCloser(bytes.NewReader(buffer.Bytes())),
 				}, nil
 			})
 
-		// Execute the method to be tested
+		// Execute the method to be tested (Skip checking the response)
 		ctx := t.Context()
 		cfg := &config.SteamConfig{
-			SteamUserID: "dummy_steam_user_id",
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
 		}
-		vg := NewSteamVideoGameDetailsGetter(cfg, m)
+		vg := NewSteamVideoGameDetailsGetter(cfg, NewRetryableHTTPClient(cfg, m))
 		input := &service.GetSteamVideoGameDetailsInput{
 			AppID: 2701660,
 		}
-		if _, err := vg.GetSteamVideoGameDetails(ctx, input); err == nil {
-			t.Errorf("\ngot: %v\nwant: an error generated by the library", nil)
+		got, err := vg.GetSteamVideoGameDetails(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetSteamVideoGameDetailsOutput{
+			VideoGameDetails: &model.SteamStoreVideoGameDetails{
+				AppID: 2701660,
+				Title: "DRAGON QUEST III HD-2D Remake",
+				CurrentPrice: &model.SteamCurrentPrice{
+					Currency: "JPY",
+					Number:   "767800",
+					Initial:  "767800",
+				},
+				ReleaseDate: &model.SteamReleaseDate{
+					Date: "14 Nov, 2024",
+				},
+			},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Negative case: Fail to send a request", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		wantErr := errors.New("unexpected error")
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				got := req.URL.String()
+				want := "https://store.steampowered.com/api/appdetails/?appids=2701660&cc=jp"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return nil, wantErr
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		vg := NewSteamVideoGameDetailsGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGameDetailsInput{
+			AppID: 2701660,
+		}
+		if _, gotErr := vg.GetSteamVideoGameDetails(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Get a status code except 200", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				got := req.URL.String()
+				want := "https://store.steampowered.com/api/appdetails/?appids=2701660&cc=jp"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusInternalServerError,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		vg := NewSteamVideoGameDetailsGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGameDetailsInput{
+			AppID: 2701660,
+		}
+		wantErr := errUnexpectedStatusCode
+		if _, gotErr := vg.GetSteamVideoGameDetails(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Fail to unmarshal a response", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				got := req.URL.String()
+				want := "https://store.steampowered.com/api/appdetails/?appids=2701660&cc=jp"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte("invalid JSON"))),
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		vg := NewSteamVideoGameDetailsGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGameDetailsInput{
+			AppID: 2701660,
+		}
+		wantErr := model.ErrSteamMalformedResponse
+		if _, gotErr := vg.GetSteamVideoGameDetails(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Get a response without required data", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(`{"2701660": {"success": true, "data": {"name": "DRAGON QUEST III HD-2D Remake"}}}`))),
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		vg := NewSteamVideoGameDetailsGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGameDetailsInput{
+			AppID: 2701660,
+		}
+		wantErr := model.ErrSteamMalformedResponse
+		if _, gotErr := vg.GetSteamVideoGameDetails(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: A video game is not available in the region", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		gomock.InOrder(
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte(`{"2701660": {"success": false}}`))),
+				}, nil),
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				DoAndReturn(func(req *http.Request) (*http.Response, error) {
+					got := req.URL.String()
+					want := "https://store.steampowered.com/api/appdetails/?appids=2701660&cc=us&filters=basic"
+					if diff := cmp.Diff(got, want); diff != "" {
+						t.Errorf("got(-) want(+)\n%s", diff)
+					}
+
+					body := `{"2701660": {"success": true, "data": {"name": "DRAGON QUEST III HD-2D Remake"}}}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+					}, nil
+				}),
+		)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		vg := NewSteamVideoGameDetailsGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGameDetailsInput{
+			AppID: 2701660,
+		}
+		wantErr := model.ErrSteamVideoGameUnavailableInRegion
+		if _, gotErr := vg.GetSteamVideoGameDetails(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: A video game is delisted from the Steam Store", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		gomock.InOrder(
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte(`{"2701660": {"success": false}}`))),
+				}, nil),
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				DoAndReturn(func(req *http.Request) (*http.Response, error) {
+					got := req.URL.String()
+					want := "https://store.steampowered.com/api/appdetails/?appids=2701660&cc=jp&filters=basic"
+					if diff := cmp.Diff(got, want); diff != "" {
+						t.Errorf("got(-) want(+)\n%s", diff)
+					}
+
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(bytes.NewReader([]byte(`{"2701660": {"success": false}}`))),
+					}, nil
+				}),
+		)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "us",
+		}
+		vg := NewSteamVideoGameDetailsGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGameDetailsInput{
+			AppID: 2701660,
+		}
+		wantErr := model.ErrSteamVideoGameDelisted
+		if _, gotErr := vg.GetSteamVideoGameDetails(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+	t.Run("Negative case: Fail to check the availability of a video game", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		gomock.InOrder(
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte(`{"2701660": {"success": false}}`))),
+				}, nil),
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				Return(&http.Response{
+					StatusCode: http.StatusInternalServerError,
+					Body:       http.NoBody,
+				}, nil),
+		)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		vg := NewSteamVideoGameDetailsGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGameDetailsInput{
+			AppID: 2701660,
+		}
+		_, gotErr := vg.GetSteamVideoGameDetails(ctx, input)
+		if !errors.Is(gotErr, model.ErrSteamVideoGameAvailabilityUnknown) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, model.ErrSteamVideoGameAvailabilityUnknown)
+		}
+		if !model.IsSteamVideoGameUnavailable(gotErr) {
+			t.Errorf("\ngot: %v\nwant: %v", false, true)
+		}
+	})
+}
+
+func TestGetSteamVideoGamePrices(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Positive case: Successfully get prices of video games from the Steam Store", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				got := req.URL.String()
+				want := "https://store.steampowered.com/api/appdetails/?appids=2701660%2C105600&cc=jp&filters=price_overview"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				jsonFile, err := os.Open("./testdata/video_game_prices.json")
+				if err != nil {
+					t.Fatalf("failed to open video_game_prices.json: %v", err)
+				}
+				defer jsonFile.Close()
+
+				buffer := bytes.Buffer{}
+				if _, err := io.Copy(&buffer, jsonFile); err != nil {
+					t.Fatalf("failed to read video_game_prices.json: %v", err)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader(buffer.Bytes())),
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		pg := NewSteamVideoGamePricesGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGamePricesInput{
+			AppIDs: []model.SteamAppID{2701660, 105600},
+		}
+		got, err := pg.GetSteamVideoGamePrices(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetSteamVideoGamePricesOutput{
+			VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+				2701660: {
+					Currency:        "JPY",
+					Number:          "767800",
+					Initial:         "1535600",
+					DiscountPercent: 50,
+				},
+				105600: nil,
+			},
+			UnavailableVideoGames: map[model.SteamAppID]error{},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: Split a batch into halves when the Steam Store rejects it", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		gomock.InOrder(
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				DoAndReturn(func(req *http.Request) (*http.Response, error) {
+					got := req.URL.Query().Get("appids")
+					want := "2701660,105600"
+					if diff := cmp.Diff(got, want); diff != "" {
+						t.Errorf("got(-) want(+)\n%s", diff)
+					}
+
+					return &http.Response{
+						StatusCode: http.StatusBadRequest,
+						Body:       http.NoBody,
+					}, nil
+				}),
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				DoAndReturn(func(req *http.Request) (*http.Response, error) {
+					got := req.URL.Query().Get("appids")
+					want := "2701660"
+					if diff := cmp.Diff(got, want); diff != "" {
+						t.Errorf("got(-) want(+)\n%s", diff)
+					}
+
+					body := `{"2701660": {"success": true, "data": {"price_overview": {"currency": "JPY", "final": 767800}}}}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+					}, nil
+				}),
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				DoAndReturn(func(req *http.Request) (*http.Response, error) {
+					got := req.URL.Query().Get("appids")
+					want := "105600"
+					if diff := cmp.Diff(got, want); diff != "" {
+						t.Errorf("got(-) want(+)\n%s", diff)
+					}
+
+					body := `{"105600": {"success": true, "data": []}}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+					}, nil
+				}),
+		)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		pg := NewSteamVideoGamePricesGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGamePricesInput{
+			AppIDs: []model.SteamAppID{2701660, 105600},
+		}
+		got, err := pg.GetSteamVideoGamePrices(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetSteamVideoGamePricesOutput{
+			VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+				2701660: {
+					Currency: "JPY",
+					Number:   "767800",
+				},
+				105600: nil,
+			},
+			UnavailableVideoGames: map[model.SteamAppID]error{},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: Skip video games that cannot be retrieved from the Steam Store", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		gomock.InOrder(
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				DoAndReturn(func(req *http.Request) (*http.Response, error) {
+					body := `{"2701660": {"success": false}}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+					}, nil
+				}),
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				DoAndReturn(func(req *http.Request) (*http.Response, error) {
+					got := req.URL.String()
+					want := "https://store.steampowered.com/api/appdetails/?appids=2701660&cc=us&filters=basic"
+					if diff := cmp.Diff(got, want); diff != "" {
+						t.Errorf("got(-) want(+)\n%s", diff)
+					}
+
+					body := `{"2701660": {"success": false}}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+					}, nil
+				}),
+		)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		pg := NewSteamVideoGamePricesGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGamePricesInput{
+			AppIDs: []model.SteamAppID{2701660, 105600},
+		}
+		got, err := pg.GetSteamVideoGamePrices(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetSteamVideoGamePricesOutput{
+			VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{},
+			UnavailableVideoGames: map[model.SteamAppID]error{
+				2701660: model.ErrSteamVideoGameDelisted,
+				105600:  model.ErrSteamMalformedResponse,
+			},
+		}
+		if diff := cmp.Diff(got, want, cmpopts.EquateErrors()); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: A video game whose availability fails to be checked is reported as unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		wantErr := errors.New("unexpected error")
+		gomock.InOrder(
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(bytes.NewReader([]byte(
+						`{"2701660": {"success": false}, "105600": {"success": true, "data": []}}`,
+					))),
+				}, nil),
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				Return(nil, wantErr),
+		)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		pg := NewSteamVideoGamePricesGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGamePricesInput{
+			AppIDs: []model.SteamAppID{2701660, 105600},
+		}
+		got, err := pg.GetSteamVideoGamePrices(ctx, input)
+		if err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if diff := cmp.Diff(got.VideoGamePrices, map[model.SteamAppID]*model.SteamCurrentPrice{105600: nil}); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+		gotErr := got.UnavailableVideoGames[2701660]
+		if !errors.Is(gotErr, model.ErrSteamVideoGameAvailabilityUnknown) || !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, model.ErrSteamVideoGameAvailabilityUnknown)
+		}
+	})
+
+	t.Run("Negative case: Fail to send a request", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		wantErr := errors.New("unexpected error")
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(nil, wantErr)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		pg := NewSteamVideoGamePricesGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGamePricesInput{
+			AppIDs: []model.SteamAppID{2701660},
+		}
+		if _, gotErr := pg.GetSteamVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Get a status code except 200", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Body:       http.NoBody,
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		pg := NewSteamVideoGamePricesGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGamePricesInput{
+			AppIDs: []model.SteamAppID{2701660},
+		}
+		wantErr := errUnexpectedStatusCode
+		if _, gotErr := pg.GetSteamVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Positive case: A video game rejected on its own is reported as unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		gomock.InOrder(
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				Return(&http.Response{
+					StatusCode: http.StatusBadRequest,
+					Body:       http.NoBody,
+				}, nil),
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				DoAndReturn(func(req *http.Request) (*http.Response, error) {
+					got := req.URL.Query().Get("appids")
+					want := "2701660"
+					if diff := cmp.Diff(got, want); diff != "" {
+						t.Errorf("got(-) want(+)\n%s", diff)
+					}
+
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(bytes.NewReader([]byte("null"))),
+					}, nil
+				}),
+			m.
+				EXPECT().
+				Do(gomock.Any()).
+				DoAndReturn(func(req *http.Request) (*http.Response, error) {
+					got := req.URL.Query().Get("appids")
+					want := "105600"
+					if diff := cmp.Diff(got, want); diff != "" {
+						t.Errorf("got(-) want(+)\n%s", diff)
+					}
+
+					return &http.Response{
+						StatusCode: http.StatusBadRequest,
+						Body:       http.NoBody,
+					}, nil
+				}),
+		)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		pg := NewSteamVideoGamePricesGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGamePricesInput{
+			AppIDs: []model.SteamAppID{2701660, 105600},
+		}
+		got, err := pg.GetSteamVideoGamePrices(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetSteamVideoGamePricesOutput{
+			VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{},
+			UnavailableVideoGames: map[model.SteamAppID]error{
+				2701660: model.ErrSteamMalformedResponse,
+				105600:  model.ErrSteamMalformedResponse,
+			},
+		}
+		if diff := cmp.Diff(got, want, cmpopts.EquateErrors()); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Negative case: Fail to unmarshal a response", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte("invalid JSON"))),
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		pg := NewSteamVideoGamePricesGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamVideoGamePricesInput{
+			AppIDs: []model.SteamAppID{2701660},
+		}
+		if _, err := pg.GetSteamVideoGamePrices(ctx, input); err == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated by the library", nil)
+		}
+	})
+}
+
+func TestGetSteamReviewSummary(t *testing.T) {
+	t.Parallel()
+
+	const wantURL = "https://store.steampowered.com/appreviews/2701660?json=1&language=all&num_per_page=0&purchase_type=all"
+
+	t.Run("Positive case: Successfully get a review summary from the Steam Store", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				if diff := cmp.Diff(req.URL.String(), wantURL); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				jsonFile, err := os.Open("./testdata/review_summary.json")
+				if err != nil {
+					t.Fatalf("failed to open review_summary.json: %v", err)
+				}
+				defer jsonFile.Close()
+
+				buffer := bytes.Buffer{}
+				if _, err := io.Copy(&buffer, jsonFile); err != nil {
+					t.Fatalf("failed to read review_summary.json: %v", err)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader(buffer.Bytes())),
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		rs := NewSteamReviewSummaryGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamReviewSummaryInput{
+			AppID: 2701660,
+		}
+		got, err := rs.GetSteamReviewSummary(ctx, input)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &service.GetSteamReviewSummaryOutput{
+			ReviewSummary: &model.SteamStoreReviewSummary{
+				ReviewScore:     8,
+				ReviewScoreDesc: "Very Positive",
+				TotalPositive:   8734,
+				TotalNegative:   1266,
+				TotalReviews:    10000,
+			},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Negative case: Get a status code except 200", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Body:       http.NoBody,
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		rs := NewSteamReviewSummaryGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamReviewSummaryInput{
+			AppID: 2701660,
+		}
+		wantErr := errUnexpectedStatusCode
+		if _, gotErr := rs.GetSteamReviewSummary(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: A response has no summary", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock for the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(`{"success":2}`))),
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.SteamConfig{
+			SteamUserIDs:     []string{"76561197960287930"},
+			SteamCountryCode: "jp",
+		}
+		rs := NewSteamReviewSummaryGetter(cfg, NewRetryableHTTPClient(cfg, m))
+		input := &service.GetSteamReviewSummaryInput{
+			AppID: 2701660,
+		}
+		wantErr := model.ErrSteamMalformedResponse
+		if _, gotErr := rs.GetSteamReviewSummary(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
 		}
 	})
 }
@@ -7,8 +7,17 @@ import (
 
 // A wire set for the steam package
 var Set = wire.NewSet(
+	NewRetryableHTTPClient,
+	NewSteamUserIDResolver,
 	NewSteamWishlistGetter,
+	NewSteamOwnedGamesGetter,
 	NewSteamVideoGameDetailsGetter,
+	NewSteamVideoGamePricesGetter,
+	NewSteamReviewSummaryGetter,
+	wire.Bind(new(service.SteamUserIDResolver), new(*steamUserIDResolver)),
 	wire.Bind(new(service.SteamWishlistGetter), new(*steamWishlistGetter)),
+	wire.Bind(new(service.SteamOwnedGamesGetter), new(*steamOwnedGamesGetter)),
 	wire.Bind(new(service.SteamVideoGameDetailsGetter), new(*steamVideoGameDetailsGetter)),
+	wire.Bind(new(service.SteamVideoGamePricesGetter), new(*steamVideoGamePricesGetter)),
+	wire.Bind(new(service.SteamReviewSummaryGetter), new(*steamReviewSummaryGetter)),
 )
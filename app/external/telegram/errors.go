@@ -0,0 +1,5 @@
+package telegram
+
+import "errors"
+
+var errUnexpectedStatusCode = errors.New("unexpected status code")
@@ -0,0 +1,467 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"golang.org/x/time/rate"
+)
+
+const telegramAPIURL string = "https://api.telegram.org"
+
+// A name of the notification channel of Telegram
+const channelName string = "telegram"
+
+// Limits of a Telegram message
+//
+// [FYI]
+// A text has up to 4,096 characters counted in UTF-16 code units.
+// Each part of an entry is truncated to 500 characters before it is escaped, so that an entry
+// with a title and details stays far below the limit even if all characters are escaped
+// ref. https://core.telegram.org/bots/api#sendmessage
+const (
+	maxTextLength     int = 4_096
+	maxPartLength     int = 500
+	maxButtonTitleLen int = 40
+)
+
+// A prefix of the buttons which open the store pages of video games
+const storeButtonPrefix string = "Open in Steam: "
+
+// A notification channel of Telegram
+type Channel service.NotificationChannel
+
+// Generate a new notification channel of Telegram
+//
+// [FYI]
+// nil is returned if Telegram is not configured
+func NewChannel(
+	cfg *config.TelegramConfig,
+	dNotifier *videoGamePricesOnTelegramNotifier,
+	eNotifier *errorOnTelegramNotifier,
+) *Channel {
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	return &Channel{
+		Name:          channelName,
+		DealNotifier:  dNotifier,
+		ErrorNotifier: eNotifier,
+	}
+}
+
+// An entry of a Telegram message formatted with MarkdownV2
+//
+// [FYI]
+// The button is nil if the entry is not a video game (e.g. a heading of a deal class)
+type entry struct {
+	text   string
+	button *model.TelegramInlineKeyboardButton
+}
+
+type videoGamePricesOnTelegramNotifier struct {
+	cfg        *config.TelegramConfig
+	httpClient service.HTTPClient
+}
+
+var _ service.DealNotifier = (*videoGamePricesOnTelegramNotifier)(nil)
+
+// Generate a new video game prices on Telegram notifier
+func NewVideoGamePricesOnTelegramNotifier(
+	cfg *config.TelegramConfig,
+	httpClient service.HTTPClient,
+) *videoGamePricesOnTelegramNotifier {
+	return &videoGamePricesOnTelegramNotifier{
+		cfg:        cfg,
+		httpClient: httpClient,
+	}
+}
+
+// Notify video game prices on Telegram
+//
+// [FYI]
+// Each video game has an inline button to open its store page under the message.
+// A long list of video games is divided into multiple messages by the limit of 4,096 characters.
+// The rate limiter is set to 1 request per second, which is the limit of messages to a chat
+// ref. https://core.telegram.org/bots/faq#my-bot-is-hitting-limits-how-do-i-avoid-this
+func (n *videoGamePricesOnTelegramNotifier) NotifyDeals(
+	ctx context.Context,
+	input *service.NotifyDealsInput,
+) (*service.NotifyDealsOutput, error) {
+	// Build messages of recommended and skipped video games
+	//
+	// [FYI]
+	// The message of recommended video games is omitted if only skipped video games or the weekly digest are notified
+	bodies := make([]*model.TelegramSendMessageBody, 0)
+	if len(input.Contents) > 0 || (len(input.SkippedContents) == 0 && input.Digest == nil) {
+		bodies = append(bodies, n.buildMessageBodies(
+			"The recommended video games to buy now are as follows:",
+			buildDealEntries(input.Contents),
+		)...)
+	}
+	if input.Basket != nil {
+		bodies = append(bodies, n.buildMessageBodies("Recommended basket", buildBasketEntries(input.Basket))...)
+	}
+	if input.Digest != nil {
+		bodies = append(bodies, n.buildMessageBodies("Weekly digest", buildDigestEntries(input.Digest))...)
+	}
+	if len(input.SkippedContents) > 0 {
+		bodies = append(bodies, n.buildMessageBodies(
+			"The following video games were skipped:",
+			buildSkippedEntries(input.SkippedContents),
+		)...)
+	}
+
+	limiter := rate.NewLimiter(1, 1)
+	for _, body := range bodies {
+		if err := limiter.Wait(ctx); err != nil {
+			slog.ErrorContext(ctx, "failed to wait for the rate limiter", slog.Any("error", err))
+			return nil, err
+		}
+
+		if err := sendMessage(ctx, n.httpClient, n.cfg.TelegramBotToken, body); err != nil {
+			return nil, err
+		}
+	}
+
+	return &service.NotifyDealsOutput{}, nil
+}
+
+// Build message bodies from entries under a heading
+//
+// [FYI]
+// A new message is started with the same heading if the next entry makes the text longer than the limit,
+// and the buttons of the video games in a message are shown under it
+func (n *videoGamePricesOnTelegramNotifier) buildMessageBodies(
+	heading string,
+	entries []*entry,
+) []*model.TelegramSendMessageBody {
+	headingText := "*" + escape(heading) + "*"
+	bodies := make([]*model.TelegramSendMessageBody, 0, 1)
+	var current *model.TelegramSendMessageBody
+	for _, e := range entries {
+		if current != nil && textLength(current.Text+"\n"+e.text) > maxTextLength {
+			bodies = append(bodies, current)
+			current = nil
+		}
+
+		if current == nil {
+			current = n.newMessageBody(headingText)
+		}
+		current.Text += "\n" + e.text
+		if e.button != nil {
+			if current.ReplyMarkup == nil {
+				current.ReplyMarkup = &model.TelegramInlineKeyboardMarkup{}
+			}
+			current.ReplyMarkup.InlineKeyboard = append(
+				current.ReplyMarkup.InlineKeyboard,
+				[]*model.TelegramInlineKeyboardButton{e.button},
+			)
+		}
+	}
+	if current == nil {
+		current = n.newMessageBody(headingText)
+	}
+
+	return append(bodies, current)
+}
+
+// Generate a new message body with a text
+func (n *videoGamePricesOnTelegramNotifier) newMessageBody(text string) *model.TelegramSendMessageBody {
+	return &model.TelegramSendMessageBody{
+		ChatID:    n.cfg.TelegramChatID,
+		Text:      text,
+		ParseMode: model.TelegramParseModeMarkdownV2,
+		LinkPreviewOptions: &model.TelegramLinkPreviewOptions{
+			IsDisabled: true,
+		},
+	}
+}
+
+type errorOnTelegramNotifier struct {
+	cfg        *config.TelegramConfig
+	httpClient service.HTTPClient
+}
+
+var _ service.ErrorNotifier = (*errorOnTelegramNotifier)(nil)
+
+// Generate a new error on Telegram notifier
+func NewErrorOnTelegramNotifier(
+	cfg *config.TelegramConfig,
+	httpClient service.HTTPClient,
+) *errorOnTelegramNotifier {
+	return &errorOnTelegramNotifier{
+		cfg:        cfg,
+		httpClient: httpClient,
+	}
+}
+
+// Notify an error on Telegram
+//
+// [FYI]
+// The error is truncated to half of the limit because escaping can double its length
+func (n *errorOnTelegramNotifier) NotifyError(
+	ctx context.Context,
+	input *service.NotifyErrorInput,
+) (*service.NotifyErrorOutput, error) {
+	body := &model.TelegramSendMessageBody{
+		ChatID:    n.cfg.TelegramChatID,
+		Text:      "*An error occurred:*\n" + escape(truncate(input.GeneratedError.Error(), maxTextLength/2-20)),
+		ParseMode: model.TelegramParseModeMarkdownV2,
+	}
+	if err := sendMessage(ctx, n.httpClient, n.cfg.TelegramBotToken, body); err != nil {
+		return nil, err
+	}
+
+	return &service.NotifyErrorOutput{}, nil
+}
+
+// Send a message with the Telegram Bot API
+func sendMessage(
+	ctx context.Context,
+	httpClient service.HTTPClient,
+	botToken string,
+	body *model.TelegramSendMessageBody,
+) error {
+	reqURL, err := url.JoinPath(telegramAPIURL, "bot"+botToken, "sendMessage")
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build a Telegram API URL", slog.Any("error", err))
+		return err
+	}
+
+	reqJSON, err := json.Marshal(body)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to marshal a Telegram API request body", slog.Any("error", err))
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewBuffer(reqJSON))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create a Telegram API request", slog.Any("error", err))
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		// [FYI]
+		// An error of the HTTP client contains the URL, so the bot token is redacted
+		// before the error is logged and notified on the other channels
+		if uErr := (*url.Error)(nil); errors.As(err, &uErr) {
+			uErr.URL = strings.Replace(uErr.URL, botToken, "<redacted>", 1)
+		}
+		slog.ErrorContext(ctx, "failed to send a Telegram API request", slog.Any("error", err))
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		slog.ErrorContext(ctx, "failed to send a Telegram API request", slog.Any("status_code", res.StatusCode))
+		return errUnexpectedStatusCode
+	}
+
+	return nil
+}
+
+// Build entries of recommended video games grouped by their deal classes
+func buildDealEntries(contents map[model.SteamAppID]*model.DealContent) []*entry {
+	entries := make([]*entry, 0, len(contents)+len(model.DealClasses))
+	appIDs := model.SortContentAppIDs(contents)
+	for _, class := range model.DealClasses {
+		heading := false
+		for _, appID := range appIDs {
+			v := contents[appID]
+			if v.DealClass != class {
+				continue
+			}
+
+			if !heading {
+				entries = append(entries, &entry{text: "\n_" + escape(class.Heading()) + "_"})
+				heading = true
+			}
+
+			entries = append(entries, newGameEntry(appID, v.Title, buildDetails(v)))
+		}
+	}
+
+	return entries
+}
+
+// Build details of a recommended video game
+//
+// [FYI]
+// The lowest price is shown as "-" if it is not set
+func buildDetails(v *model.DealContent) []string {
+	lowestPrice := "-"
+	if v.LowestPrice != nil {
+		lowestPrice = v.LowestPrice.Format()
+	}
+
+	details := []string{
+		"Current Price: " + v.CurrentPrice.Format(),
+		"Lowest Price: " + lowestPrice,
+	}
+	if v.DiscountPercent > 0 {
+		details = append(details, fmt.Sprintf(
+			"Discount: -%d%% (%s → %s)",
+			v.DiscountPercent,
+			v.RegularPrice.Format(),
+			v.CurrentPrice.Format(),
+		))
+	}
+	if len(v.TriggeredRules) > 0 {
+		rules := make([]string, 0, len(v.TriggeredRules))
+		for _, r := range v.TriggeredRules {
+			rules = append(rules, string(r))
+		}
+		details = append(details, "Triggered by: "+strings.Join(rules, ", "))
+	}
+
+	return details
+}
+
+// Build entries of a recommended basket of deals within the monthly budget
+func buildBasketEntries(basket *model.Basket) []*entry {
+	entries := make([]*entry, 0, len(basket.Items)+2)
+	entries = append(entries, newTextEntry(fmt.Sprintf(
+		"Monthly Budget: %s  |  Spent This Month: %s  |  Available: %s",
+		basket.Budget.Format(),
+		basket.Spent.Format(),
+		basket.Available().Format(),
+	)))
+	if len(basket.Items) == 0 {
+		return append(entries, newTextEntry("No deals fit in the available budget"))
+	}
+
+	for _, v := range basket.Items {
+		details := []string{"Price: " + v.Price.Format()}
+		if v.Priority > 0 {
+			details = append(details, fmt.Sprintf("Priority: %d", v.Priority))
+		}
+		entries = append(entries, newGameEntry(v.AppID, v.Title, details))
+	}
+
+	return append(entries, newTextEntry(fmt.Sprintf(
+		"Total: %s  |  Left After Buying: %s",
+		basket.Total.Format(),
+		basket.Left().Format(),
+	)))
+}
+
+// Build entries of the weekly digest
+func buildDigestEntries(digest *model.Digest) []*entry {
+	entries := make([]*entry, 0, len(digest.QueuedDeals)+len(digest.DiscountedGames)+5)
+	entries = append(entries, &entry{text: "\n_" + escape("Deals since the last digest") + "_"})
+	if len(digest.QueuedDeals) == 0 {
+		entries = append(entries, newTextEntry("No deals were found since the last digest"))
+	}
+	for _, v := range digest.QueuedDeals {
+		entries = append(entries, newGameEntry(v.AppID, v.Content.Title, buildDetails(v.Content)))
+	}
+
+	entries = append(entries, &entry{text: "\n_" + escape("Currently discounted video games") + "_"})
+	if len(digest.DiscountedGames) == 0 {
+		entries = append(entries, newTextEntry("No video games on the wishlist are discounted now"))
+	}
+	for _, v := range digest.DiscountedGames {
+		entries = append(entries, newGameEntry(v.AppID, v.Title, []string{
+			fmt.Sprintf("Discount: -%d%% (%s → %s)", v.DiscountPercent, v.RegularPrice.Format(), v.CurrentPrice.Format()),
+			"Savings: " + v.Savings().Format(),
+		}))
+	}
+
+	if digest.TotalSavings != nil {
+		entries = append(entries, newTextEntry(fmt.Sprintf(
+			"Discounted Video Games: %d  |  Total Savings: %s",
+			len(digest.DiscountedGames),
+			digest.TotalSavings.Format(),
+		)))
+	}
+
+	return entries
+}
+
+// Build entries of video games skipped because they cannot be retrieved from the Steam Store
+func buildSkippedEntries(skippedContents map[model.SteamAppID]*model.SkippedContent) []*entry {
+	entries := make([]*entry, 0, len(skippedContents))
+	for _, k := range slices.Sorted(maps.Keys(skippedContents)) {
+		v := skippedContents[k]
+		title := v.Title
+		if title == "" {
+			title = fmt.Sprintf("App ID: %d", v.AppID)
+		}
+		entries = append(entries, newGameEntry(v.AppID, title, []string{
+			fmt.Sprintf("App ID: %d", v.AppID),
+			"Reason: " + v.Reason,
+		}))
+	}
+
+	return entries
+}
+
+// Generate a new entry of a plain text
+func newTextEntry(text string) *entry {
+	return &entry{text: escape(truncate(text, maxPartLength))}
+}
+
+// Generate a new entry of a video game with a button to open its store page
+//
+// e.g. "• *Title*\nCurrent Price: ¥250  \\|  Lowest Price: ¥1,500"
+func newGameEntry(appID model.SteamAppID, title string, details []string) *entry {
+	return &entry{
+		text: "• *" + escape(truncate(title, maxPartLength)) + "*\n" +
+			escape(truncate(strings.Join(details, "  |  "), maxPartLength)),
+		button: &model.TelegramInlineKeyboardButton{
+			Text: storeButtonPrefix + truncate(title, maxButtonTitleLen),
+			URL:  appID.StoreURL(),
+		},
+	}
+}
+
+// Escape special characters of MarkdownV2
+//
+// [FYI]
+// ref. https://core.telegram.org/bots/api#markdownv2-style
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune("\\_*[]()~`>#+-=|{}.!", r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// Count the length of a text in UTF-16 code units as Telegram does
+func textLength(s string) int {
+	return len(utf16.Encode([]rune(s)))
+}
+
+// Truncate a text to the maximum number of characters with an ellipsis
+//
+// e.g. ("abcdef", 4) -> "abc…"
+func truncate(s string, maxLength int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLength {
+		return s
+	}
+
+	return string(runes[:maxLength-1]) + "…"
+}
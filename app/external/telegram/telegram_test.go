@@ -0,0 +1,337 @@
+package telegram
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	httpclient "github.com/TsubasaBneAus/steam_game_price_notifier/app/external/httpclient/mock"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNotifyVideoGamePricesOnTelegram(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.TelegramConfig{
+		TelegramBotToken: "dummy_telegram_bot_token",
+		TelegramChatID:   "dummy_telegram_chat_id",
+	}
+
+	t.Run("Positive case: Successfully notify video game prices on Telegram", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				got := req.URL.String()
+				want := "https://api.telegram.org/botdummy_telegram_bot_token/sendMessage"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				body := &model.TelegramSendMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				wantBody := &model.TelegramSendMessageBody{
+					ChatID: "dummy_telegram_chat_id",
+					Text: "*The recommended video games to buy now are as follows:*\n" +
+						"\n" +
+						"_New all\\-time lows_\n" +
+						"• *Half\\-Life 2: Episode One \\(2006\\)\\!*\n" +
+						"Current Price: ¥250  \\|  Lowest Price: ¥1,500  \\|  Discount: \\-75% \\(¥1,000 → ¥250\\)",
+					ParseMode: model.TelegramParseModeMarkdownV2,
+					LinkPreviewOptions: &model.TelegramLinkPreviewOptions{
+						IsDisabled: true,
+					},
+					ReplyMarkup: &model.TelegramInlineKeyboardMarkup{
+						InlineKeyboard: [][]*model.TelegramInlineKeyboardButton{
+							{
+								{
+									Text: "Open in Steam: Half-Life 2: Episode One (2006)!",
+									URL:  "https://store.steampowered.com/app/380/",
+								},
+							},
+						},
+					},
+				}
+				if diff := cmp.Diff(body, wantBody); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesOnTelegramNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				380: {
+					Title:           "Half-Life 2: Episode One (2006)!",
+					CurrentPrice:    model.Money{Currency: "JPY", Amount: 250},
+					RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+					LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+					DiscountPercent: 75,
+					DealClass:       model.DealClassNewLow,
+				},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: A long list of video games is divided into messages under 4096 characters", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		games := 0
+		messages := 0
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &model.TelegramSendMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				if textLength(body.Text) > maxTextLength {
+					t.Errorf("\ngot: %v\nwant: %v or less", textLength(body.Text), maxTextLength)
+				}
+				if !strings.HasPrefix(body.Text, "*The recommended video games to buy now are as follows:*\n") {
+					t.Errorf("\ngot: %v\nwant: a text starting with the heading", body.Text)
+				}
+
+				// Each video game in the text has its own button
+				if got, want := len(body.ReplyMarkup.InlineKeyboard), strings.Count(body.Text, "• "); got != want {
+					t.Errorf("\ngot: %v\nwant: %v", got, want)
+				}
+				games += len(body.ReplyMarkup.InlineKeyboard)
+				messages++
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			}).
+			Times(2)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesOnTelegramNotifier(cfg, m)
+		contents := make(map[model.SteamAppID]*model.DealContent, 30)
+		for i := range 30 {
+			contents[model.SteamAppID(i+1)] = &model.DealContent{
+				Title:        strings.Repeat("🎮", 60),
+				CurrentPrice: model.Money{Currency: "JPY", Amount: 1000},
+				DealClass:    model.DealClassNewLow,
+			}
+		}
+		input := &service.NotifyDealsInput{
+			Contents: contents,
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+
+		if games != 30 || messages != 2 {
+			t.Errorf("\ngot: %v, %v\nwant: %v, %v", games, messages, 30, 2)
+		}
+	})
+
+	t.Run("Positive case: Only skipped video games are notified", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &model.TelegramSendMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				got := body.Text
+				want := "*The following video games were skipped:*\n" +
+					"• *App ID: 2*\n" +
+					"App ID: 2  \\|  Reason: dummy\\_reason"
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesOnTelegramNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+			SkippedContents: map[model.SteamAppID]*model.SkippedContent{
+				2: {
+					AppID:  2,
+					Reason: "dummy_reason",
+				},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Negative case: Failed to send a Telegram API request with the bot token redacted", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		wantErr := errors.New("unexpected error")
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				return nil, &url.Error{Op: "Post", URL: req.URL.String(), Err: wantErr}
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesOnTelegramNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+		}
+		_, gotErr := n.NotifyDeals(ctx, input)
+		if !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+		if strings.Contains(gotErr.Error(), "dummy_telegram_bot_token") {
+			t.Errorf("\ngot: %v\nwant: an error without the bot token", gotErr)
+		}
+	})
+
+	t.Run("Negative case: Get a status code except 200", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       http.NoBody,
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesOnTelegramNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+		}
+		wantErr := errUnexpectedStatusCode
+		if _, gotErr := n.NotifyDeals(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+}
+
+func TestNotifyErrorOnTelegram(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.TelegramConfig{
+		TelegramBotToken: "dummy_telegram_bot_token",
+		TelegramChatID:   "dummy_telegram_chat_id",
+	}
+
+	t.Run("Positive case: Successfully notify an error on Telegram", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body := &model.TelegramSendMessageBody{}
+				if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+					t.Fatalf("failed to decode a request body: %v", err)
+				}
+
+				want := &model.TelegramSendMessageBody{
+					ChatID:    "dummy_telegram_chat_id",
+					Text:      "*An error occurred:*\ndummy\\_error",
+					ParseMode: model.TelegramParseModeMarkdownV2,
+				}
+				if diff := cmp.Diff(body, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewErrorOnTelegramNotifier(cfg, m)
+		input := &service.NotifyErrorInput{
+			GeneratedError: errors.New("dummy_error"),
+		}
+		if _, err := n.NotifyError(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Negative case: Get a status code except 200", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusForbidden,
+				Body:       http.NoBody,
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewErrorOnTelegramNotifier(cfg, m)
+		input := &service.NotifyErrorInput{
+			GeneratedError: errors.New("dummy_error"),
+		}
+		wantErr := errUnexpectedStatusCode
+		if _, gotErr := n.NotifyError(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+}
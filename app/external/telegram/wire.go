@@ -0,0 +1,10 @@
+package telegram
+
+import "github.com/google/wire"
+
+// A wire set for the telegram package
+var Set = wire.NewSet(
+	NewVideoGamePricesOnTelegramNotifier,
+	NewErrorOnTelegramNotifier,
+	NewChannel,
+)
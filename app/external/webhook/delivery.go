@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/internal/retry"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+)
+
+// Headers of a request to a generic webhook
+//
+// [FYI]
+// The signature is "sha256=" followed by the hex-encoded HMAC-SHA256 of "<timestamp>.<body>"
+// with the secret, where the timestamp is the value of the timestamp header in Unix seconds.
+// A receiver should compare the signature in constant time and reject old timestamps to prevent replays
+const (
+	headerIdempotencyKey string = "Idempotency-Key"
+	headerTimestamp      string = "X-Webhook-Timestamp"
+	headerSignature      string = "X-Webhook-Signature"
+	signaturePrefix      string = "sha256="
+)
+
+// Deliver a payload to a generic webhook
+//
+// [FYI]
+// A request is retried with exponential backoff and full jitter if it fails to be sent,
+// or if the webhook responds with 429 or 5xx, and Retry-After is honoured if it is set.
+// Every attempt has the same idempotency key, but it is signed again with a new timestamp
+func deliver(
+	ctx context.Context,
+	cfg *config.WebhookConfig,
+	httpClient service.HTTPClient,
+	payload *model.WebhookPayload,
+) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to marshal a webhook payload", slog.Any("error", err))
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.WebhookURL, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create a webhook request", slog.Any("error", redact(err)))
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerIdempotencyKey, payload.ID)
+
+	for attempt := 0; ; attempt++ {
+		statusCode, retryAfter, err := send(httpClient, req, cfg.WebhookSecret, body)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			slog.ErrorContext(ctx, "failed to send a webhook request", slog.Any("error", err))
+			return err
+		case err == nil && statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices:
+			return nil
+		case err == nil && !retry.IsRetryableStatusCode(statusCode):
+			slog.ErrorContext(ctx, "failed to send a webhook request", slog.Any("status_code", statusCode))
+			return errUnexpectedStatusCode
+		case attempt >= cfg.WebhookMaxRetries:
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to send a webhook request", slog.Any("error", err))
+				return err
+			}
+
+			slog.ErrorContext(ctx, "failed to send a webhook request", slog.Any("status_code", statusCode))
+			return errUnexpectedStatusCode
+		}
+
+		delay := retry.Delay(cfg.WebhookRetryBaseDelay, attempt, retryAfter)
+		slog.WarnContext(
+			ctx,
+			"retry a webhook request",
+			slog.String("idempotency_key", payload.ID),
+			slog.Int("status_code", statusCode),
+			slog.Any("error", err),
+			slog.Int("attempt", attempt+1),
+			slog.Duration("delay", delay),
+		)
+
+		if err := retry.Sleep(ctx, delay); err != nil {
+			slog.ErrorContext(ctx, "failed to wait for a retry", slog.Any("error", err))
+			return err
+		}
+	}
+}
+
+// Send a copy of a request signed with the current timestamp once
+//
+// [FYI]
+// The status code is 0 if the request fails to be sent
+func send(
+	httpClient service.HTTPClient,
+	req *http.Request,
+	secret string,
+	body []byte,
+) (int, string, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	attemptReq := req.Clone(req.Context())
+	attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+	attemptReq.ContentLength = int64(len(body))
+	attemptReq.Header.Set(headerTimestamp, timestamp)
+	attemptReq.Header.Set(headerSignature, sign(secret, timestamp, body))
+
+	res, err := httpClient.Do(attemptReq)
+	if err != nil {
+		return 0, "", redact(err)
+	}
+	defer res.Body.Close()
+
+	// Drain the body to reuse the connection
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	return res.StatusCode, res.Header.Get("Retry-After"), nil
+}
+
+// Redact the URL in an error of the HTTP client to its host
+//
+// [FYI]
+// The path of a webhook often contains a secret (e.g. a webhook ID of Home Assistant),
+// so it must not be logged or notified on the other channels
+func redact(err error) error {
+	if uErr := (*url.Error)(nil); errors.As(err, &uErr) {
+		if u, pErr := url.Parse(uErr.URL); pErr == nil {
+			uErr.URL = (&url.URL{Scheme: u.Scheme, Host: u.Host}).String()
+		} else {
+			uErr.URL = "<redacted>"
+		}
+	}
+
+	return err
+}
+
+// Sign a body of a request with a timestamp
+//
+// e.g. ("secret", "1735787045", `{"version":1}`) -> "sha256=<64 hex digits>"
+func sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,5 @@
+package webhook
+
+import "errors"
+
+var errUnexpectedStatusCode = errors.New("unexpected status code")
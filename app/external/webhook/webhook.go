@@ -0,0 +1,257 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+)
+
+// A name of the notification channel of a generic webhook
+const channelName string = "webhook"
+
+// A notification channel of a generic webhook
+type Channel service.NotificationChannel
+
+// Generate a new notification channel of a generic webhook
+//
+// [FYI]
+// nil is returned if the webhook is not configured
+func NewChannel(
+	cfg *config.WebhookConfig,
+	dNotifier *videoGamePricesWithWebhookNotifier,
+	eNotifier *errorWithWebhookNotifier,
+) *Channel {
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	return &Channel{
+		Name:          channelName,
+		DealNotifier:  dNotifier,
+		ErrorNotifier: eNotifier,
+	}
+}
+
+type videoGamePricesWithWebhookNotifier struct {
+	cfg        *config.WebhookConfig
+	httpClient service.HTTPClient
+	now        func() time.Time
+}
+
+var _ service.DealNotifier = (*videoGamePricesWithWebhookNotifier)(nil)
+
+// Generate a new video game prices with webhook notifier
+func NewVideoGamePricesWithWebhookNotifier(
+	cfg *config.WebhookConfig,
+	httpClient service.HTTPClient,
+) *videoGamePricesWithWebhookNotifier {
+	return &videoGamePricesWithWebhookNotifier{
+		cfg:        cfg,
+		httpClient: httpClient,
+		now:        time.Now,
+	}
+}
+
+// Notify video game prices with a generic webhook
+//
+// [FYI]
+// All deals, the basket, the digest, and skipped video games are sent in one payload,
+// so that a receiver handles a run of the app at once
+func (n *videoGamePricesWithWebhookNotifier) NotifyDeals(
+	ctx context.Context,
+	input *service.NotifyDealsInput,
+) (*service.NotifyDealsOutput, error) {
+	payload := newPayload(model.WebhookEventDeals, n.now())
+	appIDs := model.SortContentAppIDs(input.Contents)
+	for _, class := range model.DealClasses {
+		for _, appID := range appIDs {
+			if v := input.Contents[appID]; v.DealClass == class {
+				payload.Deals = append(payload.Deals, buildDeal(appID, v))
+			}
+		}
+	}
+	if input.Basket != nil {
+		payload.Basket = buildBasket(input.Basket)
+	}
+	if input.Digest != nil {
+		payload.Digest = buildDigest(input.Digest)
+	}
+	for _, k := range slices.Sorted(maps.Keys(input.SkippedContents)) {
+		v := input.SkippedContents[k]
+		payload.Skipped = append(payload.Skipped, &model.WebhookSkippedGame{
+			AppID:    v.AppID,
+			Title:    v.Title,
+			StoreURL: v.AppID.StoreURL(),
+			Reason:   v.Reason,
+		})
+	}
+
+	if err := deliver(ctx, n.cfg, n.httpClient, payload); err != nil {
+		return nil, err
+	}
+
+	return &service.NotifyDealsOutput{}, nil
+}
+
+type errorWithWebhookNotifier struct {
+	cfg        *config.WebhookConfig
+	httpClient service.HTTPClient
+	now        func() time.Time
+}
+
+var _ service.ErrorNotifier = (*errorWithWebhookNotifier)(nil)
+
+// Generate a new error with webhook notifier
+func NewErrorWithWebhookNotifier(
+	cfg *config.WebhookConfig,
+	httpClient service.HTTPClient,
+) *errorWithWebhookNotifier {
+	return &errorWithWebhookNotifier{
+		cfg:        cfg,
+		httpClient: httpClient,
+		now:        time.Now,
+	}
+}
+
+// Notify an error with a generic webhook
+func (n *errorWithWebhookNotifier) NotifyError(
+	ctx context.Context,
+	input *service.NotifyErrorInput,
+) (*service.NotifyErrorOutput, error) {
+	payload := newPayload(model.WebhookEventError, n.now())
+	payload.Error = &model.WebhookError{
+		Message: input.GeneratedError.Error(),
+	}
+
+	if err := deliver(ctx, n.cfg, n.httpClient, payload); err != nil {
+		return nil, err
+	}
+
+	return &service.NotifyErrorOutput{}, nil
+}
+
+// Generate a new payload of an event with a random ID
+//
+// [FYI]
+// The ID is used as the idempotency key, so it is generated once per notification, not per attempt
+func newPayload(event string, now time.Time) *model.WebhookPayload {
+	return &model.WebhookPayload{
+		Version: model.WebhookSchemaVersion,
+		ID:      rand.Text(),
+		Event:   event,
+		SentAt:  now.UTC(),
+	}
+}
+
+// Build a deal of a video game in a payload
+func buildDeal(appID model.SteamAppID, v *model.DealContent) *model.WebhookDeal {
+	var lowestPrice *uint64
+	if v.LowestPrice != nil {
+		lowestPrice = &v.LowestPrice.Amount
+	}
+
+	rules := make([]string, 0, len(v.TriggeredRules))
+	for _, r := range v.TriggeredRules {
+		rules = append(rules, string(r))
+	}
+
+	return &model.WebhookDeal{
+		AppID:            appID,
+		Title:            v.Title,
+		StoreURL:         appID.StoreURL(),
+		Priority:         v.Priority,
+		Currency:         string(v.CurrentPrice.Currency),
+		CurrencyExponent: v.CurrentPrice.Currency.Exponent(),
+		CurrentPrice:     v.CurrentPrice.Amount,
+		RegularPrice:     v.RegularPrice.Amount,
+		LowestPrice:      lowestPrice,
+		DiscountPercent:  v.DiscountPercent,
+		DealClass:        dealClassName(v.DealClass),
+		TriggeredRules:   rules,
+	}
+}
+
+// Build a recommended basket of deals in a payload
+func buildBasket(basket *model.Basket) *model.WebhookBasket {
+	items := make([]*model.WebhookBasketItem, 0, len(basket.Items))
+	for _, v := range basket.Items {
+		items = append(items, &model.WebhookBasketItem{
+			AppID:    v.AppID,
+			Title:    v.Title,
+			StoreURL: v.AppID.StoreURL(),
+			Priority: v.Priority,
+			Price:    v.Price.Amount,
+		})
+	}
+
+	return &model.WebhookBasket{
+		Currency:         string(basket.Budget.Currency),
+		CurrencyExponent: basket.Budget.Currency.Exponent(),
+		Budget:           basket.Budget.Amount,
+		Spent:            basket.Spent.Amount,
+		Available:        basket.Available().Amount,
+		Total:            basket.Total.Amount,
+		Left:             basket.Left().Amount,
+		Items:            items,
+	}
+}
+
+// Build a weekly digest in a payload
+func buildDigest(digest *model.Digest) *model.WebhookDigest {
+	queuedDeals := make([]*model.WebhookDeal, 0, len(digest.QueuedDeals))
+	for _, v := range digest.QueuedDeals {
+		queuedDeals = append(queuedDeals, buildDeal(v.AppID, v.Content))
+	}
+
+	discountedGames := make([]*model.WebhookDiscountedGame, 0, len(digest.DiscountedGames))
+	for _, v := range digest.DiscountedGames {
+		discountedGames = append(discountedGames, &model.WebhookDiscountedGame{
+			AppID:            v.AppID,
+			Title:            v.Title,
+			StoreURL:         v.AppID.StoreURL(),
+			Currency:         string(v.CurrentPrice.Currency),
+			CurrencyExponent: v.CurrentPrice.Currency.Exponent(),
+			CurrentPrice:     v.CurrentPrice.Amount,
+			RegularPrice:     v.RegularPrice.Amount,
+			DiscountPercent:  v.DiscountPercent,
+			Savings:          v.Savings().Amount,
+		})
+	}
+
+	var totalSavings *model.WebhookMoney
+	if digest.TotalSavings != nil {
+		totalSavings = &model.WebhookMoney{
+			Currency:         string(digest.TotalSavings.Currency),
+			CurrencyExponent: digest.TotalSavings.Currency.Exponent(),
+			Amount:           digest.TotalSavings.Amount,
+		}
+	}
+
+	return &model.WebhookDigest{
+		QueuedDeals:     queuedDeals,
+		DiscountedGames: discountedGames,
+		TotalSavings:    totalSavings,
+	}
+}
+
+// Get a name of a deal class in a payload
+func dealClassName(c model.DealClass) string {
+	switch c {
+	case model.DealClassNewLow:
+		return model.WebhookDealClassNewLow
+	case model.DealClassMatchesLow:
+		return model.WebhookDealClassMatchesLow
+	case model.DealClassNearLow:
+		return model.WebhookDealClassNearLow
+	case model.DealClassOtherRules:
+		return model.WebhookDealClassOtherRules
+	default:
+		return model.WebhookDealClassUnspecified
+	}
+}
@@ -0,0 +1,479 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	httpclient "github.com/TsubasaBneAus/steam_game_price_notifier/app/external/httpclient/mock"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.uber.org/mock/gomock"
+)
+
+// Read a payload of a request after verifying its headers and signature
+func readPayload(t *testing.T, req *http.Request, secret string) *model.WebhookPayload {
+	t.Helper()
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("failed to read a request body: %v", err)
+	}
+
+	if got, want := req.Header.Get("Content-Type"), "application/json"; got != want {
+		t.Errorf("\ngot: %v\nwant: %v", got, want)
+	}
+
+	got := req.Header.Get(headerSignature)
+	want := sign(secret, req.Header.Get(headerTimestamp), body)
+	if !hmac.Equal([]byte(got), []byte(want)) {
+		t.Errorf("\ngot: %v\nwant: %v", got, want)
+	}
+
+	payload := &model.WebhookPayload{}
+	if err := json.Unmarshal(body, payload); err != nil {
+		t.Fatalf("failed to decode a request body: %v", err)
+	}
+
+	if got, want := req.Header.Get(headerIdempotencyKey), payload.ID; got == "" || got != want {
+		t.Errorf("\ngot: %v\nwant: %v", got, want)
+	}
+
+	return payload
+}
+
+func TestNotifyVideoGamePricesWithWebhook(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.WebhookConfig{
+		WebhookURL:            "https://example.com/api/webhook/dummy_webhook_id",
+		WebhookSecret:         "dummy_webhook_secret",
+		WebhookMaxRetries:     2,
+		WebhookRetryBaseDelay: time.Millisecond,
+	}
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// Build a response with a status code
+	newResponse := func(statusCode int) *http.Response {
+		return &http.Response{
+			StatusCode: statusCode,
+			Header:     http.Header{},
+			Body:       http.NoBody,
+		}
+	}
+
+	t.Run("Positive case: Successfully notify video game prices with a webhook", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				if got, want := req.URL.String(), cfg.WebhookURL; got != want {
+					t.Errorf("\ngot: %v\nwant: %v", got, want)
+				}
+
+				lowestPrice := uint64(1500)
+				want := &model.WebhookPayload{
+					Version: 1,
+					Event:   "deals",
+					SentAt:  now,
+					Deals: []*model.WebhookDeal{
+						{
+							AppID:            380,
+							Title:            "Half-Life 2: Episode One",
+							StoreURL:         "https://store.steampowered.com/app/380/",
+							Priority:         1,
+							Currency:         "JPY",
+							CurrencyExponent: 0,
+							CurrentPrice:     250,
+							RegularPrice:     1000,
+							LowestPrice:      &lowestPrice,
+							DiscountPercent:  75,
+							DealClass:        "new_low",
+							TriggeredRules:   []string{"Lowest Price", "Min Discount %"},
+						},
+						{
+							AppID:            420,
+							Title:            "Half-Life 2: Episode Two",
+							StoreURL:         "https://store.steampowered.com/app/420/",
+							Currency:         "JPY",
+							CurrencyExponent: 0,
+							CurrentPrice:     800,
+							RegularPrice:     1000,
+							DiscountPercent:  20,
+							DealClass:        "other_rules",
+							TriggeredRules:   []string{"Target Price"},
+						},
+					},
+					Basket: &model.WebhookBasket{
+						Currency:  "JPY",
+						Budget:    3000,
+						Spent:     1000,
+						Available: 2000,
+						Total:     250,
+						Left:      1750,
+						Items: []*model.WebhookBasketItem{
+							{
+								AppID:    380,
+								Title:    "Half-Life 2: Episode One",
+								StoreURL: "https://store.steampowered.com/app/380/",
+								Priority: 1,
+								Price:    250,
+							},
+						},
+					},
+					Skipped: []*model.WebhookSkippedGame{
+						{
+							AppID:    2,
+							StoreURL: "https://store.steampowered.com/app/2/",
+							Reason:   "dummy_reason",
+						},
+					},
+				}
+				got := readPayload(t, req, cfg.WebhookSecret)
+				if diff := cmp.Diff(got, want, cmpopts.IgnoreFields(model.WebhookPayload{}, "ID")); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return newResponse(http.StatusNoContent), nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesWithWebhookNotifier(cfg, m)
+		n.now = func() time.Time { return now }
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{
+				380: {
+					Title:           "Half-Life 2: Episode One",
+					Priority:        1,
+					CurrentPrice:    model.Money{Currency: "JPY", Amount: 250},
+					RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+					LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+					DiscountPercent: 75,
+					DealClass:       model.DealClassNewLow,
+					TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice, model.DealRuleMinDiscount},
+				},
+				420: {
+					Title:           "Half-Life 2: Episode Two",
+					CurrentPrice:    model.Money{Currency: "JPY", Amount: 800},
+					RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+					DiscountPercent: 20,
+					DealClass:       model.DealClassOtherRules,
+					TriggeredRules:  []model.DealRule{model.DealRuleTargetPrice},
+				},
+			},
+			SkippedContents: map[model.SteamAppID]*model.SkippedContent{
+				2: {
+					AppID:  2,
+					Reason: "dummy_reason",
+				},
+			},
+			Basket: &model.Basket{
+				Items: []*model.BasketItem{
+					{
+						AppID:    380,
+						Title:    "Half-Life 2: Episode One",
+						Priority: 1,
+						Price:    model.Money{Currency: "JPY", Amount: 250},
+					},
+				},
+				Total:  model.Money{Currency: "JPY", Amount: 250},
+				Budget: model.Money{Currency: "JPY", Amount: 3000},
+				Spent:  model.Money{Currency: "JPY", Amount: 1000},
+			},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: The weekly digest is notified with the total savings", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				want := &model.WebhookDigest{
+					QueuedDeals: []*model.WebhookDeal{
+						{
+							AppID:            220,
+							Title:            "Half-Life 2",
+							StoreURL:         "https://store.steampowered.com/app/220/",
+							Currency:         "AUD",
+							CurrencyExponent: 2,
+							CurrentPrice:     199,
+							RegularPrice:     1450,
+							DiscountPercent:  86,
+							DealClass:        "near_low",
+							TriggeredRules:   []string{},
+						},
+					},
+					DiscountedGames: []*model.WebhookDiscountedGame{
+						{
+							AppID:            220,
+							Title:            "Half-Life 2",
+							StoreURL:         "https://store.steampowered.com/app/220/",
+							Currency:         "AUD",
+							CurrencyExponent: 2,
+							CurrentPrice:     199,
+							RegularPrice:     1450,
+							DiscountPercent:  86,
+							Savings:          1251,
+						},
+					},
+					TotalSavings: &model.WebhookMoney{
+						Currency:         "AUD",
+						CurrencyExponent: 2,
+						Amount:           1251,
+					},
+				}
+				got := readPayload(t, req, cfg.WebhookSecret)
+				if len(got.Deals) != 0 {
+					t.Errorf("\ngot: %v\nwant: %v", len(got.Deals), 0)
+				}
+				if diff := cmp.Diff(got.Digest, want); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return newResponse(http.StatusOK), nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesWithWebhookNotifier(cfg, m)
+		content := &model.DealContent{
+			Title:           "Half-Life 2",
+			CurrentPrice:    model.Money{Currency: "AUD", Amount: 199},
+			RegularPrice:    model.Money{Currency: "AUD", Amount: 1450},
+			DiscountPercent: 86,
+			DealClass:       model.DealClassNearLow,
+		}
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+			Digest: model.NewDigest(
+				[]*model.QueuedDeal{{AppID: 220, QueuedAt: now, Content: content}},
+				[]*model.DiscountedGame{{
+					AppID:           220,
+					Title:           "Half-Life 2",
+					CurrentPrice:    model.Money{Currency: "AUD", Amount: 199},
+					RegularPrice:    model.Money{Currency: "AUD", Amount: 1450},
+					DiscountPercent: 86,
+				}},
+			),
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: Retry a request with the same idempotency key", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		keys := make([]string, 0, 3)
+		record := func(res *http.Response, err error) func(req *http.Request) (*http.Response, error) {
+			return func(req *http.Request) (*http.Response, error) {
+				payload := readPayload(t, req, cfg.WebhookSecret)
+				keys = append(keys, payload.ID)
+				return res, err
+			}
+		}
+		gomock.InOrder(
+			m.EXPECT().Do(gomock.Any()).DoAndReturn(record(nil, errors.New("connection reset by peer"))),
+			m.EXPECT().Do(gomock.Any()).DoAndReturn(record(newResponse(http.StatusServiceUnavailable), nil)),
+			m.EXPECT().Do(gomock.Any()).DoAndReturn(record(newResponse(http.StatusAccepted), nil)),
+		)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesWithWebhookNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+		}
+		if _, err := n.NotifyDeals(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+
+		if len(keys) != 3 || keys[0] != keys[1] || keys[1] != keys[2] {
+			t.Errorf("\ngot: %v\nwant: 3 identical idempotency keys", keys)
+		}
+	})
+
+	t.Run("Negative case: Get a status code of 5xx after all retries", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				return newResponse(http.StatusInternalServerError), nil
+			}).
+			Times(cfg.WebhookMaxRetries + 1)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesWithWebhookNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+		}
+		wantErr := errUnexpectedStatusCode
+		if _, gotErr := n.NotifyDeals(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Get a status code of 4xx without retries", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(newResponse(http.StatusUnauthorized), nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesWithWebhookNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+		}
+		wantErr := errUnexpectedStatusCode
+		if _, gotErr := n.NotifyDeals(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Failed to send a request with the path of the URL redacted", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		wantErr := errors.New("unexpected error")
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				return nil, &url.Error{Op: "Post", URL: req.URL.String(), Err: wantErr}
+			}).
+			Times(cfg.WebhookMaxRetries + 1)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewVideoGamePricesWithWebhookNotifier(cfg, m)
+		input := &service.NotifyDealsInput{
+			Contents: map[model.SteamAppID]*model.DealContent{},
+		}
+		_, gotErr := n.NotifyDeals(ctx, input)
+		if !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+		if strings.Contains(gotErr.Error(), "dummy_webhook_id") {
+			t.Errorf("\ngot: %v\nwant: an error without the path of the URL", gotErr)
+		}
+	})
+}
+
+func TestNotifyErrorWithWebhook(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.WebhookConfig{
+		WebhookURL:            "https://example.com/webhook",
+		WebhookSecret:         "dummy_webhook_secret",
+		WebhookMaxRetries:     0,
+		WebhookRetryBaseDelay: time.Millisecond,
+	}
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("Positive case: Successfully notify an error with a webhook", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				want := &model.WebhookPayload{
+					Version: 1,
+					Event:   "error",
+					SentAt:  now,
+					Error: &model.WebhookError{
+						Message: "dummy_error",
+					},
+				}
+				got := readPayload(t, req, cfg.WebhookSecret)
+				if diff := cmp.Diff(got, want, cmpopts.IgnoreFields(model.WebhookPayload{}, "ID")); diff != "" {
+					t.Errorf("got(-) want(+)\n%s", diff)
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+				}, nil
+			})
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewErrorWithWebhookNotifier(cfg, m)
+		n.now = func() time.Time { return now }
+		input := &service.NotifyErrorInput{
+			GeneratedError: errors.New("dummy_error"),
+		}
+		if _, err := n.NotifyError(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Negative case: Get a status code except 2xx", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock of the HTTP client
+		ctrl := gomock.NewController(t)
+		m := httpclient.NewMockHTTPClient(ctrl)
+		m.
+			EXPECT().
+			Do(gomock.Any()).
+			Return(&http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Body:       http.NoBody,
+			}, nil)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewErrorWithWebhookNotifier(cfg, m)
+		input := &service.NotifyErrorInput{
+			GeneratedError: errors.New("dummy_error"),
+		}
+		wantErr := errUnexpectedStatusCode
+		if _, gotErr := n.NotifyError(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+}
@@ -0,0 +1,10 @@
+package webhook
+
+import "github.com/google/wire"
+
+// A wire set for the webhook package
+var Set = wire.NewSet(
+	NewVideoGamePricesWithWebhookNotifier,
+	NewErrorWithWebhookNotifier,
+	NewChannel,
+)
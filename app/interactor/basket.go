@@ -0,0 +1,61 @@
+package interactor
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/usecase"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+)
+
+type basketRecommender struct {
+	notifierCfg *config.NotifierConfig
+	now         func() time.Time
+}
+
+var _ usecase.BasketRecommender = (*basketRecommender)(nil)
+
+// Generate a new basketRecommender
+func NewBasketRecommender(notifierCfg *config.NotifierConfig) *basketRecommender {
+	return &basketRecommender{
+		notifierCfg: notifierCfg,
+		now:         time.Now,
+	}
+}
+
+// Recommend a basket of deals within the monthly budget
+//
+// [FYI]
+// The monthly budget is in the major units of the currency of the candidates,
+// and what has already been spent this month is subtracted from it before choosing the basket
+func (r *basketRecommender) RecommendBasket(
+	ctx context.Context,
+	input *usecase.RecommendBasketInput,
+) (*usecase.RecommendBasketOutput, error) {
+	if r.notifierCfg.MonthlyBudget == nil || len(input.Candidates) == 0 {
+		return &usecase.RecommendBasketOutput{}, nil
+	}
+
+	currency := input.Candidates[0].Price.Currency
+	budget, err := r.notifierCfg.MonthlyBudget.ToMoney(currency)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to convert the monthly budget to Money", slog.Any("error", err))
+		return nil, err
+	}
+
+	basket := &model.Basket{
+		Total:  model.Money{Currency: currency},
+		Budget: *budget,
+		Spent:  model.SumPurchases(input.Purchases, currency, model.StartOfBudgetMonth(r.now())),
+	}
+	basket.Items = model.RecommendBasket(input.Candidates, basket.Available())
+	for _, v := range basket.Items {
+		basket.Total.Amount += v.Price.Amount
+	}
+
+	return &usecase.RecommendBasketOutput{
+		Basket: basket,
+	}, nil
+}
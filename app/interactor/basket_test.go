@@ -0,0 +1,98 @@
+package interactor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/usecase"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRecommendBasket(t *testing.T) {
+	t.Parallel()
+
+	candidates := []*model.BasketItem{
+		{AppID: 1, Title: "Title1", Priority: 1, Price: model.Money{Currency: "AUD", Amount: 5999}},
+		{AppID: 2, Title: "Title2", Priority: 2, Price: model.Money{Currency: "AUD", Amount: 1999}},
+		{AppID: 3, Title: "Title3", Priority: 3, Price: model.Money{Currency: "AUD", Amount: 2499}},
+	}
+	purchases := []*model.Purchase{
+		{PurchasedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Price: model.Money{Currency: "AUD", Amount: 1500}},
+		{PurchasedAt: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), Price: model.Money{Currency: "AUD", Amount: 9999}},
+	}
+
+	// Parse a monthly budget in the major units of the currency
+	newMonthlyBudget := func(s string) *model.MajorAmount {
+		amount, err := model.ParseMajorAmount(s)
+		if err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+
+		return amount
+	}
+
+	testCases := map[string]struct {
+		monthlyBudget *model.MajorAmount
+		candidates    []*model.BasketItem
+		want          *model.Basket
+	}{
+		"Positive case: A basket is recommended within the budget left this month": {
+			monthlyBudget: newMonthlyBudget("60"),
+			candidates:    candidates,
+			want: &model.Basket{
+				Items:  []*model.BasketItem{candidates[1], candidates[2]},
+				Total:  model.Money{Currency: "AUD", Amount: 4498},
+				Budget: model.Money{Currency: "AUD", Amount: 6000},
+				Spent:  model.Money{Currency: "AUD", Amount: 1500},
+			},
+		},
+		"Positive case: No basket is recommended without the monthly budget": {
+			monthlyBudget: nil,
+			candidates:    candidates,
+			want:          nil,
+		},
+		"Positive case: No basket is recommended without candidates": {
+			monthlyBudget: newMonthlyBudget("60"),
+			candidates:    []*model.BasketItem{},
+			want:          nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			ctx := t.Context()
+			r := NewBasketRecommender(&config.NotifierConfig{MonthlyBudget: tc.monthlyBudget})
+			r.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+			input := &usecase.RecommendBasketInput{
+				Candidates: tc.candidates,
+				Purchases:  purchases,
+			}
+			got, err := r.RecommendBasket(ctx, input)
+			if err != nil {
+				t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+			}
+			if diff := cmp.Diff(got.Basket, tc.want); diff != "" {
+				t.Errorf("got(-) want(+)\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("Negative case: The monthly budget overflows in the currency of the candidates", func(t *testing.T) {
+		t.Parallel()
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		r := NewBasketRecommender(&config.NotifierConfig{MonthlyBudget: newMonthlyBudget("1e30")})
+		input := &usecase.RecommendBasketInput{
+			Candidates: candidates,
+		}
+		if _, err := r.RecommendBasket(ctx, input); err == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated in money.go", nil)
+		}
+	})
+}
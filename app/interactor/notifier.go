@@ -3,6 +3,8 @@ package interactor
 import (
 	"context"
 	"log/slog"
+	"maps"
+	"slices"
 	"strconv"
 	"sync"
 	"time"
@@ -15,15 +17,33 @@ import (
 	"golang.org/x/time/rate"
 )
 
+// The maximum number of video game details retrieved from the Steam Store in parallel
+const maxParallelVideoGameDetails int = 5
+
 type videoGamePricesNotifier struct {
-	cfg           *config.NotionConfig
-	sWGetter      service.SteamWishlistGetter
-	sVGDGetter    service.SteamVideoGameDetailsGetter
-	nWGetter      service.NotionWishlistGetter
-	nWICreator    service.NotionWishlistItemCreator
-	nWIUpdater    service.NotionWishlistItemUpdater
-	nWIDeleter    service.NotionWishlistItemDeleter
-	vGPODNotifier service.VideoGamePricesOnDiscordNotifier
+	cfg          *config.NotionConfig
+	steamCfg     *config.SteamConfig
+	notifierCfg  *config.NotifierConfig
+	sUIDResolver service.SteamUserIDResolver
+	sWGetter     service.SteamWishlistGetter
+	sOGGetter    service.SteamOwnedGamesGetter
+	sVGDGetter   service.SteamVideoGameDetailsGetter
+	sVGPGetter   service.SteamVideoGamePricesGetter
+	sRSGetter    service.SteamReviewSummaryGetter
+	nWGetter     service.NotionWishlistGetter
+	nWICreator   service.NotionWishlistItemCreator
+	nWIUpdater   service.NotionWishlistItemUpdater
+	nWIDeleter   service.NotionWishlistItemDeleter
+	dNotifier    service.DealNotifier
+	phRecorder   service.PriceObservationsRecorder
+	phGetter     service.PriceHistoryGetter
+	hLGetter     service.HistoricalLowsGetter
+	dQueuer      service.DealsQueuer
+	dQGetter     service.DigestQueueGetter
+	dQFlusher    service.DigestQueueFlusher
+	evaluator    service.DealRuleEvaluator
+	bRecommender usecase.BasketRecommender
+	now          func() time.Time
 }
 
 var _ usecase.VideoGamePricesNotifier = (*videoGamePricesNotifier)(nil)
@@ -31,39 +51,71 @@ var _ usecase.VideoGamePricesNotifier = (*videoGamePricesNotifier)(nil)
 // Generate a new videoGamePricesNotifier
 func NewGamePricesNotifier(
 	cfg *config.NotionConfig,
+	steamCfg *config.SteamConfig,
+	notifierCfg *config.NotifierConfig,
+	evaluator service.DealRuleEvaluator,
+	bRecommender usecase.BasketRecommender,
+	sUIDResolver service.SteamUserIDResolver,
 	sWGetter service.SteamWishlistGetter,
+	sOGGetter service.SteamOwnedGamesGetter,
 	sVGDGetter service.SteamVideoGameDetailsGetter,
+	sVGPGetter service.SteamVideoGamePricesGetter,
+	sRSGetter service.SteamReviewSummaryGetter,
 	nWGetter service.NotionWishlistGetter,
 	nWICreator service.NotionWishlistItemCreator,
 	nWIUpdater service.NotionWishlistItemUpdater,
 	nWIDeleter service.NotionWishlistItemDeleter,
-	vGPODNotifier service.VideoGamePricesOnDiscordNotifier,
+	dNotifier service.DealNotifier,
+	phRecorder service.PriceObservationsRecorder,
+	phGetter service.PriceHistoryGetter,
+	hLGetter service.HistoricalLowsGetter,
+	dQueuer service.DealsQueuer,
+	dQGetter service.DigestQueueGetter,
+	dQFlusher service.DigestQueueFlusher,
 ) *videoGamePricesNotifier {
 	return &videoGamePricesNotifier{
-		cfg:           cfg,
-		sWGetter:      sWGetter,
-		sVGDGetter:    sVGDGetter,
-		nWGetter:      nWGetter,
-		nWICreator:    nWICreator,
-		nWIUpdater:    nWIUpdater,
-		nWIDeleter:    nWIDeleter,
-		vGPODNotifier: vGPODNotifier,
+		cfg:          cfg,
+		steamCfg:     steamCfg,
+		notifierCfg:  notifierCfg,
+		sUIDResolver: sUIDResolver,
+		sWGetter:     sWGetter,
+		sOGGetter:    sOGGetter,
+		sVGDGetter:   sVGDGetter,
+		sVGPGetter:   sVGPGetter,
+		sRSGetter:    sRSGetter,
+		nWGetter:     nWGetter,
+		nWICreator:   nWICreator,
+		nWIUpdater:   nWIUpdater,
+		nWIDeleter:   nWIDeleter,
+		dNotifier:    dNotifier,
+		phRecorder:   phRecorder,
+		phGetter:     phGetter,
+		hLGetter:     hLGetter,
+		dQueuer:      dQueuer,
+		dQGetter:     dQGetter,
+		dQFlusher:    dQFlusher,
+		evaluator:    evaluator,
+		bRecommender: bRecommender,
+		now:          time.Now,
 	}
 }
 
-// Notify video game prices on Discord
+// Notify video game prices on all notification channels
 func (n *videoGamePricesNotifier) NotifyVideoGamePrices(
 	ctx context.Context,
 	input *usecase.NotifyVideoGamePricesInput,
 ) (*usecase.NotifyVideoGamePricesOutput, error) {
-	// Get a list of video game details on the Steam Store
-	vGDList, err := n.getVideoGameDetailsList(ctx)
+	// Resolve the Steam user IDs into SteamID64s
+	steamID64s, err := n.resolveSteamUserIDs(ctx)
 	if err != nil {
-		slog.ErrorContext(
-			ctx,
-			"failed to get a list of video game details on the Steam Store",
-			slog.Any("error", err),
-		)
+		slog.ErrorContext(ctx, "failed to resolve Steam user IDs", slog.Any("error", err))
+		return nil, err
+	}
+
+	// Get wishlists of all Steam accounts from the Steam Store
+	appIDs, wishlistItems, err := n.getSteamWishlist(ctx, steamID64s)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get a Steam Store wishlist", slog.Any("error", err))
 		return nil, err
 	}
 
@@ -74,71 +126,304 @@ func (n *videoGamePricesNotifier) NotifyVideoGamePrices(
 		return nil, err
 	}
 
+	// Convert a Notion wishlist to a map
+	convertedNWishList, err := n.convertNotionWishlist(ctx, nWishlist.WishlistItems)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to convert a Notion DB wishlist", slog.Any("error", err))
+		return nil, err
+	}
+
+	// Get current prices of video games on the Steam Store in batches
+	vGPrices, err := n.sVGPGetter.GetSteamVideoGamePrices(ctx, &service.GetSteamVideoGamePricesInput{AppIDs: appIDs})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get prices of video games on the Steam Store", slog.Any("error", err))
+		return nil, err
+	}
+
+	// Record the current prices of video games in the price history
+	if err := n.recordPriceObservations(ctx, appIDs, vGPrices.VideoGamePrices); err != nil {
+		slog.ErrorContext(ctx, "failed to record the price history of video games", slog.Any("error", err))
+		return nil, err
+	}
+
 	// Create or update a wishlist on the Notion DB based on the Steam Store wishlist
-	discordContents, err := n.createOrUpdateNotionWishlist(ctx, vGDList, nWishlist.WishlistItems)
+	dealContents, basketContents, unavailableVideoGames, err := n.createOrUpdateNotionWishlist(
+		ctx,
+		vGPrices.VideoGamePrices,
+		wishlistItems,
+		convertedNWishList,
+	)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to create or update a wishlist on the Notion DB", slog.Any("error", err))
 		return nil, err
 	}
+	maps.Copy(unavailableVideoGames, vGPrices.UnavailableVideoGames)
 
-	// Delete a wishlist on the Notion DB
-	if err := n.deleteNotionWishlistItems(ctx, vGDList, nWishlist.WishlistItems); err != nil {
-		slog.ErrorContext(ctx, "failed to delete a wishlist on the Notion DB", slog.Any("error", err))
+	// Remove a wishlist on the Notion DB
+	//
+	// [FYI]
+	// Only video games that no account wishlists any longer are removed.
+	// Video games that cannot be retrieved from the Steam Store are still on the wishlist, so they are not removed
+	listPurchased, err := n.removeNotionWishlistItems(ctx, steamID64s, appIDs, convertedNWishList)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to remove a wishlist on the Notion DB", slog.Any("error", err))
+		return nil, err
+	}
+
+	// Separate instant alerts from deals queued for the weekly digest
+	instantContents, digest, err := n.scheduleDeals(
+		ctx,
+		appIDs,
+		dealContents,
+		vGPrices.VideoGamePrices,
+		convertedNWishList,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to schedule deals for the weekly digest", slog.Any("error", err))
 		return nil, err
 	}
 
-	// Terminate processing if there are no video game prices to notify
-	if len(discordContents) == 0 {
+	// Terminate processing if there are no video game prices, skipped video games, and a digest to notify
+	//
+	// [FYI]
+	// A basket is recommended only together with a notification, so that the same basket is not notified in every run
+	// even if all deals are queued for the weekly digest or within the cooldown
+	if len(instantContents) == 0 && len(unavailableVideoGames) == 0 && digest == nil {
+		// Record the notification state of deals queued for the weekly digest
+		if err := n.recordNotificationState(ctx, dealContents, convertedNWishList); err != nil {
+			slog.ErrorContext(ctx, "failed to record the notification state on the Notion DB", slog.Any("error", err))
+			return nil, err
+		}
+
 		return &usecase.NotifyVideoGamePricesOutput{}, nil
 	}
 
-	// Notify video game prices on Discord
-	vGPODInput := &service.NotifyVideoGamePricesOnDiscordInput{
-		DiscordContents: discordContents,
+	// Recommend a basket of deals within the monthly budget
+	basket, err := n.recommendBasket(ctx, basketContents, convertedNWishList, listPurchased)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to recommend a basket of deals", slog.Any("error", err))
+		return nil, err
+	}
+
+	// Notify video game prices on all notification channels
+	//
+	// [FYI]
+	// Channels which failed are reported, and processing is aborted only if all channels failed
+	dInput := &service.NotifyDealsInput{
+		Contents:        instantContents,
+		SkippedContents: n.buildSkippedContents(unavailableVideoGames, convertedNWishList),
+		Basket:          basket,
+		Digest:          digest,
+	}
+	dOutput, err := n.dNotifier.NotifyDeals(ctx, dInput)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to notify video game prices", slog.Any("error", err))
+		return nil, err
+	}
+	for name, err := range dOutput.FailedChannels {
+		slog.WarnContext(
+			ctx,
+			"failed to notify video game prices on a channel",
+			slog.String("channel", name),
+			slog.Any("error", err),
+		)
 	}
-	if _, err := n.vGPODNotifier.NotifyVideoGamePricesOnDiscord(ctx, vGPODInput); err != nil {
-		slog.ErrorContext(ctx, "failed to notify video game prices on Discord", slog.Any("error", err))
+
+	// Record the notification state of notified video games on the Notion DB
+	//
+	// [FYI]
+	// The state is recorded only after the deals are notified, so that they are notified again in the next run
+	// if all channels failed
+	if err := n.recordNotificationState(ctx, dealContents, convertedNWishList); err != nil {
+		slog.ErrorContext(ctx, "failed to record the notification state on the Notion DB", slog.Any("error", err))
 		return nil, err
 	}
 
+	// Flush the queue of the weekly digest after it is sent, so that the deals are not lost if sending fails
+	if digest != nil {
+		if _, err := n.dQFlusher.FlushDigestQueue(ctx, &service.FlushDigestQueueInput{SentAt: n.now()}); err != nil {
+			slog.ErrorContext(ctx, "failed to flush the queue of the weekly digest", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
 	return &usecase.NotifyVideoGamePricesOutput{}, nil
 }
 
-// Get a list of video game details on the Steam Store
-func (n *videoGamePricesNotifier) getVideoGameDetailsList(
+// Resolve the configured Steam user IDs into SteamID64s
+//
+// [FYI]
+// The SteamID64s are keyed by the Steam user IDs as configured,
+// because the configured IDs are shown in the Notion DB instead of the SteamID64s
+func (n *videoGamePricesNotifier) resolveSteamUserIDs(ctx context.Context) (map[string]string, error) {
+	steamID64s := make(map[string]string, len(n.steamCfg.SteamUserIDs))
+	for _, steamUserID := range n.steamCfg.SteamUserIDs {
+		input := &service.ResolveSteamUserIDInput{
+			SteamUserID: steamUserID,
+		}
+		resolved, err := n.sUIDResolver.ResolveSteamUserID(ctx, input)
+		if err != nil {
+			slog.ErrorContext(
+				ctx,
+				"failed to resolve a Steam user ID",
+				slog.String("steam_user_id", steamUserID),
+				slog.Any("error", err),
+			)
+			return nil, err
+		}
+
+		steamID64s[steamUserID] = resolved.SteamID64
+	}
+
+	return steamID64s, nil
+}
+
+// Record the current prices of video games in the price history
+//
+// [FYI]
+// All prices are recorded at the same time, and video games without a price are not recorded
+func (n *videoGamePricesNotifier) recordPriceObservations(
 	ctx context.Context,
-) (map[model.SteamAppID]*model.SteamStoreVideoGameDetails, error) {
-	// Get a wishlist from the Steam Store
-	steamWishlist, err := n.sWGetter.GetSteamWishlist(ctx, &service.GetSteamWishlistInput{})
-	if err != nil {
-		slog.ErrorContext(ctx, "failed to get a Steam Store wishlist", slog.Any("error", err))
-		return nil, err
+	appIDs []model.SteamAppID,
+	vGPrices map[model.SteamAppID]*model.SteamCurrentPrice,
+) error {
+	observedAt := n.now()
+	observations := make([]*model.PriceObservation, 0, len(vGPrices))
+	for _, appID := range appIDs {
+		observation, err := model.NewPriceObservation(ctx, appID, observedAt, vGPrices[appID])
+		if err != nil {
+			slog.ErrorContext(
+				ctx,
+				"failed to generate an observation of a video game price",
+				slog.Any("app_id", appID),
+				slog.Any("error", err),
+			)
+			return err
+		}
+		if observation == nil {
+			continue
+		}
+
+		observations = append(observations, observation)
 	}
 
-	// Get a list of video game details on the Steam Store
-	//
-	// [FYI]
-	// The rate limiter is set to 5 requests per second and parallel processing is used,
-	// but there is no recommended rate limit due to the unofficial Steam Store API
-	videoGameDetailsList := make(
-		map[model.SteamAppID]*model.SteamStoreVideoGameDetails,
-		len(steamWishlist.Wishlist.Response.Items),
-	)
+	if len(observations) == 0 {
+		return nil
+	}
+
+	input := &service.RecordPriceObservationsInput{
+		PriceObservations: observations,
+	}
+	if _, err := n.phRecorder.RecordPriceObservations(ctx, input); err != nil {
+		slog.ErrorContext(ctx, "failed to record observations of video game prices", slog.Any("error", err))
+		return err
+	}
+
+	return nil
+}
+
+// Get wishlists of all Steam accounts and merge them by app IDs
+//
+// [FYI]
+// The app IDs are returned in the order in which they first appear in the wishlists
+func (n *videoGamePricesNotifier) getSteamWishlist(
+	ctx context.Context,
+	steamID64s map[string]string,
+) ([]model.SteamAppID, map[model.SteamAppID]*model.SteamWishlistItem, error) {
+	appIDs := make([]model.SteamAppID, 0)
+	wishlistItems := make(map[model.SteamAppID]*model.SteamWishlistItem)
+	for _, steamUserID := range n.steamCfg.SteamUserIDs {
+		input := &service.GetSteamWishlistInput{
+			SteamUserID: steamID64s[steamUserID],
+		}
+		steamWishlist, err := n.sWGetter.GetSteamWishlist(ctx, input)
+		if err != nil {
+			slog.ErrorContext(
+				ctx,
+				"failed to get a Steam Store wishlist",
+				slog.String("steam_user_id", steamUserID),
+				slog.Any("error", err),
+			)
+			return nil, nil, err
+		}
+
+		for _, item := range steamWishlist.Wishlist.Response.Items {
+			appID := model.SteamAppID(item.AppID)
+			if _, ok := wishlistItems[appID]; !ok {
+				appIDs = append(appIDs, appID)
+				wishlistItems[appID] = &model.SteamWishlistItem{AppID: appID}
+			}
+			wishlistItems[appID].Merge(steamUserID, item)
+		}
+	}
+
+	return appIDs, wishlistItems, nil
+}
+
+// Convert a Notion wishlist to a map keyed by app IDs
+func (n *videoGamePricesNotifier) convertNotionWishlist(
+	ctx context.Context,
+	nWishList []*model.NotionWishlistItem,
+) (map[model.SteamAppID]*model.NotionWishlistItem, error) {
+	convertedNWishList := make(map[model.SteamAppID]*model.NotionWishlistItem, len(nWishList))
+	for _, v := range nWishList {
+		appID, err := strconv.Atoi(v.Properties.NotionAppID.Title[0].NotionText.NotionContent)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to convert the app ID to int", slog.Any("error", err))
+			return nil, err
+		}
+
+		convertedNWishList[model.SteamAppID(appID)] = v
+	}
+
+	return convertedNWishList, nil
+}
+
+// Get a list of video game details on the Steam Store
+//
+// [FYI]
+// Parallel processing is used up to maxParallelVideoGameDetails, and the requests are rate-limited
+// by the Steam Store video game details getter, which slows down adaptively when the Steam Store throttles them.
+// Video games that cannot be retrieved are returned separately with the reason.
+func (n *videoGamePricesNotifier) getVideoGameDetailsList(
+	ctx context.Context,
+	appIDs []model.SteamAppID,
+) (map[model.SteamAppID]*model.SteamStoreVideoGameDetails, map[model.SteamAppID]error, error) {
+	videoGameDetailsList := make(map[model.SteamAppID]*model.SteamStoreVideoGameDetails, len(appIDs))
+	unavailableVideoGames := make(map[model.SteamAppID]error)
 	var mu sync.Mutex
-	limiter := rate.NewLimiter(5, 1)
+	sem := make(chan struct{}, maxParallelVideoGameDetails)
 	meg := &multierror.Group{}
-	for _, item := range steamWishlist.Wishlist.Response.Items {
-		if err := limiter.Wait(ctx); err != nil {
-			slog.ErrorContext(ctx, "failed to wait the rate limiter", slog.Any("error", err))
-			return nil, err
+	for _, appID := range appIDs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			_ = meg.Wait()
+			slog.ErrorContext(ctx, "failed to wait for a video game details to be retrieved", slog.Any("error", ctx.Err()))
+			return nil, nil, ctx.Err()
 		}
 
 		meg.Go(func() error {
+			defer func() { <-sem }()
+
 			// Get a video game details on the Steam Store
 			input := &service.GetSteamVideoGameDetailsInput{
-				AppID: model.SteamAppID(item.AppID),
+				AppID: appID,
 			}
 			videoGameDetails, err := n.sVGDGetter.GetSteamVideoGameDetails(ctx, input)
+			if model.IsSteamVideoGameUnavailable(err) {
+				slog.WarnContext(
+					ctx,
+					"skip a video game that cannot be retrieved from the Steam Store",
+					slog.Any("app_id", appID),
+					slog.Any("error", err),
+				)
+				mu.Lock()
+				unavailableVideoGames[appID] = err
+				mu.Unlock()
+
+				return nil
+			}
 			if err != nil {
 				slog.ErrorContext(
 					ctx,
@@ -149,7 +434,7 @@ func (n *videoGamePricesNotifier) getVideoGameDetailsList(
 			}
 
 			mu.Lock()
-			videoGameDetailsList[model.SteamAppID(item.AppID)] = videoGameDetails.VideoGameDetails
+			videoGameDetailsList[appID] = videoGameDetails.VideoGameDetails
 			mu.Unlock()
 
 			return nil
@@ -162,55 +447,176 @@ func (n *videoGamePricesNotifier) getVideoGameDetailsList(
 			"failed to get a list of video game details on the Steam Store",
 			slog.Any("error", err),
 		)
-		return nil, err
+		return nil, nil, err
 	}
 
-	return videoGameDetailsList, nil
+	return videoGameDetailsList, unavailableVideoGames, nil
 }
 
 // Create or update a wishlist on the Notion DB based on the Steam Store wishlist
+//
+// [FYI]
+// Full video game details are retrieved only for new video games and existing video games
+// whose release dates are not set to a day yet (e.g. "Coming soon" or "Q3 2025"),
+// and the other existing items in the Notion DB are updated with their current prices.
+// The deals to notify and the deals to recommend in a basket are returned (see updateNotionWishlistItems)
 func (n *videoGamePricesNotifier) createOrUpdateNotionWishlist(
 	ctx context.Context,
-	vGDList map[model.SteamAppID]*model.SteamStoreVideoGameDetails,
-	nWishList []*model.NotionWishlistItem,
-) (map[model.SteamAppID]*model.DiscordContent, error) {
-	// Convert a Notion wishlist to a map
-	convertedNWishList := make(map[model.SteamAppID]*model.NotionWishlistItem, len(nWishList))
-	for _, v := range nWishList {
-		appID, err := strconv.Atoi(v.Properties.NotionAppID.Title[0].NotionText.NotionContent)
-		if err != nil {
-			slog.ErrorContext(ctx, "failed to convert the app ID to int", slog.Any("error", err))
-			return nil, err
+	vGPrices map[model.SteamAppID]*model.SteamCurrentPrice,
+	wishlistItems map[model.SteamAppID]*model.SteamWishlistItem,
+	convertedNWishList map[model.SteamAppID]*model.NotionWishlistItem,
+) (
+	map[model.SteamAppID]*model.DealContent,
+	map[model.SteamAppID]*model.DealContent,
+	map[model.SteamAppID]error,
+	error,
+) {
+	// Separate the video game prices into two lists: one to create and one to update
+	appIDsToCreate := make([]model.SteamAppID, 0, len(vGPrices))
+	appIDsToRefresh := make([]model.SteamAppID, 0)
+	listToUpdate := make(map[model.SteamAppID]*model.SteamCurrentPrice, len(vGPrices))
+	for i, v := range vGPrices {
+		if item, ok := convertedNWishList[i]; ok {
+			listToUpdate[i] = v
+			if !item.Properties.NotionReleaseDate.IsDayPrecision() {
+				appIDsToRefresh = append(appIDsToRefresh, i)
+			}
+		} else {
+			appIDsToCreate = append(appIDsToCreate, i)
 		}
+	}
 
-		convertedNWishList[model.SteamAppID(appID)] = v
+	// Get a list of video game details of new video games on the Steam Store
+	listToCreate, unavailableVideoGames, err := n.getVideoGameDetailsList(ctx, appIDsToCreate)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to get a list of video game details on the Steam Store",
+			slog.Any("error", err),
+		)
+		return nil, nil, nil, err
 	}
 
-	// Separate the video game details list into two lists: one to create and one to update
-	listToCreate := make(map[model.SteamAppID]*model.SteamStoreVideoGameDetails, len(vGDList))
-	listToUpdate := make(map[model.SteamAppID]*model.SteamStoreVideoGameDetails, len(vGDList))
-	for i, v := range vGDList {
-		if _, ok := convertedNWishList[i]; ok {
-			listToUpdate[i] = v
-		} else {
-			listToCreate[i] = v
-		}
+	// Get the lowest prices of new video games to seed them in the Notion DB
+	lowestPrices, err := n.getLowestPrices(ctx, listToCreate)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get the lowest prices of new video games", slog.Any("error", err))
+		return nil, nil, nil, err
 	}
 
 	// Create wishlist items on the Notion DB
-	if err := n.createNotionWishlistItems(ctx, listToCreate); err != nil {
+	if err := n.createNotionWishlistItems(ctx, listToCreate, wishlistItems, lowestPrices); err != nil {
 		slog.ErrorContext(ctx, "failed to create a wishlist item on the Notion DB", slog.Any("error", err))
-		return nil, err
+		return nil, nil, nil, err
+	}
+
+	// Get a list of video game details of existing video games to refresh their titles and release dates
+	//
+	// [FYI]
+	// Video games that cannot be retrieved are updated only with their prices, and they are not reported as skipped
+	// because their prices are available
+	listToRefresh, _, err := n.getVideoGameDetailsList(ctx, appIDsToRefresh)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to get a list of video game details on the Steam Store",
+			slog.Any("error", err),
+		)
+		return nil, nil, nil, err
 	}
 
 	// Update wishlist items on the Notion DB
-	discordContents, err := n.updateNotionWishlistItems(ctx, convertedNWishList, listToUpdate)
+	dealContents, basketContents, err := n.updateNotionWishlistItems(
+		ctx,
+		convertedNWishList,
+		wishlistItems,
+		listToUpdate,
+		listToRefresh,
+	)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to update a wishlist item on the Notion DB", slog.Any("error", err))
-		return nil, err
+		return nil, nil, nil, err
+	}
+
+	return dealContents, basketContents, unavailableVideoGames, nil
+}
+
+// Get the lowest prices of new video games
+//
+// [FYI]
+// The historical low from the provider is used if it is found in the same currency as the current price.
+// Otherwise, the lowest price observed by this application is used as a fallback, so that a new video game
+// has its lowest price from the first run. The lowest price never exceeds the current price
+func (n *videoGamePricesNotifier) getLowestPrices(
+	ctx context.Context,
+	listToCreate map[model.SteamAppID]*model.SteamStoreVideoGameDetails,
+) (map[model.SteamAppID]*model.Money, error) {
+	// Convert the current prices of video games to Money
+	//
+	// [FYI]
+	// Video games without a price (e.g. free-to-play or unreleased video games) have no lowest price
+	currentPrices := make(map[model.SteamAppID]*model.Money, len(listToCreate))
+	for i, v := range listToCreate {
+		currentPrice, err := n.convertCurrentPrice(ctx, v.CurrentPrice)
+		if err != nil {
+			return nil, err
+		}
+		if currentPrice == nil {
+			continue
+		}
+
+		currentPrices[i] = currentPrice
+	}
+
+	lowestPrices := make(map[model.SteamAppID]*model.Money, len(currentPrices))
+	if len(currentPrices) == 0 {
+		return lowestPrices, nil
+	}
+
+	// Get historical lows of the video games from the provider
+	//
+	// [FYI]
+	// The provider is an external service, so its failure does not stop processing and the fallback is used instead
+	historicalLows := make(map[model.SteamAppID]*model.Money)
+	appIDs := slices.Sorted(maps.Keys(currentPrices))
+	hLOutput, err := n.hLGetter.GetHistoricalLows(ctx, &service.GetHistoricalLowsInput{AppIDs: appIDs})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to get historical lows of video games", slog.Any("error", err))
+	} else {
+		historicalLows = hLOutput.HistoricalLows
+	}
+
+	for _, appID := range appIDs {
+		currentPrice := currentPrices[appID]
+		lowestPrice := currentPrice
+		if historicalLow, ok := historicalLows[appID]; ok && historicalLow.Currency == currentPrice.Currency {
+			if historicalLow.Amount < lowestPrice.Amount {
+				lowestPrice = historicalLow
+			}
+			lowestPrices[appID] = lowestPrice
+			continue
+		}
+
+		// Fall back to the lowest price observed by this application
+		priceHistory, err := n.phGetter.GetPriceHistory(ctx, &service.GetPriceHistoryInput{AppID: appID})
+		if err != nil {
+			slog.ErrorContext(
+				ctx,
+				"failed to get the price history of a video game",
+				slog.Any("app_id", appID),
+				slog.Any("error", err),
+			)
+			return nil, err
+		}
+		for _, v := range priceHistory.PriceObservations {
+			if v.FinalPrice.Currency == currentPrice.Currency && v.FinalPrice.Amount < lowestPrice.Amount {
+				lowestPrice = &v.FinalPrice
+			}
+		}
+		lowestPrices[appID] = lowestPrice
 	}
 
-	return discordContents, nil
+	return lowestPrices, nil
 }
 
 // Create a wishlist on the Notion DB
@@ -220,6 +626,8 @@ func (n *videoGamePricesNotifier) createOrUpdateNotionWishlist(
 func (n *videoGamePricesNotifier) createNotionWishlistItems(
 	ctx context.Context,
 	listToCreate map[model.SteamAppID]*model.SteamStoreVideoGameDetails,
+	wishlistItems map[model.SteamAppID]*model.SteamWishlistItem,
+	lowestPrices map[model.SteamAppID]*model.Money,
 ) error {
 	limiter := rate.NewLimiter(3, 1)
 	meg := &multierror.Group{}
@@ -230,12 +638,21 @@ func (n *videoGamePricesNotifier) createNotionWishlistItems(
 		}
 
 		meg.Go(func() error {
-			// Convert the current price of a video game to uint64
+			// Convert the current price of a video game to Money
 			currentPrice, err := n.convertCurrentPrice(ctx, v.CurrentPrice)
 			if err != nil {
 				return err
 			}
 
+			// Convert the regular price and the discount percent of a video game
+			regularPrice, discountPercent, err := n.convertDiscount(ctx, v.CurrentPrice)
+			if err != nil {
+				return err
+			}
+
+			// Convert the release date of a video game with its precision
+			releaseDate := n.convertReleaseDate(ctx, v.ReleaseDate)
+
 			input := &service.CreateNotionWishlistItemInput{
 				WishlistItem: &model.NotionWishlistItem{
 					Parent: &model.NotionParent{
@@ -251,24 +668,21 @@ func (n *videoGamePricesNotifier) createNotionWishlistItems(
 								},
 							},
 						},
-						NotionTitle: &model.NotionTitle{
-							RichText: []*model.NotionContent{
-								{
-									NotionText: &model.NotionText{
-										NotionContent: v.Title,
-									},
-								},
-							},
-						},
-						CurrentPrice: &model.NotionPrice{
-							Number: currentPrice,
-						},
-						LowestPrice: &model.NotionPrice{
-							Number: nil,
+						NotionTitle:       model.NewNotionTitle(v.Title),
+						CurrentPrice:      model.NewNotionPrice(currentPrice),
+						Currency:          model.NewNotionCurrency(currentPrice),
+						LowestPrice:       model.NewNotionPrice(lowestPrices[i]),
+						NotionReleaseDate: model.NewNotionReleaseDate(releaseDate),
+						Priority: &model.NotionPriority{
+							Number: wishlistItems[i].Priority,
 						},
-						NotionReleaseDate: &model.NotionReleaseDate{
-							NotionDate: n.convertReleaseDate(ctx, v.ReleaseDate),
+						DateAdded:    model.NewNotionDateAdded(wishlistItems[i].DateAdded),
+						WantedBy:     model.NewNotionMultiSelect(wishlistItems[i].WantedBy),
+						RegularPrice: model.NewNotionPrice(regularPrice),
+						DiscountPercent: &model.NotionPercent{
+							Number: discountPercent,
 						},
+						ReleaseDateText: model.NewNotionRichText(releaseDate.Text),
 					},
 				},
 			}
@@ -292,76 +706,198 @@ func (n *videoGamePricesNotifier) createNotionWishlistItems(
 // Update a wishlist on the Notion DB
 //
 // [FYI]
-// The rate limiter is set to 3 requests per second and parallel processing is used
+// The rate limiter is set to 3 requests per second and parallel processing is used.
+// A video game is notified if any deal rule matches and its notification state allows it (see shouldNotify),
+// and the state is reset when no deal rule matches any longer (e.g. its sale ends).
+// The state and the new lowest prices of notified video games are recorded after they are notified (see recordNotificationState).
+// Video games matching any deal rule are returned as basket contents regardless of the cooldown,
+// so that a deal notified recently can still be recommended in a basket.
+// The title and the release date are updated only for video games whose details are refreshed
 func (n *videoGamePricesNotifier) updateNotionWishlistItems(
 	ctx context.Context,
 	convertedNWishList map[model.SteamAppID]*model.NotionWishlistItem,
-	listToUpdate map[model.SteamAppID]*model.SteamStoreVideoGameDetails,
-) (map[model.SteamAppID]*model.DiscordContent, error) {
-	discordContents := make(map[model.SteamAppID]*model.DiscordContent, 0)
+	wishlistItems map[model.SteamAppID]*model.SteamWishlistItem,
+	listToUpdate map[model.SteamAppID]*model.SteamCurrentPrice,
+	listToRefresh map[model.SteamAppID]*model.SteamStoreVideoGameDetails,
+) (map[model.SteamAppID]*model.DealContent, map[model.SteamAppID]*model.DealContent, error) {
+	dealContents := make(map[model.SteamAppID]*model.DealContent, 0)
+	basketContents := make(map[model.SteamAppID]*model.DealContent, 0)
+	notifiedAt := n.now()
+	var mu sync.Mutex
 	limiter := rate.NewLimiter(3, 1)
 	meg := &multierror.Group{}
 	for i, v := range listToUpdate {
 		if err := limiter.Wait(ctx); err != nil {
 			slog.ErrorContext(ctx, "failed to wait the rate limiter", slog.Any("error", err))
-			return nil, err
+			return nil, nil, err
 		}
 
 		meg.Go(func() error {
-			// Convert the current price of a video game to uint64
-			currentPrice, err := n.convertCurrentPrice(ctx, v.CurrentPrice)
+			// Convert the current price of a video game to Money
+			currentPrice, err := n.convertCurrentPrice(ctx, v)
 			if err != nil {
 				return err
 			}
 
-			// Compare the current price of a video game with its lowest price
-			var lowestPrice *uint64
-			if convertedNWishList[i].Properties.LowestPrice.Number == nil || currentPrice == nil {
-				// The current and lowest prices are set to nil if either price is not available
-				lowestPrice = nil
-			} else if *convertedNWishList[i].Properties.LowestPrice.Number >= *currentPrice {
-				// Add a video game to the Discord content if the current price is lower than or equal to the lowest price
-				discordContents[i] = &model.DiscordContent{
-					Title:        v.Title,
-					CurrentPrice: *currentPrice,
-					LowestPrice:  *convertedNWishList[i].Properties.LowestPrice.Number,
+			// Convert the regular price and the discount percent of a video game
+			regularPrice, discountPercent, err := n.convertDiscount(ctx, v)
+			if err != nil {
+				return err
+			}
+
+			// Refresh the title and the release date of a video game with its details
+			properties := convertedNWishList[i].Properties
+			title := properties.NotionTitle.String()
+			var notionTitle *model.NotionTitle
+			var notionReleaseDate *model.NotionReleaseDate
+			var releaseDateText *model.NotionRichText
+			if details, ok := listToRefresh[i]; ok {
+				if details.Title != "" && details.Title != title {
+					title = details.Title
+					notionTitle = model.NewNotionTitle(title)
+				}
+				releaseDate := n.convertReleaseDate(ctx, details.ReleaseDate)
+				notionReleaseDate = model.NewNotionReleaseDate(releaseDate)
+				releaseDateText = model.NewNotionRichText(releaseDate.Text)
+			}
+
+			// Convert the lowest price of a video game into the currency of its current price
+			//
+			// [FYI]
+			// Prices stored in another currency (e.g. STEAM_COUNTRY_CODE has been changed) are ignored,
+			// and they are reset with the current price instead of being compared with it
+			currencyChanged := currentPrice != nil && !properties.IsPricedIn(currentPrice.Currency)
+			var lowestPrice *model.Money
+			var targetPrice *model.NotionPrice
+			if currencyChanged {
+				slog.WarnContext(
+					ctx,
+					"reset prices stored in another currency",
+					slog.Any("app_id", i),
+					slog.String("stored_currency", properties.Currency.String()),
+					slog.Any("currency", currentPrice.Currency),
+				)
+				targetPrice = model.NewNotionPrice(nil)
+			} else if currentPrice != nil {
+				lowestPrice, err = properties.LowestPrice.ToMoney(ctx, currentPrice.Currency)
+				if err != nil {
+					return err
 				}
-				lowestPrice = currentPrice
+			}
+
+			// Evaluate deal rules of a video game with its current price
+			//
+			// [FYI]
+			// The notification state is kept as it is on the Notion DB if lastNotifiedPrice and lastNotifiedAt are nil
+			var notify bool
+			var lastNotifiedPrice *model.NotionPrice
+			var lastNotifiedAt *model.NotionNotifiedAt
+			if currentPrice == nil {
+				// The current and lowest prices are set to nil if the current price is not available
+				lowestPrice = nil
 			} else {
-				lowestPrice = convertedNWishList[i].Properties.LowestPrice.Number
+				facts, err := n.buildDealFacts(
+					ctx,
+					i,
+					properties,
+					wishlistItems[i],
+					currentPrice,
+					regularPrice,
+					lowestPrice,
+					discountPercent,
+				)
+				if err != nil {
+					return err
+				}
+
+				// A video game whose deal rules fail to be evaluated (e.g. division by zero) is treated as no rule matches,
+				// so that it does not stop processing other video games
+				evaluation, ok, err := n.evaluator.Evaluate(facts)
+				if err != nil {
+					slog.WarnContext(
+						ctx,
+						"treat a video game whose deal rules failed to be evaluated as not matched",
+						slog.Any("app_id", i),
+						slog.Any("error", err),
+					)
+					evaluation, ok = nil, false
+				}
+				if ok {
+					content := &model.DealContent{
+						Title:           title,
+						Priority:        wishlistItems[i].Priority,
+						CurrentPrice:    *currentPrice,
+						LowestPrice:     lowestPrice,
+						RegularPrice:    *regularPrice,
+						DiscountPercent: *discountPercent,
+						DealClass:       evaluation.DealClass,
+						TriggeredRules:  evaluation.TriggeredRules,
+					}
+
+					// Add a video game to the basket contents if any deal rule matches and it is neither muted nor snoozed,
+					// whether it has been notified recently or not
+					silenced, err := isSilenced(ctx, properties, notifiedAt)
+					if err != nil {
+						return err
+					}
+					if !silenced {
+						mu.Lock()
+						basketContents[i] = content
+						mu.Unlock()
+					}
+
+					// Add a video game to the deal contents if any deal rule matches,
+					// it is neither muted nor snoozed, and it has not been notified at the same price recently
+					notify, err = n.shouldNotify(ctx, properties, currentPrice, notifiedAt)
+					if err != nil {
+						return err
+					}
+					if notify {
+						mu.Lock()
+						dealContents[i] = content
+						mu.Unlock()
+					}
+				}
+				if !notify && (!ok || currencyChanged) && hasNotificationState(properties) {
+					// Reset the notification state because no deal rule matches any longer or it is in another currency,
+					// so that the video game is notified again when a rule matches (e.g. its sale restarts)
+					lastNotifiedPrice = model.NewNotionPrice(nil)
+					lastNotifiedAt = model.NewNotionNotifiedAt(time.Time{})
+				}
+
+				// Update the lowest price if the current price is lower than or equal to it,
+				// or reset it with the current price if it was stored in another currency
+				//
+				// [FYI]
+				// The lowest price of a notified video game is updated together with its notification state (see recordNotificationState),
+				// so that it is notified again as a new low in the next run if all channels failed
+				if currencyChanged || (!notify && lowestPrice != nil && currentPrice.Amount <= lowestPrice.Amount) {
+					lowestPrice = currentPrice
+				}
 			}
 
 			input := &service.UpdateNotionWishlistItemInput{
 				WishlistItem: &model.NotionWishlistItem{
 					ID: convertedNWishList[i].ID,
 					Properties: &model.NotionProperties{
-						NotionAppID: &model.NotionAppID{
-							Title: []*model.NotionContent{
-								{
-									NotionText: &model.NotionText{
-										NotionContent: strconv.Itoa(int(i)),
-									},
-								},
-							},
-						},
-						NotionTitle: &model.NotionTitle{
-							RichText: []*model.NotionContent{
-								{
-									NotionText: &model.NotionText{
-										NotionContent: v.Title,
-									},
-								},
-							},
-						},
-						CurrentPrice: &model.NotionPrice{
-							Number: currentPrice,
-						},
-						LowestPrice: &model.NotionPrice{
-							Number: lowestPrice,
+						NotionTitle:  notionTitle,
+						CurrentPrice: model.NewNotionPrice(currentPrice),
+						Currency:     model.NewNotionCurrency(currentPrice),
+						LowestPrice:  model.NewNotionPrice(lowestPrice),
+						Priority: &model.NotionPriority{
+							Number: wishlistItems[i].Priority,
 						},
-						NotionReleaseDate: &model.NotionReleaseDate{
-							NotionDate: n.convertReleaseDate(ctx, v.ReleaseDate),
+						DateAdded:    model.NewNotionDateAdded(wishlistItems[i].DateAdded),
+						WantedBy:     model.NewNotionMultiSelect(wishlistItems[i].WantedBy),
+						RegularPrice: model.NewNotionPrice(regularPrice),
+						DiscountPercent: &model.NotionPercent{
+							Number: discountPercent,
 						},
+						LastNotifiedPrice: lastNotifiedPrice,
+						LastNotifiedAt:    lastNotifiedAt,
+						TargetPrice:       targetPrice,
+						NotionReleaseDate: notionReleaseDate,
+						ReleaseDateText:   releaseDateText,
 					},
 				},
 			}
@@ -376,77 +912,657 @@ func (n *videoGamePricesNotifier) updateNotionWishlistItems(
 
 	if err := meg.Wait(); err != nil {
 		slog.ErrorContext(ctx, "failed to update a wishlist item on the Notion DB", slog.Any("error", err))
-		return nil, err
+		return nil, nil, err
+	}
+
+	return dealContents, basketContents, nil
+}
+
+// Record the notification state of notified video games on the Notion DB
+//
+// [FYI]
+// The rate limiter is set to 3 requests per second and parallel processing is used.
+// The lowest price is also updated if the current price is lower than it.
+// Deals queued for the weekly digest are also recorded, because they are kept in the queue until it is sent
+func (n *videoGamePricesNotifier) recordNotificationState(
+	ctx context.Context,
+	dealContents map[model.SteamAppID]*model.DealContent,
+	convertedNWishList map[model.SteamAppID]*model.NotionWishlistItem,
+) error {
+	notifiedAt := n.now()
+	limiter := rate.NewLimiter(3, 1)
+	meg := &multierror.Group{}
+	for i, v := range dealContents {
+		if err := limiter.Wait(ctx); err != nil {
+			slog.ErrorContext(ctx, "failed to wait the rate limiter", slog.Any("error", err))
+			return err
+		}
+
+		meg.Go(func() error {
+			var lowestPrice *model.NotionPrice
+			if v.LowestPrice != nil && v.CurrentPrice.Amount < v.LowestPrice.Amount {
+				lowestPrice = model.NewNotionPrice(&v.CurrentPrice)
+			}
+
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: convertedNWishList[i].ID,
+					Properties: &model.NotionProperties{
+						LowestPrice:       lowestPrice,
+						LastNotifiedPrice: model.NewNotionPrice(&v.CurrentPrice),
+						LastNotifiedAt:    model.NewNotionNotifiedAt(notifiedAt),
+					},
+				},
+			}
+			if _, err := n.nWIUpdater.UpdateNotionWishlistItem(ctx, input); err != nil {
+				slog.ErrorContext(
+					ctx,
+					"failed to record the notification state of a video game on the Notion DB",
+					slog.Any("app_id", i),
+					slog.Any("error", err),
+				)
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	if err := meg.Wait(); err != nil {
+		slog.ErrorContext(ctx, "failed to record the notification state of video games", slog.Any("error", err))
+		return err
 	}
 
-	return discordContents, nil
+	return nil
 }
 
-// Convert the current price of a video game to uint64
+// Build facts of a video game to evaluate deal rules
+//
+// [FYI]
+// The target price is converted into the currency of the current price, and it is ignored if it was set in another currency.
+// The review score is retrieved from the Steam Store only if a deal rule refers to it
+func (n *videoGamePricesNotifier) buildDealFacts(
+	ctx context.Context,
+	appID model.SteamAppID,
+	properties *model.NotionProperties,
+	wishlistItem *model.SteamWishlistItem,
+	currentPrice *model.Money,
+	regularPrice *model.Money,
+	lowestPrice *model.Money,
+	discountPercent *uint32,
+) (*model.DealFacts, error) {
+	var targetPrice *model.Money
+	if properties.IsPricedIn(currentPrice.Currency) {
+		var err error
+		targetPrice, err = properties.TargetPrice.ToMoney(ctx, currentPrice.Currency)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to convert the target price to Money", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	facts := &model.DealFacts{
+		CurrentPrice: *currentPrice,
+		RegularPrice: regularPrice,
+		LowestPrice:  lowestPrice,
+		TargetPrice:  targetPrice,
+		Priority:     wishlistItem.Priority,
+	}
+	if discountPercent != nil {
+		facts.DiscountPercent = *discountPercent
+	}
+	if properties.MinDiscount != nil {
+		facts.MinDiscountPercent = properties.MinDiscount.Number
+	}
+	if n.evaluator.RequiresVariable(model.DealVariableReviewScore) {
+		facts.ReviewScore = n.getReviewScore(ctx, appID)
+	}
+
+	return facts, nil
+}
+
+// Get the percentage of positive reviews of a video game on the Steam Store
+//
+// [FYI]
+// nil is returned if the video game has no reviews or the review summary cannot be retrieved,
+// so that deal rules referring to the review score do not match instead of stopping the whole process
+func (n *videoGamePricesNotifier) getReviewScore(ctx context.Context, appID model.SteamAppID) *float64 {
+	output, err := n.sRSGetter.GetSteamReviewSummary(ctx, &service.GetSteamReviewSummaryInput{AppID: appID})
+	if err != nil {
+		slog.WarnContext(
+			ctx,
+			"failed to get a review summary of a video game on the Steam Store",
+			slog.Any("app_id", appID),
+			slog.Any("error", err),
+		)
+		return nil
+	}
+
+	reviewScore, ok := output.ReviewSummary.PositivePercent()
+	if !ok {
+		return nil
+	}
+
+	return &reviewScore
+}
+
+// Check whether a deal of a video game should be notified
+//
+// [FYI]
+// A video game muted or snoozed on the Notion DB is not notified, and its notification state is kept as it is.
+// Otherwise, a video game is notified if it has never been notified, its last notified price is in another currency,
+// its price drops below the last notified price, or the cooldown has passed since it was notified last time
+func (n *videoGamePricesNotifier) shouldNotify(
+	ctx context.Context,
+	properties *model.NotionProperties,
+	currentPrice *model.Money,
+	now time.Time,
+) (bool, error) {
+	silenced, err := isSilenced(ctx, properties, now)
+	if err != nil {
+		return false, err
+	}
+	if silenced {
+		return false, nil
+	}
+	if !properties.IsPricedIn(currentPrice.Currency) {
+		return true, nil
+	}
+
+	lastNotifiedPrice, err := properties.LastNotifiedPrice.ToMoney(ctx, currentPrice.Currency)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to convert the last notified price to Money", slog.Any("error", err))
+		return false, err
+	}
+
+	lastNotifiedAt, err := properties.LastNotifiedAt.ToTime(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to convert the last notified date to time.Time", slog.Any("error", err))
+		return false, err
+	}
+
+	if lastNotifiedPrice == nil || lastNotifiedAt == nil {
+		return true, nil
+	}
+
+	if currentPrice.Amount < lastNotifiedPrice.Amount {
+		return true, nil
+	}
+
+	return now.Sub(*lastNotifiedAt) >= n.notifierCfg.NotificationCooldown, nil
+}
+
+// Check whether a video game is muted or snoozed on the Notion DB
+func isSilenced(ctx context.Context, properties *model.NotionProperties, now time.Time) (bool, error) {
+	if properties.Muted.IsChecked() {
+		return true, nil
+	}
+
+	snoozed, err := properties.SnoozeUntil.IsSnoozed(ctx, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to check whether a video game is snoozed", slog.Any("error", err))
+		return false, err
+	}
+
+	return snoozed, nil
+}
+
+// Check whether a video game has a notification state on the Notion DB
+func hasNotificationState(properties *model.NotionProperties) bool {
+	return (properties.LastNotifiedPrice != nil && properties.LastNotifiedPrice.Number != nil) ||
+		(properties.LastNotifiedAt != nil && properties.LastNotifiedAt.NotionDate != nil)
+}
+
+// Convert the current price of a video game to Money
 func (n *videoGamePricesNotifier) convertCurrentPrice(
 	ctx context.Context,
 	currentPrice *model.SteamCurrentPrice,
-) (*uint64, error) {
+) (*model.Money, error) {
 	if currentPrice == nil {
 		return nil, nil
 	}
 
-	convertedPrice, err := currentPrice.ConvertPriceFormat(ctx)
+	convertedPrice, err := currentPrice.ToMoney(ctx)
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to convert the current price to uint64", slog.Any("error", err))
+		slog.ErrorContext(ctx, "failed to convert the current price to Money", slog.Any("error", err))
 		return nil, err
 	}
 
 	return convertedPrice, nil
 }
 
-// Convert the release date of a video game to "2 Jan, 2006" format time string
+// Convert the regular price and the discount percent of a video game
+//
 // [FYI]
-// The release date varies depending on the video game
-// e.g. "1 Nov, 2024", "2025", and "To be announced"
+// Both are set to nil if the current price is not available
+func (n *videoGamePricesNotifier) convertDiscount(
+	ctx context.Context,
+	currentPrice *model.SteamCurrentPrice,
+) (*model.Money, *uint32, error) {
+	if currentPrice == nil {
+		return nil, nil, nil
+	}
+
+	regularPrice, err := currentPrice.RegularPriceToMoney(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to convert the regular price to Money", slog.Any("error", err))
+		return nil, nil, err
+	}
+
+	return regularPrice, &currentPrice.DiscountPercent, nil
+}
+
+// Convert the release date of a video game with its precision
+//
+// [FYI]
+// The release date varies depending on the video game and the locale
+// e.g. "1 Nov, 2024", "Q3 2025", "2025", and "To be announced"
 func (n *videoGamePricesNotifier) convertReleaseDate(
 	ctx context.Context,
 	releaseDate *model.SteamReleaseDate,
-) *model.NotionDate {
-	convertedDate, err := releaseDate.ToTime(ctx)
-	if releaseDate.Date == "To be announced" || err != nil {
-		slog.WarnContext(ctx, "failed to convert the release date to time.Time", slog.Any("error", err))
+) *model.ReleaseDate {
+	if releaseDate == nil {
+		return &model.ReleaseDate{Precision: model.ReleaseDatePrecisionUnknown}
+	}
+
+	parsedDate := releaseDate.Parse()
+	if parsedDate.Precision == model.ReleaseDatePrecisionUnknown {
+		slog.WarnContext(ctx, "failed to parse the release date", slog.String("release_date", releaseDate.Date))
+	}
+
+	return parsedDate
+}
+
+// Build contents of video games skipped because they cannot be retrieved from the Steam Store
+func (n *videoGamePricesNotifier) buildSkippedContents(
+	unavailableVideoGames map[model.SteamAppID]error,
+	convertedNWishList map[model.SteamAppID]*model.NotionWishlistItem,
+) map[model.SteamAppID]*model.SkippedContent {
+	if len(unavailableVideoGames) == 0 {
 		return nil
 	}
 
-	return &model.NotionDate{
-		Start: convertedDate.Format(time.DateOnly),
+	skippedContents := make(map[model.SteamAppID]*model.SkippedContent, len(unavailableVideoGames))
+	for i, v := range unavailableVideoGames {
+		// The title is available only if the video game is already in the Notion DB
+		var title string
+		if item, ok := convertedNWishList[i]; ok {
+			title = item.Properties.NotionTitle.String()
+		}
+
+		skippedContents[i] = &model.SkippedContent{
+			AppID:  i,
+			Title:  title,
+			Reason: v.Error(),
+		}
 	}
+
+	return skippedContents
 }
 
-// Delete a wishlist on the Notion DB
+// Remove video games that no account wishlists any longer from the Notion DB
+//
+// [FYI]
+// Video games owned by any Steam account are marked as purchased to keep their history,
+// and the other video games are deleted. Video games already marked as purchased are left as they are.
+// The video games marked as purchased this time are returned
+func (n *videoGamePricesNotifier) removeNotionWishlistItems(
+	ctx context.Context,
+	steamID64s map[string]string,
+	appIDs []model.SteamAppID,
+	convertedNWishList map[model.SteamAppID]*model.NotionWishlistItem,
+) (map[model.SteamAppID]*model.NotionWishlistItem, error) {
+	// Categorize the Notion wishlist items to remove
+	listToRemove := maps.Clone(convertedNWishList)
+	for _, appID := range appIDs {
+		delete(listToRemove, appID)
+	}
+	maps.DeleteFunc(listToRemove, func(_ model.SteamAppID, v *model.NotionWishlistItem) bool {
+		return v.Properties.Purchased.IsChecked()
+	})
+
+	// Terminate processing if there are no video games to remove not to call the Steam Web API in vain
+	if len(listToRemove) == 0 {
+		return nil, nil
+	}
+
+	ownedAppIDs, err := n.getSteamOwnedGames(ctx, steamID64s)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get owned games on Steam", slog.Any("error", err))
+		return nil, err
+	}
+
+	listToPurchase := make(map[model.SteamAppID]*model.NotionWishlistItem)
+	listToDelete := make(map[model.SteamAppID]*model.NotionWishlistItem)
+	for appID, v := range listToRemove {
+		if _, ok := ownedAppIDs[appID]; ok {
+			listToPurchase[appID] = v
+		} else {
+			listToDelete[appID] = v
+		}
+	}
+
+	if err := n.markNotionWishlistItemsAsPurchased(ctx, listToPurchase); err != nil {
+		slog.ErrorContext(ctx, "failed to mark a wishlist on the Notion DB as purchased", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := n.deleteNotionWishlistItems(ctx, listToDelete); err != nil {
+		slog.ErrorContext(ctx, "failed to delete a wishlist on the Notion DB", slog.Any("error", err))
+		return nil, err
+	}
+
+	return listToPurchase, nil
+}
+
+// Separate instant alerts from deals queued for the weekly digest
+//
+// [FYI]
+// All deals are notified instantly if the weekly digest is disabled.
+// Otherwise, only new all-time lows are notified instantly, and the other deals are queued in the database.
+// The digest is returned only on the weekday of the digest, and it is nil otherwise
+func (n *videoGamePricesNotifier) scheduleDeals(
+	ctx context.Context,
+	appIDs []model.SteamAppID,
+	dealContents map[model.SteamAppID]*model.DealContent,
+	vGPrices map[model.SteamAppID]*model.SteamCurrentPrice,
+	convertedNWishList map[model.SteamAppID]*model.NotionWishlistItem,
+) (map[model.SteamAppID]*model.DealContent, *model.Digest, error) {
+	if n.notifierCfg.DigestWeekday == nil {
+		return dealContents, nil, nil
+	}
+	weekday := *n.notifierCfg.DigestWeekday
+
+	now := n.now()
+	instantContents := make(map[model.SteamAppID]*model.DealContent, len(dealContents))
+	queuedDeals := make([]*model.QueuedDeal, 0, len(dealContents))
+	for _, appID := range slices.Sorted(maps.Keys(dealContents)) {
+		v := dealContents[appID]
+		if v.DealClass == model.DealClassNewLow {
+			instantContents[appID] = v
+			continue
+		}
+
+		queuedDeals = append(queuedDeals, &model.QueuedDeal{
+			AppID:    appID,
+			QueuedAt: now,
+			Content:  v,
+		})
+	}
+
+	if len(queuedDeals) > 0 {
+		if _, err := n.dQueuer.QueueDeals(ctx, &service.QueueDealsInput{QueuedDeals: queuedDeals}); err != nil {
+			slog.ErrorContext(ctx, "failed to queue deals for the weekly digest", slog.Any("error", err))
+			return nil, nil, err
+		}
+	}
+
+	queue, err := n.dQGetter.GetDigestQueue(ctx, &service.GetDigestQueueInput{})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get the queue of the weekly digest", slog.Any("error", err))
+		return nil, nil, err
+	}
+	if !model.IsDigestDue(now, queue.LastSentAt, weekday) {
+		return instantContents, nil, nil
+	}
+
+	discountedGames, err := n.buildDiscountedGames(ctx, appIDs, dealContents, vGPrices, convertedNWishList, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build discounted video games", slog.Any("error", err))
+		return nil, nil, err
+	}
+
+	return instantContents, model.NewDigest(queue.QueuedDeals, discountedGames), nil
+}
+
+// Build video games on the wishlist which are currently discounted
+//
+// [FYI]
+// Video games muted or snoozed on the Notion DB are excluded.
+// A title is taken from the Notion DB, or from the deal found this time if the video game has just been added
+func (n *videoGamePricesNotifier) buildDiscountedGames(
+	ctx context.Context,
+	appIDs []model.SteamAppID,
+	dealContents map[model.SteamAppID]*model.DealContent,
+	vGPrices map[model.SteamAppID]*model.SteamCurrentPrice,
+	convertedNWishList map[model.SteamAppID]*model.NotionWishlistItem,
+	now time.Time,
+) ([]*model.DiscountedGame, error) {
+	discountedGames := make([]*model.DiscountedGame, 0)
+	for _, appID := range appIDs {
+		price := vGPrices[appID]
+		if price == nil || price.DiscountPercent == 0 {
+			continue
+		}
+
+		var title string
+		if item, ok := convertedNWishList[appID]; ok {
+			silenced, err := isSilenced(ctx, item.Properties, now)
+			if err != nil {
+				return nil, err
+			}
+			if silenced {
+				continue
+			}
+
+			title = item.Properties.NotionTitle.String()
+		} else if content, ok := dealContents[appID]; ok {
+			title = content.Title
+		} else {
+			title = "App ID: " + strconv.FormatUint(uint64(appID), 10)
+		}
+
+		currentPrice, err := price.ToMoney(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to convert the current price to Money", slog.Any("error", err))
+			return nil, err
+		}
+
+		regularPrice, err := price.RegularPriceToMoney(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to convert the regular price to Money", slog.Any("error", err))
+			return nil, err
+		}
+
+		discountedGames = append(discountedGames, &model.DiscountedGame{
+			AppID:           appID,
+			Title:           title,
+			CurrentPrice:    *currentPrice,
+			RegularPrice:    *regularPrice,
+			DiscountPercent: price.DiscountPercent,
+		})
+	}
+
+	return discountedGames, nil
+}
+
+// Recommend a basket of deals within the monthly budget
 //
 // [FYI]
+// nil is returned without calling the basket recommender if the monthly budget is not set.
+// The spending of this month is tracked with the purchases on the Notion DB,
+// including video games marked as purchased this time at their last prices
+func (n *videoGamePricesNotifier) recommendBasket(
+	ctx context.Context,
+	dealContents map[model.SteamAppID]*model.DealContent,
+	convertedNWishList map[model.SteamAppID]*model.NotionWishlistItem,
+	listPurchased map[model.SteamAppID]*model.NotionWishlistItem,
+) (*model.Basket, error) {
+	if n.notifierCfg.MonthlyBudget == nil || len(dealContents) == 0 {
+		return nil, nil
+	}
+
+	candidates := make([]*model.BasketItem, 0, len(dealContents))
+	for _, appID := range slices.Sorted(maps.Keys(dealContents)) {
+		v := dealContents[appID]
+		candidates = append(candidates, &model.BasketItem{
+			AppID:    appID,
+			Title:    v.Title,
+			Priority: v.Priority,
+			Price:    v.CurrentPrice,
+		})
+	}
+	currency := candidates[0].Price.Currency
+
+	purchases, err := n.buildPurchases(ctx, convertedNWishList, listPurchased, currency)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build purchases on the Notion DB", slog.Any("error", err))
+		return nil, err
+	}
+
+	input := &usecase.RecommendBasketInput{
+		Candidates: candidates,
+		Purchases:  purchases,
+	}
+	output, err := n.bRecommender.RecommendBasket(ctx, input)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to recommend a basket of deals", slog.Any("error", err))
+		return nil, err
+	}
+
+	return output.Basket, nil
+}
+
+// Build purchases of video games from the Notion DB
+//
+// [FYI]
+// Video games marked as purchased this time are purchased now at their current prices on the Notion DB.
+// Purchases without a date or a price, or with a price in another currency, are ignored
+func (n *videoGamePricesNotifier) buildPurchases(
+	ctx context.Context,
+	convertedNWishList map[model.SteamAppID]*model.NotionWishlistItem,
+	listPurchased map[model.SteamAppID]*model.NotionWishlistItem,
+	currency model.CurrencyCode,
+) ([]*model.Purchase, error) {
+	now := n.now()
+	purchases := make([]*model.Purchase, 0)
+	for appID, v := range convertedNWishList {
+		if !v.Properties.IsPricedIn(currency) {
+			continue
+		}
+
+		purchasedAt := &now
+		price, err := v.Properties.CurrentPrice.ToMoney(ctx, currency)
+		if _, ok := listPurchased[appID]; !ok {
+			if !v.Properties.Purchased.IsChecked() {
+				continue
+			}
+
+			purchasedAt, err = v.Properties.PurchaseDate.ToTime(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to convert the purchase date to time.Time", slog.Any("error", err))
+				return nil, err
+			}
+			price, err = v.Properties.PurchasePrice.ToMoney(ctx, currency)
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to convert the purchase price to Money", slog.Any("error", err))
+			return nil, err
+		}
+		if purchasedAt == nil || price == nil {
+			continue
+		}
+
+		purchases = append(purchases, &model.Purchase{
+			PurchasedAt: *purchasedAt,
+			Price:       *price,
+		})
+	}
+
+	return purchases, nil
+}
+
+// Get app IDs of video games owned by any Steam account
+func (n *videoGamePricesNotifier) getSteamOwnedGames(
+	ctx context.Context,
+	steamID64s map[string]string,
+) (map[model.SteamAppID]struct{}, error) {
+	ownedAppIDs := make(map[model.SteamAppID]struct{})
+	for _, steamUserID := range n.steamCfg.SteamUserIDs {
+		input := &service.GetSteamOwnedGamesInput{
+			SteamID64: steamID64s[steamUserID],
+		}
+		ownedGames, err := n.sOGGetter.GetSteamOwnedGames(ctx, input)
+		if err != nil {
+			slog.ErrorContext(
+				ctx,
+				"failed to get owned games on Steam",
+				slog.String("steam_user_id", steamUserID),
+				slog.Any("error", err),
+			)
+			return nil, err
+		}
+
+		for _, v := range ownedGames.OwnedGames.Response.Games {
+			ownedAppIDs[model.SteamAppID(v.AppID)] = struct{}{}
+		}
+	}
+
+	return ownedAppIDs, nil
+}
+
+// Mark a wishlist on the Notion DB as purchased
+//
+// [FYI]
+// The purchase date is the time when the purchase is detected because the Steam Web API does not provide it,
+// and the purchase price is the last price seen in the Notion DB.
 // The rate limiter is set to 3 requests per second and parallel processing is used
-func (n *videoGamePricesNotifier) deleteNotionWishlistItems(
+func (n *videoGamePricesNotifier) markNotionWishlistItemsAsPurchased(
 	ctx context.Context,
-	vGDList map[model.SteamAppID]*model.SteamStoreVideoGameDetails,
-	nWishList []*model.NotionWishlistItem,
+	listToPurchase map[model.SteamAppID]*model.NotionWishlistItem,
 ) error {
-	// Convert a Notion wishlist to a map
-	convertedNWishList := make(map[model.SteamAppID]*model.NotionWishlistItem, len(nWishList))
-	for _, v := range nWishList {
-		appID, err := strconv.Atoi(v.Properties.NotionAppID.Title[0].NotionText.NotionContent)
-		if err != nil {
-			slog.ErrorContext(ctx, "failed to convert the app ID to int", slog.Any("error", err))
+	purchasedAt := n.now()
+	limiter := rate.NewLimiter(3, 1)
+	meg := &multierror.Group{}
+	for _, v := range listToPurchase {
+		if err := limiter.Wait(ctx); err != nil {
+			slog.ErrorContext(ctx, "failed to wait the rate limiter", slog.Any("error", err))
 			return err
 		}
-		convertedNWishList[model.SteamAppID(appID)] = v
+
+		meg.Go(func() error {
+			purchasePrice := &model.NotionPrice{Number: nil}
+			if v.Properties.CurrentPrice != nil {
+				purchasePrice.Number = v.Properties.CurrentPrice.Number
+			}
+
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: v.ID,
+					Properties: &model.NotionProperties{
+						Purchased: &model.NotionCheckbox{
+							Checkbox: true,
+						},
+						PurchaseDate:  model.NewNotionPurchaseDate(purchasedAt),
+						PurchasePrice: purchasePrice,
+					},
+				},
+			}
+			if _, err := n.nWIUpdater.UpdateNotionWishlistItem(ctx, input); err != nil {
+				slog.ErrorContext(ctx, "failed to mark a wishlist item on the Notion DB as purchased", slog.Any("error", err))
+				return err
+			}
+
+			return nil
+		})
 	}
 
-	// Categorize the Notion wishlist items to delete
-	listToDelete := make(map[model.SteamAppID]*model.NotionWishlistItem, len(convertedNWishList))
-	for i, v := range convertedNWishList {
-		if _, ok := vGDList[i]; !ok {
-			listToDelete[i] = v
-		}
+	if err := meg.Wait(); err != nil {
+		slog.ErrorContext(ctx, "failed to mark a wishlist item on the Notion DB as purchased", slog.Any("error", err))
+		return err
 	}
 
+	return nil
+}
+
+// Delete a wishlist on the Notion DB
+//
+// [FYI]
+// The rate limiter is set to 3 requests per second and parallel processing is used
+func (n *videoGamePricesNotifier) deleteNotionWishlistItems(
+	ctx context.Context,
+	listToDelete map[model.SteamAppID]*model.NotionWishlistItem,
+) error {
 	limiter := rate.NewLimiter(3, 1)
 	meg := &multierror.Group{}
 	for _, v := range listToDelete {
@@ -476,36 +1592,38 @@ func (n *videoGamePricesNotifier) deleteNotionWishlistItems(
 	return nil
 }
 
-type errorOnDiscordNotifier struct {
-	cfg         *config.DiscordConfig
-	eODNotifier service.ErrorOnDiscordNotifier
+type errorNotifier struct {
+	eNotifier service.ErrorNotifier
 }
 
-var _ usecase.ErrorNotifier = (*errorOnDiscordNotifier)(nil)
+var _ usecase.ErrorNotifier = (*errorNotifier)(nil)
 
-// Generate a new errorOnDiscordNotifier
-func NewErrorOnDiscordNotifier(
-	cfg *config.DiscordConfig,
-	eODNotifier service.ErrorOnDiscordNotifier,
-) *errorOnDiscordNotifier {
-	return &errorOnDiscordNotifier{
-		cfg:         cfg,
-		eODNotifier: eODNotifier,
+// Generate a new errorNotifier
+func NewErrorNotifier(eNotifier service.ErrorNotifier) *errorNotifier {
+	return &errorNotifier{
+		eNotifier: eNotifier,
 	}
 }
 
-// Notify an error on Discord
-func (n *errorOnDiscordNotifier) NotifyError(
+// Notify an error on all notification channels
+//
+// [FYI]
+// Channels which failed are reported, and an error is returned only if all channels failed
+func (n *errorNotifier) NotifyError(
 	ctx context.Context,
 	input *usecase.NotifyErrorInput,
 ) (*usecase.NotifyErrorOutput, error) {
-	eODInput := &service.NotifyErrorOnDiscordInput{
+	eInput := &service.NotifyErrorInput{
 		GeneratedError: input.GeneratedError,
 	}
-	if _, err := n.eODNotifier.NotifyErrorOnDiscord(ctx, eODInput); err != nil {
-		slog.ErrorContext(ctx, "failed to notify an error on Discord", slog.Any("error", err))
+	eOutput, err := n.eNotifier.NotifyError(ctx, eInput)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to notify an error", slog.Any("error", err))
 		return nil, err
 	}
+	for name, err := range eOutput.FailedChannels {
+		slog.WarnContext(ctx, "failed to notify an error on a channel", slog.String("channel", name), slog.Any("error", err))
+	}
 
 	return &usecase.NotifyErrorOutput{}, nil
 }
@@ -4,11 +4,16 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
-	discord "github.com/TsubasaBneAus/steam_game_price_notifier/app/external/discord/mock"
+	boltdb "github.com/TsubasaBneAus/steam_game_price_notifier/app/external/boltdb/mock"
+	isthereanydeal "github.com/TsubasaBneAus/steam_game_price_notifier/app/external/isthereanydeal/mock"
+	notifier "github.com/TsubasaBneAus/steam_game_price_notifier/app/external/notifier/mock"
 	notion "github.com/TsubasaBneAus/steam_game_price_notifier/app/external/notion/mock"
 	steam "github.com/TsubasaBneAus/steam_game_price_notifier/app/external/steam/mock"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/ruleengine"
+	ruleenginemock "github.com/TsubasaBneAus/steam_game_price_notifier/app/ruleengine/mock"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/usecase"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
@@ -21,33 +26,53 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 
 	// There is two records in the Notion DB ([1, Title1, 2000, 1500, 2021-01-01], [3, Title3, 2000, 1500, 2021-01-01])
 	// The Steam wishlist has two records ([1, 2])
-	// The Steam video game details has two records ([1, Title1, 1000, 1500, 2021-01-01], [2, Title2, nil, nil, To be announced])
+	// The Steam video game prices has two records ([1, 1000], [2, nil])
+	// The Steam video game details are retrieved only for the new record ([2, Title2, nil, To be announced])
 	// A new record will be created in the Notion DB ([2, Title2, nil, nil, nil]))
 	// The existing record will be updated ([1, Title1, 1000, 1000, 2021-01-01])
-	// {1: {Title1, 1000, 1500}} will be notified on Discord
+	// {1: {Title1, 1000, 1500}} will be notified
 	t.Run("Positive case: Successfully notify video game prices", func(t *testing.T) {
 		t.Parallel()
 
 		// Create mocks
 		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
 		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
-		sVGGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sOGGetter := steam.NewMockSteamOwnedGamesGetter(ctrl)
+		sVGDGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
 		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
 		nWICreator := notion.NewMockNotionWishlistItemCreator(ctrl)
 		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
 		nWIDeleter := notion.NewMockNotionWishlistItemDeleter(ctrl)
-		vGPODNotifier := discord.NewMockVideoGamePricesOnDiscordNotifier(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
 		{
-			input := &service.GetSteamWishlistInput{}
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
 			output := &service.GetSteamWishlistOutput{
 				Wishlist: &model.SteamStoreWishlist{
 					Response: &model.SteamStoreResponse{
 						Items: []*model.SteamStoreItem{
 							{
-								AppID: 1,
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
 							},
 							{
-								AppID: 2,
+								AppID:     2,
+								Priority:  2,
+								DateAdded: 1714468758,
 							},
 						},
 					},
@@ -55,38 +80,6 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			}
 			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
 		}
-		{
-			input1 := &service.GetSteamVideoGameDetailsInput{
-				AppID: 1,
-			}
-			input2 := &service.GetSteamVideoGameDetailsInput{
-				AppID: 2,
-			}
-			output1 := &service.GetSteamVideoGameDetailsOutput{
-				VideoGameDetails: &model.SteamStoreVideoGameDetails{
-					AppID: 1,
-					Title: "Title1",
-					CurrentPrice: &model.SteamCurrentPrice{
-						Number: json.Number("100000"),
-					},
-					ReleaseDate: &model.SteamReleaseDate{
-						Date: "01 Jan, 2021",
-					},
-				},
-			}
-			output2 := &service.GetSteamVideoGameDetailsOutput{
-				VideoGameDetails: &model.SteamStoreVideoGameDetails{
-					AppID:        2,
-					Title:        "Title2",
-					CurrentPrice: nil,
-					ReleaseDate: &model.SteamReleaseDate{
-						Date: "To be announced",
-					},
-				},
-			}
-			sVGGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input1).Return(output1, nil)
-			sVGGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input2).Return(output2, nil)
-		}
 		{
 			input := &service.GetNotionWishlistInput{}
 			output := &service.GetNotionWishlistOutput{
@@ -116,10 +109,10 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 								},
 							},
 							CurrentPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(2000)),
+								Number: pointer.Ptr(json.Number("2000")),
 							},
 							LowestPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(1500)),
+								Number: pointer.Ptr(json.Number("1500")),
 							},
 							NotionReleaseDate: &model.NotionReleaseDate{
 								NotionDate: &model.NotionDate{
@@ -153,10 +146,10 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 								},
 							},
 							CurrentPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(2000)),
+								Number: pointer.Ptr(json.Number("2000")),
 							},
 							LowestPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(1500)),
+								Number: pointer.Ptr(json.Number("1500")),
 							},
 							NotionReleaseDate: &model.NotionReleaseDate{
 								NotionDate: &model.NotionDate{
@@ -169,6 +162,57 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			}
 			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
 		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1, 2},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+					2: nil,
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.GetSteamVideoGameDetailsInput{
+				AppID: 2,
+			}
+			output := &service.GetSteamVideoGameDetailsOutput{
+				VideoGameDetails: &model.SteamStoreVideoGameDetails{
+					AppID:        2,
+					Title:        "Title2",
+					CurrentPrice: nil,
+					ReleaseDate: &model.SteamReleaseDate{
+						Date: "To be announced",
+					},
+				},
+			}
+			sVGDGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
+		}
 		{
 			input := &service.CreateNotionWishlistItemInput{
 				WishlistItem: &model.NotionWishlistItem{
@@ -203,6 +247,36 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 						NotionReleaseDate: &model.NotionReleaseDate{
 							NotionDate: nil,
 						},
+						Priority: &model.NotionPriority{
+							Number: 2,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: nil,
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: nil,
+						},
+						ReleaseDateText: &model.NotionRichText{
+							RichText: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "To be announced",
+									},
+								},
+							},
+						},
 					},
 				},
 			}
@@ -214,41 +288,58 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 				WishlistItem: &model.NotionWishlistItem{
 					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 					Properties: &model.NotionProperties{
-						NotionAppID: &model.NotionAppID{
-							Title: []*model.NotionContent{
-								{
-									NotionText: &model.NotionText{
-										NotionContent: "1",
-									},
-								},
-							},
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
 						},
-						NotionTitle: &model.NotionTitle{
-							RichText: []*model.NotionContent{
-								{
-									NotionText: &model.NotionText{
-										NotionContent: "Title1",
-									},
-								},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
 							},
 						},
-						CurrentPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(1000)),
-						},
 						LowestPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(1000)),
+							Number: pointer.Ptr(json.Number("1500")),
 						},
-						NotionReleaseDate: &model.NotionReleaseDate{
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
 							NotionDate: &model.NotionDate{
-								Start: "2021-01-01",
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
 							},
 						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
 					},
 				},
 			}
 			output := &service.UpdateNotionWishlistItemOutput{}
 			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
 		}
+		{
+			input := &service.GetSteamOwnedGamesInput{
+				SteamID64: "76561197960287930",
+			}
+			output := &service.GetSteamOwnedGamesOutput{
+				OwnedGames: &model.SteamOwnedGames{
+					Response: &model.SteamOwnedGamesResponse{
+						GameCount: 0,
+						Games:     []*model.SteamOwnedGame{},
+					},
+				},
+			}
+			sOGGetter.EXPECT().GetSteamOwnedGames(gomock.Any(), input).Return(output, nil)
+		}
 		{
 			input := &service.DeleteNotionWishlistItemInput{
 				WishlistItem: &model.NotionWishlistItem{
@@ -276,10 +367,10 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 							},
 						},
 						CurrentPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(2000)),
+							Number: pointer.Ptr(json.Number("2000")),
 						},
 						LowestPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(1500)),
+							Number: pointer.Ptr(json.Number("1500")),
 						},
 						NotionReleaseDate: &model.NotionReleaseDate{
 							NotionDate: &model.NotionDate{
@@ -293,17 +384,44 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			nWIDeleter.EXPECT().DeleteNotionWishlistItem(gomock.Any(), input).Return(output, nil)
 		}
 		{
-			input := &service.NotifyVideoGamePricesOnDiscordInput{
-				DiscordContents: map[model.SteamAppID]*model.DiscordContent{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
 					1: {
-						Title:        "Title1",
-						CurrentPrice: 1000,
-						LowestPrice:  1500,
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassNewLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
 					},
 				},
 			}
-			output := &service.NotifyVideoGamePricesOnDiscordOutput{}
-			vGPODNotifier.EXPECT().NotifyVideoGamePricesOnDiscord(gomock.Any(), input).Return(output, nil)
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
 		}
 
 		// Execute the method to be tested
@@ -312,16 +430,19 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			NotionAPIKey:     "dummy-notion-api-key",
 			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 		}
-		n := NewGamePricesNotifier(
-			cfg,
-			sWGetter,
-			sVGGetter,
-			nWGetter,
-			nWICreator,
-			nWIUpdater,
-			nWIDeleter,
-			vGPODNotifier,
-		)
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, sOGGetter, sVGDGetter, sVGPGetter, nil, nWGetter, nWICreator, nWIUpdater, nWIDeleter, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
 		input := &usecase.NotifyVideoGamePricesInput{}
 		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
 			t.Errorf("\ngot: %v\nwant: %v", err, nil)
@@ -333,19 +454,33 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 
 		// Create mocks
 		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
 		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
-		sVGGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
 		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
 		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
-		nWIDeleter := notion.NewMockNotionWishlistItemDeleter(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
 		{
-			input := &service.GetSteamWishlistInput{}
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
 			output := &service.GetSteamWishlistOutput{
 				Wishlist: &model.SteamStoreWishlist{
 					Response: &model.SteamStoreResponse{
 						Items: []*model.SteamStoreItem{
 							{
-								AppID: 1,
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
 							},
 						},
 					},
@@ -353,24 +488,6 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			}
 			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
 		}
-		{
-			input := &service.GetSteamVideoGameDetailsInput{
-				AppID: 1,
-			}
-			output := &service.GetSteamVideoGameDetailsOutput{
-				VideoGameDetails: &model.SteamStoreVideoGameDetails{
-					AppID: 1,
-					Title: "Title1",
-					CurrentPrice: &model.SteamCurrentPrice{
-						Number: json.Number("100000"),
-					},
-					ReleaseDate: &model.SteamReleaseDate{
-						Date: "01 Jan, 2021",
-					},
-				},
-			}
-			sVGGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
-		}
 		{
 			input := &service.GetNotionWishlistInput{}
 			output := &service.GetNotionWishlistOutput{
@@ -400,7 +517,7 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 								},
 							},
 							CurrentPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(2000)),
+								Number: pointer.Ptr(json.Number("2000")),
 							},
 							LowestPrice: &model.NotionPrice{
 								Number: nil,
@@ -412,8 +529,160 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 							},
 						},
 					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
 					{
-						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: nil,
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: The current price of a video game is more expensive than its lowest price", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sOGGetter := steam.NewMockSteamOwnedGamesGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		nWIDeleter := notion.NewMockNotionWishlistItemDeleter(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 						Parent: &model.NotionParent{
 							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 						},
@@ -422,7 +691,7 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 								Title: []*model.NotionContent{
 									{
 										NotionText: &model.NotionText{
-											NotionContent: "3",
+											NotionContent: "1",
 										},
 									},
 								},
@@ -431,16 +700,16 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 								RichText: []*model.NotionContent{
 									{
 										NotionText: &model.NotionText{
-											NotionContent: "Title3",
+											NotionContent: "Title1",
 										},
 									},
 								},
 							},
 							CurrentPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(2000)),
+								Number: pointer.Ptr(json.Number("2000")),
 							},
 							LowestPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(1500)),
+								Number: pointer.Ptr(json.Number("1500")),
 							},
 							NotionReleaseDate: &model.NotionReleaseDate{
 								NotionDate: &model.NotionDate{
@@ -449,50 +718,138 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 							},
 						},
 					},
-				},
-			}
-			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
-		}
-		{
-			input := &service.UpdateNotionWishlistItemInput{
-				WishlistItem: &model.NotionWishlistItem{
-					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
-					Properties: &model.NotionProperties{
-						NotionAppID: &model.NotionAppID{
-							Title: []*model.NotionContent{
-								{
-									NotionText: &model.NotionText{
-										NotionContent: "1",
+					{
+						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "3",
+										},
 									},
 								},
 							},
-						},
-						NotionTitle: &model.NotionTitle{
-							RichText: []*model.NotionContent{
-								{
-									NotionText: &model.NotionText{
-										NotionContent: "Title1",
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title3",
+										},
 									},
 								},
 							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("200000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
 						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
 						CurrentPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(1000)),
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
 						},
 						LowestPrice: &model.NotionPrice{
-							Number: nil,
+							Number: pointer.Ptr(json.Number("1500")),
 						},
-						NotionReleaseDate: &model.NotionReleaseDate{
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
 							NotionDate: &model.NotionDate{
-								Start: "2021-01-01",
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
 							},
 						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
 					},
 				},
 			}
 			output := &service.UpdateNotionWishlistItemOutput{}
 			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
 		}
+		{
+			input := &service.GetSteamOwnedGamesInput{
+				SteamID64: "76561197960287930",
+			}
+			output := &service.GetSteamOwnedGamesOutput{
+				OwnedGames: &model.SteamOwnedGames{
+					Response: &model.SteamOwnedGamesResponse{
+						GameCount: 0,
+						Games:     []*model.SteamOwnedGame{},
+					},
+				},
+			}
+			sOGGetter.EXPECT().GetSteamOwnedGames(gomock.Any(), input).Return(output, nil)
+		}
 		{
 			input := &service.DeleteNotionWishlistItemInput{
 				WishlistItem: &model.NotionWishlistItem{
@@ -520,10 +877,10 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 							},
 						},
 						CurrentPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(2000)),
+							Number: pointer.Ptr(json.Number("2000")),
 						},
 						LowestPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(1500)),
+							Number: pointer.Ptr(json.Number("1500")),
 						},
 						NotionReleaseDate: &model.NotionReleaseDate{
 							NotionDate: &model.NotionDate{
@@ -543,31 +900,59 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			NotionAPIKey:     "dummy-notion-api-key",
 			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 		}
-		n := NewGamePricesNotifier(cfg, sWGetter, sVGGetter, nWGetter, nil, nWIUpdater, nWIDeleter, nil)
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, sOGGetter, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nWIDeleter, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
 		input := &usecase.NotifyVideoGamePricesInput{}
 		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
 			t.Errorf("\ngot: %v\nwant: %v", err, nil)
 		}
 	})
 
-	t.Run("Positive case: The current price of a video game is more expensive than its lowest price", func(t *testing.T) {
+	t.Run("Positive case: Seed the lowest price of a new video game with its historical low", func(t *testing.T) {
 		t.Parallel()
 
 		// Create mocks
 		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
 		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
-		sVGGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGDGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
 		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
-		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
-		nWIDeleter := notion.NewMockNotionWishlistItemDeleter(ctrl)
+		nWICreator := notion.NewMockNotionWishlistItemCreator(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		hLGetter := isthereanydeal.NewMockHistoricalLowsGetter(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
 		{
-			input := &service.GetSteamWishlistInput{}
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
 			output := &service.GetSteamWishlistOutput{
 				Wishlist: &model.SteamStoreWishlist{
 					Response: &model.SteamStoreResponse{
 						Items: []*model.SteamStoreItem{
 							{
-								AppID: 1,
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
 							},
 						},
 					},
@@ -575,6 +960,47 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			}
 			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
 		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
 		{
 			input := &service.GetSteamVideoGameDetailsInput{
 				AppID: 1,
@@ -584,101 +1010,36 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 					AppID: 1,
 					Title: "Title1",
 					CurrentPrice: &model.SteamCurrentPrice{
-						Number: json.Number("200000"),
+						Currency: "JPY",
+						Number:   json.Number("100000"),
 					},
 					ReleaseDate: &model.SteamReleaseDate{
 						Date: "01 Jan, 2021",
 					},
 				},
 			}
-			sVGGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
+			sVGDGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
 		}
 		{
-			input := &service.GetNotionWishlistInput{}
-			output := &service.GetNotionWishlistOutput{
-				WishlistItems: []*model.NotionWishlistItem{
-					{
-						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
-						Parent: &model.NotionParent{
-							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
-						},
-						Properties: &model.NotionProperties{
-							NotionAppID: &model.NotionAppID{
-								Title: []*model.NotionContent{
-									{
-										NotionText: &model.NotionText{
-											NotionContent: "1",
-										},
-									},
-								},
-							},
-							NotionTitle: &model.NotionTitle{
-								RichText: []*model.NotionContent{
-									{
-										NotionText: &model.NotionText{
-											NotionContent: "Title1",
-										},
-									},
-								},
-							},
-							CurrentPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(2000)),
-							},
-							LowestPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(1500)),
-							},
-							NotionReleaseDate: &model.NotionReleaseDate{
-								NotionDate: &model.NotionDate{
-									Start: "2021-01-01",
-								},
-							},
-						},
-					},
-					{
-						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
-						Parent: &model.NotionParent{
-							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
-						},
-						Properties: &model.NotionProperties{
-							NotionAppID: &model.NotionAppID{
-								Title: []*model.NotionContent{
-									{
-										NotionText: &model.NotionText{
-											NotionContent: "3",
-										},
-									},
-								},
-							},
-							NotionTitle: &model.NotionTitle{
-								RichText: []*model.NotionContent{
-									{
-										NotionText: &model.NotionText{
-											NotionContent: "Title3",
-										},
-									},
-								},
-							},
-							CurrentPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(2000)),
-							},
-							LowestPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(1500)),
-							},
-							NotionReleaseDate: &model.NotionReleaseDate{
-								NotionDate: &model.NotionDate{
-									Start: "2021-01-01",
-								},
-							},
-						},
+			input := &service.GetHistoricalLowsInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetHistoricalLowsOutput{
+				HistoricalLows: map[model.SteamAppID]*model.Money{
+					1: {
+						Currency: "JPY",
+						Amount:   800,
 					},
 				},
 			}
-			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+			hLGetter.EXPECT().GetHistoricalLows(gomock.Any(), input).Return(output, nil)
 		}
 		{
-			input := &service.UpdateNotionWishlistItemInput{
+			input := &service.CreateNotionWishlistItemInput{
 				WishlistItem: &model.NotionWishlistItem{
-					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Parent: &model.NotionParent{
+						DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					},
 					Properties: &model.NotionProperties{
 						NotionAppID: &model.NotionAppID{
 							Title: []*model.NotionContent{
@@ -699,64 +1060,56 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 							},
 						},
 						CurrentPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(2000)),
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
 						},
 						LowestPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(1500)),
+							Number: pointer.Ptr(json.Number("800")),
 						},
 						NotionReleaseDate: &model.NotionReleaseDate{
 							NotionDate: &model.NotionDate{
 								Start: "2021-01-01",
 							},
 						},
-					},
-				},
-			}
-			output := &service.UpdateNotionWishlistItemOutput{}
-			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
-		}
-		{
-			input := &service.DeleteNotionWishlistItemInput{
-				WishlistItem: &model.NotionWishlistItem{
-					ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
-					Parent: &model.NotionParent{
-						DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
-					},
-					Properties: &model.NotionProperties{
-						NotionAppID: &model.NotionAppID{
-							Title: []*model.NotionContent{
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
 								{
-									NotionText: &model.NotionText{
-										NotionContent: "3",
-									},
+									Name: "dummy_steam_user_id",
 								},
 							},
 						},
-						NotionTitle: &model.NotionTitle{
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+						ReleaseDateText: &model.NotionRichText{
 							RichText: []*model.NotionContent{
 								{
 									NotionText: &model.NotionText{
-										NotionContent: "Title3",
+										NotionContent: "01 Jan, 2021",
 									},
 								},
 							},
 						},
-						CurrentPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(2000)),
-						},
-						LowestPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(1500)),
-						},
-						NotionReleaseDate: &model.NotionReleaseDate{
-							NotionDate: &model.NotionDate{
-								Start: "2021-01-01",
-							},
-						},
 					},
 				},
 			}
-			output := &service.DeleteNotionWishlistItemOutput{}
-			nWIDeleter.EXPECT().DeleteNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+			output := &service.CreateNotionWishlistItemOutput{}
+			nWICreator.EXPECT().CreateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
 		}
 
 		// Execute the method to be tested
@@ -765,98 +1118,9865 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			NotionAPIKey:     "dummy-notion-api-key",
 			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 		}
-		n := NewGamePricesNotifier(cfg, sWGetter, sVGGetter, nWGetter, nil, nWIUpdater, nWIDeleter, nil)
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, sVGDGetter, sVGPGetter, nil, nWGetter, nWICreator, nil, nil, nil, phRecorder, nil, hLGetter, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
 		input := &usecase.NotifyVideoGamePricesInput{}
 		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
 			t.Errorf("\ngot: %v\nwant: %v", err, nil)
 		}
 	})
 
-	t.Run("Negative case: Failed to get a Steam Store wishlist", func(t *testing.T) {
+	// The historical low is not found, so the lowest price in the price history is used
+	t.Run("Positive case: Seed the lowest price of a new video game with its lowest observed price", func(t *testing.T) {
 		t.Parallel()
 
 		// Create mocks
 		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
 		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
-		wantErr := errors.New("unexpected error")
+		sVGDGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWICreator := notion.NewMockNotionWishlistItemCreator(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		phGetter := boltdb.NewMockPriceHistoryGetter(ctrl)
+		hLGetter := isthereanydeal.NewMockHistoricalLowsGetter(ctrl)
 		{
-			input := &service.GetSteamWishlistInput{}
-			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(nil, wantErr)
-		}
-
-		// Execute the method to be tested
-		ctx := t.Context()
-		cfg := &config.NotionConfig{
-			NotionAPIKey:     "dummy-notion-api-key",
-			NotionDatabaseID: "dummy-notion-database-id",
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
 		}
-		n := NewGamePricesNotifier(cfg, sWGetter, nil, nil, nil, nil, nil, nil)
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.GetSteamVideoGameDetailsInput{
+				AppID: 1,
+			}
+			output := &service.GetSteamVideoGameDetailsOutput{
+				VideoGameDetails: &model.SteamStoreVideoGameDetails{
+					AppID: 1,
+					Title: "Title1",
+					CurrentPrice: &model.SteamCurrentPrice{
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+					ReleaseDate: &model.SteamReleaseDate{
+						Date: "01 Jan, 2021",
+					},
+				},
+			}
+			sVGDGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetHistoricalLowsInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetHistoricalLowsOutput{
+				HistoricalLows: map[model.SteamAppID]*model.Money{},
+			}
+			hLGetter.EXPECT().GetHistoricalLows(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetPriceHistoryInput{
+				AppID: 1,
+			}
+			output := &service.GetPriceHistoryOutput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 1, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1200,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1200,
+						},
+						DiscountPercent: 0,
+					},
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   900,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   900,
+						},
+						DiscountPercent: 0,
+					},
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 3, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phGetter.EXPECT().GetPriceHistory(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.CreateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					Parent: &model.NotionParent{
+						DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					},
+					Properties: &model.NotionProperties{
+						NotionAppID: &model.NotionAppID{
+							Title: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "1",
+									},
+								},
+							},
+						},
+						NotionTitle: &model.NotionTitle{
+							RichText: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "Title1",
+									},
+								},
+							},
+						},
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("900")),
+						},
+						NotionReleaseDate: &model.NotionReleaseDate{
+							NotionDate: &model.NotionDate{
+								Start: "2021-01-01",
+							},
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+						ReleaseDateText: &model.NotionRichText{
+							RichText: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "01 Jan, 2021",
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			output := &service.CreateNotionWishlistItemOutput{}
+			nWICreator.EXPECT().CreateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, sVGDGetter, sVGPGetter, nil, nWGetter, nWICreator, nil, nil, nil, phRecorder, phGetter, hLGetter, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: Fall back to the lowest observed price if historical lows cannot be retrieved", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGDGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWICreator := notion.NewMockNotionWishlistItemCreator(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		phGetter := boltdb.NewMockPriceHistoryGetter(ctrl)
+		hLGetter := isthereanydeal.NewMockHistoricalLowsGetter(ctrl)
+		wantErr := errors.New("unexpected error")
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.GetSteamVideoGameDetailsInput{
+				AppID: 1,
+			}
+			output := &service.GetSteamVideoGameDetailsOutput{
+				VideoGameDetails: &model.SteamStoreVideoGameDetails{
+					AppID: 1,
+					Title: "Title1",
+					CurrentPrice: &model.SteamCurrentPrice{
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+					ReleaseDate: &model.SteamReleaseDate{
+						Date: "01 Jan, 2021",
+					},
+				},
+			}
+			sVGDGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetHistoricalLowsInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			hLGetter.EXPECT().GetHistoricalLows(gomock.Any(), input).Return(nil, wantErr)
+		}
+		{
+			input := &service.GetPriceHistoryInput{
+				AppID: 1,
+			}
+			output := &service.GetPriceHistoryOutput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 1, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phGetter.EXPECT().GetPriceHistory(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.CreateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					Parent: &model.NotionParent{
+						DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					},
+					Properties: &model.NotionProperties{
+						NotionAppID: &model.NotionAppID{
+							Title: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "1",
+									},
+								},
+							},
+						},
+						NotionTitle: &model.NotionTitle{
+							RichText: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "Title1",
+									},
+								},
+							},
+						},
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						NotionReleaseDate: &model.NotionReleaseDate{
+							NotionDate: &model.NotionDate{
+								Start: "2021-01-01",
+							},
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+						ReleaseDateText: &model.NotionRichText{
+							RichText: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "01 Jan, 2021",
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			output := &service.CreateNotionWishlistItemOutput{}
+			nWICreator.EXPECT().CreateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, sVGDGetter, sVGPGetter, nil, nWGetter, nWICreator, nil, nil, nil, phRecorder, phGetter, hLGetter, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// The video game was notified at the same price two days ago
+	t.Run("Positive case: A video game which stays at the same price is not notified again within the cooldown", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1000")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							LastNotifiedPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1000")),
+							},
+							LastNotifiedAt: &model.NotionNotifiedAt{
+								NotionDate: &model.NotionDate{
+									Start: "2024-12-31T03:04:05Z",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: The title and the release date of a video game are refreshed if its release date is not set to a day", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGDGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1 (Working Title)",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("800")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+									End:   "2021-03-31",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.GetSteamVideoGameDetailsInput{
+				AppID: 1,
+			}
+			output := &service.GetSteamVideoGameDetailsOutput{
+				VideoGameDetails: &model.SteamStoreVideoGameDetails{
+					AppID: 1,
+					Title: "Title1",
+					CurrentPrice: &model.SteamCurrentPrice{
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+					ReleaseDate: &model.SteamReleaseDate{
+						Date: "01 Jan, 2021",
+					},
+				},
+			}
+			sVGDGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						NotionTitle: &model.NotionTitle{
+							RichText: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "Title1",
+									},
+								},
+							},
+						},
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("800")),
+						},
+						NotionReleaseDate: &model.NotionReleaseDate{
+							NotionDate: &model.NotionDate{
+								Start: "2021-01-01",
+							},
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+						ReleaseDateText: &model.NotionRichText{
+							RichText: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "01 Jan, 2021",
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, sVGDGetter, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: A video game is notified again if its price drops further", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1000")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							LastNotifiedPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1200")),
+							},
+							LastNotifiedAt: &model.NotionNotifiedAt{
+								NotionDate: &model.NotionDate{
+									Start: "2024-12-31T03:04:05Z",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1000},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassMatchesLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// The video game was notified at the same price eight days ago
+	t.Run("Positive case: A video game which stays at the same price is notified again after the cooldown", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1000")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							LastNotifiedPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1000")),
+							},
+							LastNotifiedAt: &model.NotionNotifiedAt{
+								NotionDate: &model.NotionDate{
+									Start: "2024-12-25T03:04:05Z",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1000},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassMatchesLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// The current price is within 10% of the lowest price
+	t.Run("Positive case: A video game close to its lowest price is notified without updating its lowest price", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1000")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("105000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1050,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1050,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1050")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1050")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1050},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1000},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1050},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassNearLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1050")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// The current price is far from the lowest price, but it is lower than or equal to the target price
+	t.Run("Positive case: A video game which reaches its target price is notified", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							TargetPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1000")),
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassOtherRules,
+						TriggeredRules:  []model.DealRule{model.DealRuleTargetPrice},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: A video game with enough discount is notified even if its lowest price is not filled", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: nil,
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							MinDiscount: &model.NotionPercent{
+								Number: pointer.Ptr(uint32(50)),
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency:        "JPY",
+						Number:          json.Number("50000"),
+						Initial:         json.Number("100000"),
+						DiscountPercent: 50,
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   500,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 50,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("500")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: nil,
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(50)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 500},
+						LowestPrice:     nil,
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 50,
+						DealClass:       model.DealClassOtherRules,
+						TriggeredRules:  []model.DealRule{model.DealRuleMinDiscount},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("500")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// The prices of a video game were stored in AUD before STEAM_COUNTRY_CODE was changed to "jp"
+	// The stored lowest, target, and last notified prices are ignored, and the lowest price is reset with the current price
+	t.Run("Positive case: Prices stored in another currency are reset with the current price", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("20")),
+							},
+							Currency: &model.NotionSelect{
+								Select: &model.NotionSelectOption{
+									Name: "AUD",
+								},
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("15")),
+							},
+							TargetPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("10")),
+							},
+							LastNotifiedPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("15")),
+							},
+							LastNotifiedAt: &model.NotionNotifiedAt{
+								NotionDate: &model.NotionDate{
+									Start: "2025-01-01T00:00:00Z",
+								},
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							MinDiscount: &model.NotionPercent{
+								Number: pointer.Ptr(uint32(50)),
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency:        "JPY",
+						Number:          json.Number("50000"),
+						Initial:         json.Number("100000"),
+						DiscountPercent: 50,
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   500,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 50,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("500")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(50)),
+						},
+						TargetPrice: &model.NotionPrice{
+							Number: nil,
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 500},
+						LowestPrice:     nil,
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 50,
+						DealClass:       model.DealClassOtherRules,
+						TriggeredRules:  []model.DealRule{model.DealRuleMinDiscount},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("500")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: All deal rules which match a video game are reported", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							TargetPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1000")),
+							},
+							MinDiscount: &model.NotionPercent{
+								Number: pointer.Ptr(uint32(20)),
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency:        "JPY",
+						Number:          json.Number("100000"),
+						Initial:         json.Number("200000"),
+						DiscountPercent: 50,
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 50,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(50)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 2000},
+						DiscountPercent: 50,
+						DealClass:       model.DealClassNewLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice, model.DealRuleTargetPrice, model.DealRuleMinDiscount},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: A video game whose discount is less than its minimum discount is not notified", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							MinDiscount: &model.NotionPercent{
+								Number: pointer.Ptr(uint32(50)),
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency:        "JPY",
+						Number:          json.Number("180000"),
+						Initial:         json.Number("200000"),
+						DiscountPercent: 10,
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1800,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 10,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1800")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(10)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: The notification state is reset if the sale of a video game ends", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1000")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							LastNotifiedPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1000")),
+							},
+							LastNotifiedAt: &model.NotionNotifiedAt{
+								NotionDate: &model.NotionDate{
+									Start: "2024-12-31T03:04:05Z",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("200000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: nil,
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: nil,
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// There is two records in the Notion DB ([1, Title1, 2000, 1500, 2021-01-01], [3, Title3, 2000, 1500, 2021-01-01])
+	// The Steam wishlist has three records ([1, 2, 3])
+	// The video game 3 is delisted and the video game 2 is not available in the region
+	// The record of the video game 3 will not be deleted because it is still on the Steam wishlist
+	// The skipped video games will be notified
+	t.Run("Positive case: Skip video games that cannot be retrieved from the Steam Store", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGDGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+							{
+								AppID:     2,
+								Priority:  2,
+								DateAdded: 1714468758,
+							},
+							{
+								AppID:     3,
+								Priority:  3,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+					{
+						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "3",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title3",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1, 2, 3},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("200000"),
+					},
+					2: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+				UnavailableVideoGames: map[model.SteamAppID]error{
+					3: model.ErrSteamVideoGameDelisted,
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 0,
+					},
+					{
+						AppID:      2,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.GetSteamVideoGameDetailsInput{
+				AppID: 2,
+			}
+			sVGDGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(nil, model.ErrSteamVideoGameUnavailableInRegion)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{},
+				SkippedContents: map[model.SteamAppID]*model.SkippedContent{
+					2: {
+						AppID:  2,
+						Title:  "",
+						Reason: "video game unavailable in the region",
+					},
+					3: {
+						AppID:  3,
+						Title:  "Title3",
+						Reason: "video game delisted from the Steam Store",
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, sVGDGetter, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// There is two records in the Notion DB ([1, Title1, 2000, 1500, 2021-01-01], [3, Title3, 2000, 1500, 2021-01-01])
+	// The Steam wishlist of the 1st account has two records ([1, 3]) and that of the 2nd account has two records ([1, 2])
+	// The record of the video game 3 will not be deleted because the 1st account still wishlists it
+	// Each record will have the accounts which want the video game
+	t.Run("Positive case: Merge wishlists of multiple Steam accounts", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGDGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWICreator := notion.NewMockNotionWishlistItemCreator(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id_1",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287931",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287931",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+							{
+								AppID:     3,
+								Priority:  3,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id_2",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287932",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287932",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+							{
+								AppID:     2,
+								Priority:  2,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+					{
+						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "3",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title3",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1, 3, 2},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("200000"),
+					},
+					2: nil,
+					3: {
+						Currency: "JPY",
+						Number:   json.Number("200000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 0,
+					},
+					{
+						AppID:      3,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.GetSteamVideoGameDetailsInput{
+				AppID: 2,
+			}
+			output := &service.GetSteamVideoGameDetailsOutput{
+				VideoGameDetails: &model.SteamStoreVideoGameDetails{
+					AppID:        2,
+					Title:        "Title2",
+					CurrentPrice: nil,
+					ReleaseDate: &model.SteamReleaseDate{
+						Date: "Q3 2025",
+					},
+				},
+			}
+			sVGDGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.CreateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					Parent: &model.NotionParent{
+						DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					},
+					Properties: &model.NotionProperties{
+						NotionAppID: &model.NotionAppID{
+							Title: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "2",
+									},
+								},
+							},
+						},
+						NotionTitle: &model.NotionTitle{
+							RichText: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "Title2",
+									},
+								},
+							},
+						},
+						CurrentPrice: &model.NotionPrice{
+							Number: nil,
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: nil,
+						},
+						NotionReleaseDate: &model.NotionReleaseDate{
+							NotionDate: &model.NotionDate{
+								Start: "2025-07-01",
+								End:   "2025-09-30",
+							},
+						},
+						Priority: &model.NotionPriority{
+							Number: 2,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id_2",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: nil,
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: nil,
+						},
+						ReleaseDateText: &model.NotionRichText{
+							RichText: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "Q3 2025",
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			output := &service.CreateNotionWishlistItemOutput{}
+			nWICreator.EXPECT().CreateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id_1",
+								},
+								{
+									Name: "dummy_steam_user_id_2",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 3,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id_1",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id_1",
+				"dummy_steam_user_id_2",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, sVGDGetter, sVGPGetter, nil, nWGetter, nWICreator, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// There is a record in the Notion DB ([1, Title1, 2000, 1500, 2021-01-01])
+	// The video game 1 is on sale at 75% off (1000 -> 250)
+	// {1: {Title1, 250, 1500, 1000, 75}} will be notified
+	t.Run("Positive case: Notify a discounted video game with its regular price", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency:        "JPY",
+						Number:          json.Number("25000"),
+						Initial:         json.Number("100000"),
+						DiscountPercent: 75,
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   250,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 75,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("250")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(75)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 250},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 75,
+						DealClass:       model.DealClassNewLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("250")),
+						},
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("250")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// There is two records in the Notion DB ([1, Title1, 2000, 1500, 2021-01-01], [3, Title3, 2000, 1500, 2021-01-01])
+	// The Steam wishlist has a record ([1]) and the video game 3 is owned by the Steam account
+	// The record of the video game 3 will be marked as purchased with its last seen price instead of being deleted
+	t.Run("Positive case: Mark a purchased video game instead of deleting it", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sOGGetter := steam.NewMockSteamOwnedGamesGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+					{
+						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "3",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title3",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("200000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamOwnedGamesInput{
+				SteamID64: "76561197960287930",
+			}
+			output := &service.GetSteamOwnedGamesOutput{
+				OwnedGames: &model.SteamOwnedGames{
+					Response: &model.SteamOwnedGamesResponse{
+						GameCount: 2,
+						Games: []*model.SteamOwnedGame{
+							{
+								AppID: 3,
+							},
+							{
+								AppID: 4,
+							},
+						},
+					},
+				},
+			}
+			sOGGetter.EXPECT().GetSteamOwnedGames(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+					Properties: &model.NotionProperties{
+						Purchased: &model.NotionCheckbox{
+							Checkbox: true,
+						},
+						PurchaseDate: &model.NotionPurchaseDate{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+						PurchasePrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, sOGGetter, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// There is two records in the Notion DB ([1, Title1, 2000, 1500, 2021-01-01], [3, Title3, 2000, 1500, 2021-01-01])
+	// The record of the video game 3 has already been marked as purchased
+	// Neither owned games are retrieved nor the record is deleted
+	t.Run("Positive case: A video game already marked as purchased is left as it is", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+					{
+						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "3",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title3",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							Purchased: &model.NotionCheckbox{
+								Checkbox: true,
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("200000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// The current price is far from the lowest price, but the discount and the review score match the deal rule
+	t.Run("Positive case: A video game matching a deal rule in the configuration is notified", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		sRSGetter := steam.NewMockSteamReviewSummaryGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency:        "JPY",
+						Number:          json.Number("100000"),
+						Initial:         json.Number("200000"),
+						DiscountPercent: 50,
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 50,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.GetSteamReviewSummaryInput{
+				AppID: 1,
+			}
+			output := &service.GetSteamReviewSummaryOutput{
+				ReviewSummary: &model.SteamStoreReviewSummary{
+					TotalPositive: 87,
+					TotalNegative: 13,
+					TotalReviews:  100,
+				},
+			}
+			sRSGetter.EXPECT().GetSteamReviewSummary(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(50)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 2000},
+						DiscountPercent: 50,
+						DealClass:       model.DealClassOtherRules,
+						TriggeredRules:  []model.DealRule{"Well reviewed"},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+			DealRules: []string{
+				"Well reviewed: discount >= 50 && review_score >= 80",
+			},
+		}
+		evaluator, err := ruleengine.NewDealRuleEvaluator(ctx, notifierCfg)
+		if err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, sRSGetter, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: A deal rule does not match if the review summary cannot be retrieved", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		sRSGetter := steam.NewMockSteamReviewSummaryGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		wantErr := errors.New("unexpected error")
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency:        "JPY",
+						Number:          json.Number("100000"),
+						Initial:         json.Number("200000"),
+						DiscountPercent: 50,
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 50,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.GetSteamReviewSummaryInput{
+				AppID: 1,
+			}
+			sRSGetter.EXPECT().GetSteamReviewSummary(gomock.Any(), input).Return(nil, wantErr)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(50)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+			DealRules: []string{
+				"Well reviewed: discount >= 50 && review_score >= 80",
+			},
+		}
+		evaluator, err := ruleengine.NewDealRuleEvaluator(ctx, notifierCfg)
+		if err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, sRSGetter, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: A video game whose deal rules fail to be evaluated is not notified", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		evaluator := ruleenginemock.NewMockDealRuleEvaluator(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency:        "JPY",
+						Number:          json.Number("100000"),
+						Initial:         json.Number("200000"),
+						DiscountPercent: 50,
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 50,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			evaluator.EXPECT().RequiresVariable(model.DealVariableReviewScore).Return(false)
+		}
+		{
+			facts := &model.DealFacts{
+				CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+				RegularPrice:    &model.Money{Currency: "JPY", Amount: 2000},
+				LowestPrice:     &model.Money{Currency: "JPY", Amount: 500},
+				DiscountPercent: 50,
+				Priority:        1,
+			}
+			evaluator.EXPECT().Evaluate(facts).Return(nil, false, errors.New("division by zero"))
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(50)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// The monthly budget is 2000, and 500 has been spent this month (the purchase in the last month is not counted)
+	// {1: {Title1, 1000}} fits in the available budget of 1500
+	t.Run("Positive case: A basket of deals within the monthly budget is recommended", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+					{
+						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "3",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title3",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							Purchased: &model.NotionCheckbox{
+								Checkbox: true,
+							},
+							PurchaseDate: &model.NotionPurchaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2025-01-01T00:00:00Z",
+								},
+							},
+							PurchasePrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("500")),
+							},
+						},
+					},
+					{
+						ID: "cccccccc-cccc-cccc-cccc-cccccccccccc",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "4",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title4",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("3000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("3000")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							Purchased: &model.NotionCheckbox{
+								Checkbox: true,
+							},
+							PurchaseDate: &model.NotionPurchaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2024-12-31T14:59:59Z",
+								},
+							},
+							PurchasePrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("3000")),
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassNewLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+				Basket: &model.Basket{
+					Items: []*model.BasketItem{
+						{
+							AppID:    1,
+							Title:    "Title1",
+							Priority: 1,
+							Price:    model.Money{Currency: "JPY", Amount: 1000},
+						},
+					},
+					Total:  model.Money{Currency: "JPY", Amount: 1000},
+					Budget: model.Money{Currency: "JPY", Amount: 2000},
+					Spent:  model.Money{Currency: "JPY", Amount: 500},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		monthlyBudget, err := model.ParseMajorAmount("2000")
+		if err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+			MonthlyBudget:        monthlyBudget,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		bRecommender := NewBasketRecommender(notifierCfg)
+		bRecommender.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, bRecommender, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// The video game 2 has been notified at the same price within the cooldown, so it is not notified again,
+	// but it is still a candidate for the basket
+	t.Run("Positive case: A video game notified within the cooldown is still recommended in a basket", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+							{
+								AppID:     2,
+								Priority:  2,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+					{
+						ID: "dddddddd-dddd-dddd-dddd-dddddddddddd",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "2",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title2",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("800")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("800")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							LastNotifiedPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("800")),
+							},
+							LastNotifiedAt: &model.NotionNotifiedAt{
+								NotionDate: &model.NotionDate{
+									Start: "2024-12-31T03:04:05Z",
+								},
+							},
+						},
+					},
+					{
+						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "3",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title3",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							Purchased: &model.NotionCheckbox{
+								Checkbox: true,
+							},
+							PurchaseDate: &model.NotionPurchaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2025-01-01T00:00:00Z",
+								},
+							},
+							PurchasePrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("500")),
+							},
+						},
+					},
+					{
+						ID: "cccccccc-cccc-cccc-cccc-cccccccccccc",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "4",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title4",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("3000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("3000")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							Purchased: &model.NotionCheckbox{
+								Checkbox: true,
+							},
+							PurchaseDate: &model.NotionPurchaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2024-12-31T14:59:59Z",
+								},
+							},
+							PurchasePrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("3000")),
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1, 2},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+					2: {
+						Currency: "JPY",
+						Number:   json.Number("80000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+					{
+						AppID:      2,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   800,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   800,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "dddddddd-dddd-dddd-dddd-dddddddddddd",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("800")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("800")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 2,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("800")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassNewLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+				Basket: &model.Basket{
+					Items: []*model.BasketItem{
+						{
+							AppID:    1,
+							Title:    "Title1",
+							Priority: 1,
+							Price:    model.Money{Currency: "JPY", Amount: 1000},
+						},
+						{
+							AppID:    2,
+							Title:    "Title2",
+							Priority: 2,
+							Price:    model.Money{Currency: "JPY", Amount: 800},
+						},
+					},
+					Total:  model.Money{Currency: "JPY", Amount: 1800},
+					Budget: model.Money{Currency: "JPY", Amount: 2500},
+					Spent:  model.Money{Currency: "JPY", Amount: 500},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		monthlyBudget, err := model.ParseMajorAmount("2500")
+		if err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+			MonthlyBudget:        monthlyBudget,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		bRecommender := NewBasketRecommender(notifierCfg)
+		bRecommender.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, bRecommender, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// The video game 3 is marked as purchased at 2000, so no deals fit in the available budget of 500
+	t.Run("Positive case: A video game purchased this time is counted as spent this month", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sOGGetter := steam.NewMockSteamOwnedGamesGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+					{
+						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "3",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title3",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamOwnedGamesInput{
+				SteamID64: "76561197960287930",
+			}
+			output := &service.GetSteamOwnedGamesOutput{
+				OwnedGames: &model.SteamOwnedGames{
+					Response: &model.SteamOwnedGamesResponse{
+						GameCount: 1,
+						Games: []*model.SteamOwnedGame{
+							{
+								AppID: 3,
+							},
+						},
+					},
+				},
+			}
+			sOGGetter.EXPECT().GetSteamOwnedGames(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+					Properties: &model.NotionProperties{
+						Purchased: &model.NotionCheckbox{
+							Checkbox: true,
+						},
+						PurchaseDate: &model.NotionPurchaseDate{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+						PurchasePrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassNewLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+				Basket: &model.Basket{
+					Items:  []*model.BasketItem{},
+					Total:  model.Money{Currency: "JPY", Amount: 0},
+					Budget: model.Money{Currency: "JPY", Amount: 2500},
+					Spent:  model.Money{Currency: "JPY", Amount: 2000},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		monthlyBudget, err := model.ParseMajorAmount("2500")
+		if err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+			MonthlyBudget:        monthlyBudget,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		bRecommender := NewBasketRecommender(notifierCfg)
+		bRecommender.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, bRecommender, sUIDResolver, sWGetter, sOGGetter, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: A muted video game is not notified, but its prices are updated", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							Muted: &model.NotionCheckbox{
+								Checkbox: true,
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// The snooze date is 2025-01-02 in JST, and it is 2025-01-02T12:04:05+09:00 now
+	t.Run("Positive case: A snoozed video game is not notified until the end of the snooze date", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							SnoozeUntil: &model.NotionSnoozeUntil{
+								NotionDate: &model.NotionDate{
+									Start: "2025-01-02",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: A video game whose snooze has expired is notified", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							SnoozeUntil: &model.NotionSnoozeUntil{
+								NotionDate: &model.NotionDate{
+									Start: "2025-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassNewLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Negative case: Failed to check whether a video game is snoozed", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+							SnoozeUntil: &model.NotionSnoozeUntil{
+								NotionDate: &model.NotionDate{
+									Start: "invalid-date",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nil, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated in notion.go", nil)
+		}
+	})
+
+	// The digest is sent on Sunday, and it is Thursday now
+	t.Run("Positive case: A deal which is not a new low is queued for the weekly digest", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		dQueuer := boltdb.NewMockDealsQueuer(ctrl)
+		dQGetter := boltdb.NewMockDigestQueueGetter(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("150000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1500,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1500,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.QueueDealsInput{
+				QueuedDeals: []*model.QueuedDeal{
+					{
+						AppID:    1,
+						QueuedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						Content: &model.DealContent{
+							Title:           "Title1",
+							Priority:        1,
+							CurrentPrice:    model.Money{Currency: "JPY", Amount: 1500},
+							LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+							RegularPrice:    model.Money{Currency: "JPY", Amount: 1500},
+							DiscountPercent: 0,
+							DealClass:       model.DealClassMatchesLow,
+							TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+						},
+					},
+				},
+			}
+			output := &service.QueueDealsOutput{}
+			dQueuer.EXPECT().QueueDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetDigestQueueInput{}
+			output := &service.GetDigestQueueOutput{
+				QueuedDeals: []*model.QueuedDeal{
+					{
+						AppID:    1,
+						QueuedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						Content: &model.DealContent{
+							Title:           "Title1",
+							Priority:        1,
+							CurrentPrice:    model.Money{Currency: "JPY", Amount: 1500},
+							LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+							RegularPrice:    model.Money{Currency: "JPY", Amount: 1500},
+							DiscountPercent: 0,
+							DealClass:       model.DealClassMatchesLow,
+							TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+						},
+					},
+				},
+				LastSentAt: time.Date(2024, 12, 26, 3, 4, 5, 0, time.UTC),
+			}
+			dQGetter.EXPECT().GetDigestQueue(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+			DigestWeekday:        pointer.Ptr(time.Sunday),
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, dQueuer, dQGetter, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// The basket is sent only with a notification, so that the same basket is not sent in every run
+	t.Run("Positive case: A basket is not notified on its own if all deals are queued for the weekly digest", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		dQueuer := boltdb.NewMockDealsQueuer(ctrl)
+		dQGetter := boltdb.NewMockDigestQueueGetter(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("150000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1500,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1500,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.QueueDealsInput{
+				QueuedDeals: []*model.QueuedDeal{
+					{
+						AppID:    1,
+						QueuedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						Content: &model.DealContent{
+							Title:           "Title1",
+							Priority:        1,
+							CurrentPrice:    model.Money{Currency: "JPY", Amount: 1500},
+							LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+							RegularPrice:    model.Money{Currency: "JPY", Amount: 1500},
+							DiscountPercent: 0,
+							DealClass:       model.DealClassMatchesLow,
+							TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+						},
+					},
+				},
+			}
+			output := &service.QueueDealsOutput{}
+			dQueuer.EXPECT().QueueDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetDigestQueueInput{}
+			output := &service.GetDigestQueueOutput{
+				QueuedDeals: []*model.QueuedDeal{
+					{
+						AppID:    1,
+						QueuedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						Content: &model.DealContent{
+							Title:           "Title1",
+							Priority:        1,
+							CurrentPrice:    model.Money{Currency: "JPY", Amount: 1500},
+							LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+							RegularPrice:    model.Money{Currency: "JPY", Amount: 1500},
+							DiscountPercent: 0,
+							DealClass:       model.DealClassMatchesLow,
+							TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+						},
+					},
+				},
+				LastSentAt: time.Date(2024, 12, 26, 3, 4, 5, 0, time.UTC),
+			}
+			dQGetter.EXPECT().GetDigestQueue(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		monthlyBudget, err := model.ParseMajorAmount("2000")
+		if err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+			MonthlyBudget:        monthlyBudget,
+			DigestWeekday:        pointer.Ptr(time.Sunday),
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		bRecommender := NewBasketRecommender(notifierCfg)
+		bRecommender.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, bRecommender, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, dQueuer, dQGetter, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: A new low is notified instantly even if the weekly digest is enabled", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		dQGetter := boltdb.NewMockDigestQueueGetter(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetDigestQueueInput{}
+			output := &service.GetDigestQueueOutput{
+				QueuedDeals: []*model.QueuedDeal{},
+				LastSentAt:  time.Date(2024, 12, 26, 3, 4, 5, 0, time.UTC),
+			}
+			dQGetter.EXPECT().GetDigestQueue(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassNewLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+			DigestWeekday:        pointer.Ptr(time.Sunday),
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, dQGetter, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// All channels fail in the first run, so the lowest price and the notification state are kept as they are.
+	// The video game is still a new low in the second run, so it is notified instantly instead of being queued for the digest
+	t.Run("Positive case: A new low is notified as a new low again after all channels failed", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		dQGetter := boltdb.NewMockDigestQueueGetter(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil).Times(2)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil).Times(2)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil).Times(2)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil).Times(2)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil).Times(2)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil).Times(2)
+		}
+		{
+			input := &service.GetDigestQueueInput{}
+			output := &service.GetDigestQueueOutput{
+				QueuedDeals: []*model.QueuedDeal{},
+				LastSentAt:  time.Date(2024, 12, 26, 3, 4, 5, 0, time.UTC),
+			}
+			dQGetter.EXPECT().GetDigestQueue(gomock.Any(), input).Return(output, nil).Times(2)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassNewLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			gomock.InOrder(
+				dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(nil, errors.New("unexpected error")),
+				dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil),
+			)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+			DigestWeekday:        pointer.Ptr(time.Sunday),
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, dQGetter, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated in notifier.go", err)
+		}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	// The digest is sent on Thursday, and the deal of the video game 3 was queued on Monday.
+	// The video game 1 is a new low, so it is notified instantly and listed as a discounted video game
+	t.Run("Positive case: The weekly digest is sent with the queued deals and currently discounted video games", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		dQGetter := boltdb.NewMockDigestQueueGetter(ctrl)
+		dQFlusher := boltdb.NewMockDigestQueueFlusher(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency:        "JPY",
+						Number:          json.Number("100000"),
+						Initial:         json.Number("200000"),
+						DiscountPercent: 50,
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 50,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(50)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetDigestQueueInput{}
+			output := &service.GetDigestQueueOutput{
+				QueuedDeals: []*model.QueuedDeal{
+					{
+						AppID:    3,
+						QueuedAt: time.Date(2024, 12, 30, 3, 4, 5, 0, time.UTC),
+						Content: &model.DealContent{
+							Title:           "Title3",
+							Priority:        3,
+							CurrentPrice:    model.Money{Currency: "JPY", Amount: 1500},
+							LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+							RegularPrice:    model.Money{Currency: "JPY", Amount: 3000},
+							DiscountPercent: 50,
+							DealClass:       model.DealClassMatchesLow,
+							TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+						},
+					},
+				},
+				LastSentAt: time.Date(2024, 12, 26, 3, 4, 5, 0, time.UTC),
+			}
+			dQGetter.EXPECT().GetDigestQueue(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 2000},
+						DiscountPercent: 50,
+						DealClass:       model.DealClassNewLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+				Digest: &model.Digest{
+					QueuedDeals: []*model.QueuedDeal{
+						{
+							AppID:    3,
+							QueuedAt: time.Date(2024, 12, 30, 3, 4, 5, 0, time.UTC),
+							Content: &model.DealContent{
+								Title:           "Title3",
+								Priority:        3,
+								CurrentPrice:    model.Money{Currency: "JPY", Amount: 1500},
+								LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+								RegularPrice:    model.Money{Currency: "JPY", Amount: 3000},
+								DiscountPercent: 50,
+								DealClass:       model.DealClassMatchesLow,
+								TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+							},
+						},
+					},
+					DiscountedGames: []*model.DiscountedGame{
+						{
+							AppID:           1,
+							Title:           "Title1",
+							CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+							RegularPrice:    model.Money{Currency: "JPY", Amount: 2000},
+							DiscountPercent: 50,
+						},
+					},
+					TotalSavings: &model.Money{Currency: "JPY", Amount: 1000},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.FlushDigestQueueInput{
+				SentAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+			}
+			output := &service.FlushDigestQueueOutput{}
+			dQFlusher.EXPECT().FlushDigestQueue(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+			DigestWeekday:        pointer.Ptr(time.Thursday),
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, dQGetter, dQFlusher)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: The weekly digest is not sent twice on the same day", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		dQGetter := boltdb.NewMockDigestQueueGetter(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("200000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   2000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetDigestQueueInput{}
+			output := &service.GetDigestQueueOutput{
+				QueuedDeals: []*model.QueuedDeal{},
+				LastSentAt:  time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			}
+			dQGetter.EXPECT().GetDigestQueue(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+			DigestWeekday:        pointer.Ptr(time.Thursday),
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, dQGetter, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Negative case: Failed to queue deals for the weekly digest", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		dQueuer := boltdb.NewMockDealsQueuer(ctrl)
+		wantErr := errors.New("unexpected error")
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("150000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1500,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1500,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.QueueDealsInput{
+				QueuedDeals: []*model.QueuedDeal{
+					{
+						AppID:    1,
+						QueuedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						Content: &model.DealContent{
+							Title:           "Title1",
+							Priority:        1,
+							CurrentPrice:    model.Money{Currency: "JPY", Amount: 1500},
+							LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+							RegularPrice:    model.Money{Currency: "JPY", Amount: 1500},
+							DiscountPercent: 0,
+							DealClass:       model.DealClassMatchesLow,
+							TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+						},
+					},
+				},
+			}
+			dQueuer.EXPECT().QueueDeals(gomock.Any(), input).Return(nil, wantErr)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+			DigestWeekday:        pointer.Ptr(time.Sunday),
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, dQueuer, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Positive case: A channel which failed to notify video game prices is reported without aborting processing", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassNewLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{
+				FailedChannels: map[string]error{
+					"discord": errors.New("unexpected error"),
+				},
+			}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
+					},
+				},
+			}
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, err := n.NotifyVideoGamePrices(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Negative case: Failed to resolve a Steam user ID", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		wantErr := errors.New("unexpected error")
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(nil, wantErr)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Failed to get a Steam Store wishlist", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		wantErr := errors.New("unexpected error")
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(nil, wantErr)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Failed to get a Notion wishlist", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		wantErr := errors.New("unexpected error")
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(nil, wantErr)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, nil, nil, nWGetter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Failed to get Steam Store video game prices", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		wantErr := errors.New("unexpected error")
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(nil, wantErr)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Failed to record the price history", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		wantErr := errors.New("unexpected error")
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(nil, wantErr)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nil, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Failed to get a Steam Store video game details", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGDGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		wantErr := errors.New("unexpected error")
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.GetSteamVideoGameDetailsInput{
+				AppID: 1,
+			}
+			sVGDGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(nil, wantErr)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, sVGDGetter, sVGPGetter, nil, nWGetter, nil, nil, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
 		input := &usecase.NotifyVideoGamePricesInput{}
 		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
 			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
 		}
 	})
 
-	t.Run("Negative case: Failed to get a Steam Store video game details", func(t *testing.T) {
+	t.Run("Negative case: Failed to create a Notion wishlist item", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGDGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWICreator := notion.NewMockNotionWishlistItemCreator(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		phGetter := boltdb.NewMockPriceHistoryGetter(ctrl)
+		hLGetter := isthereanydeal.NewMockHistoricalLowsGetter(ctrl)
+		wantErr := errors.New("unexpected error")
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "3",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title3",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.GetSteamVideoGameDetailsInput{
+				AppID: 1,
+			}
+			output := &service.GetSteamVideoGameDetailsOutput{
+				VideoGameDetails: &model.SteamStoreVideoGameDetails{
+					AppID: 1,
+					Title: "Title1",
+					CurrentPrice: &model.SteamCurrentPrice{
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+					ReleaseDate: &model.SteamReleaseDate{
+						Date: "01 Jan, 2021",
+					},
+				},
+			}
+			sVGDGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetHistoricalLowsInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetHistoricalLowsOutput{
+				HistoricalLows: map[model.SteamAppID]*model.Money{},
+			}
+			hLGetter.EXPECT().GetHistoricalLows(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetPriceHistoryInput{
+				AppID: 1,
+			}
+			output := &service.GetPriceHistoryOutput{
+				PriceObservations: []*model.PriceObservation{},
+			}
+			phGetter.EXPECT().GetPriceHistory(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.CreateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					Parent: &model.NotionParent{
+						DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					},
+					Properties: &model.NotionProperties{
+						NotionAppID: &model.NotionAppID{
+							Title: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "1",
+									},
+								},
+							},
+						},
+						NotionTitle: &model.NotionTitle{
+							RichText: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "Title1",
+									},
+								},
+							},
+						},
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						NotionReleaseDate: &model.NotionReleaseDate{
+							NotionDate: &model.NotionDate{
+								Start: "2021-01-01",
+							},
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+						ReleaseDateText: &model.NotionRichText{
+							RichText: []*model.NotionContent{
+								{
+									NotionText: &model.NotionText{
+										NotionContent: "01 Jan, 2021",
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			nWICreator.EXPECT().CreateNotionWishlistItem(gomock.Any(), input).Return(nil, wantErr)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, sVGDGetter, sVGPGetter, nil, nWGetter, nWICreator, nil, nil, nil, phRecorder, phGetter, hLGetter, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Failed to get the price history of a new video game", func(t *testing.T) {
 		t.Parallel()
 
 		// Create mocks
 		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
 		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
-		sVGGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGDGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
+		phGetter := boltdb.NewMockPriceHistoryGetter(ctrl)
+		hLGetter := isthereanydeal.NewMockHistoricalLowsGetter(ctrl)
 		wantErr := errors.New("unexpected error")
 		{
-			input := &service.GetSteamWishlistInput{}
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
 			output := &service.GetSteamWishlistOutput{
 				Wishlist: &model.SteamStoreWishlist{
 					Response: &model.SteamStoreResponse{
 						Items: []*model.SteamStoreItem{
 							{
-								AppID: 1,
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
 							},
 						},
 					},
 				},
 			}
-			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.GetSteamVideoGameDetailsInput{
+				AppID: 1,
+			}
+			output := &service.GetSteamVideoGameDetailsOutput{
+				VideoGameDetails: &model.SteamStoreVideoGameDetails{
+					AppID: 1,
+					Title: "Title1",
+					CurrentPrice: &model.SteamCurrentPrice{
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+					ReleaseDate: &model.SteamReleaseDate{
+						Date: "01 Jan, 2021",
+					},
+				},
+			}
+			sVGDGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
 		}
 		{
-			input := &service.GetSteamVideoGameDetailsInput{
+			input := &service.GetHistoricalLowsInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetHistoricalLowsOutput{
+				HistoricalLows: map[model.SteamAppID]*model.Money{},
+			}
+			hLGetter.EXPECT().GetHistoricalLows(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetPriceHistoryInput{
 				AppID: 1,
 			}
-			sVGGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(nil, wantErr)
+			phGetter.EXPECT().GetPriceHistory(gomock.Any(), input).Return(nil, wantErr)
 		}
 
 		// Execute the method to be tested
 		ctx := t.Context()
 		cfg := &config.NotionConfig{
 			NotionAPIKey:     "dummy-notion-api-key",
-			NotionDatabaseID: "dummy-notion-database-id",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 		}
-		n := NewGamePricesNotifier(cfg, sWGetter, sVGGetter, nil, nil, nil, nil, nil)
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, sVGDGetter, sVGPGetter, nil, nWGetter, nil, nil, nil, nil, phRecorder, phGetter, hLGetter, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
 		input := &usecase.NotifyVideoGamePricesInput{}
 		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
 			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
 		}
 	})
 
-	t.Run("Negative case: Failed to get a Notion wishlist", func(t *testing.T) {
+	t.Run("Negative case: Failed to update a Notion wishlist item", func(t *testing.T) {
 		t.Parallel()
 
 		// Create mocks
 		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
 		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
-		sVGGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
 		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
 		wantErr := errors.New("unexpected error")
 		{
-			input := &service.GetSteamWishlistInput{}
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
 			output := &service.GetSteamWishlistOutput{
 				Wishlist: &model.SteamStoreWishlist{
 					Response: &model.SteamStoreResponse{
 						Items: []*model.SteamStoreItem{
 							{
-								AppID: 1,
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
 							},
 						},
 					},
@@ -865,83 +10985,222 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
 		}
 		{
-			input := &service.GetSteamVideoGameDetailsInput{
-				AppID: 1,
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
+					{
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "1",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title1",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+					{
+						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+						Parent: &model.NotionParent{
+							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						},
+						Properties: &model.NotionProperties{
+							NotionAppID: &model.NotionAppID{
+								Title: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "3",
+										},
+									},
+								},
+							},
+							NotionTitle: &model.NotionTitle{
+								RichText: []*model.NotionContent{
+									{
+										NotionText: &model.NotionText{
+											NotionContent: "Title3",
+										},
+									},
+								},
+							},
+							CurrentPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("2000")),
+							},
+							LowestPrice: &model.NotionPrice{
+								Number: pointer.Ptr(json.Number("1500")),
+							},
+							NotionReleaseDate: &model.NotionReleaseDate{
+								NotionDate: &model.NotionDate{
+									Start: "2021-01-01",
+								},
+							},
+						},
+					},
+				},
 			}
-			output := &service.GetSteamVideoGameDetailsOutput{
-				VideoGameDetails: &model.SteamStoreVideoGameDetails{
-					AppID: 1,
-					Title: "Title1",
-					CurrentPrice: &model.SteamCurrentPrice{
-						Number: json.Number("100000"),
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
 					},
-					ReleaseDate: &model.SteamReleaseDate{
-						Date: "01 Jan, 2021",
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
 					},
 				},
 			}
-			sVGGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
 		}
 		{
-			input := &service.GetNotionWishlistInput{}
-			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(nil, wantErr)
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
+						},
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1500")),
+						},
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
+							NotionDate: &model.NotionDate{
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
+					},
+				},
+			}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(nil, wantErr)
 		}
 
 		// Execute the method to be tested
 		ctx := t.Context()
 		cfg := &config.NotionConfig{
 			NotionAPIKey:     "dummy-notion-api-key",
-			NotionDatabaseID: "dummy-notion-database-id",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 		}
-		n := NewGamePricesNotifier(cfg, sWGetter, sVGGetter, nWGetter, nil, nil, nil, nil)
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
 		input := &usecase.NotifyVideoGamePricesInput{}
 		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
 			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
 		}
 	})
 
-	t.Run("Negative case: Failed to create a Notion wishlist item", func(t *testing.T) {
+	t.Run("Negative case: Failed to delete a Notion wishlist item", func(t *testing.T) {
 		t.Parallel()
 
 		// Create mocks
 		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
 		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
-		sVGGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sOGGetter := steam.NewMockSteamOwnedGamesGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
 		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
-		nWICreator := notion.NewMockNotionWishlistItemCreator(ctrl)
+		nWIDeleter := notion.NewMockNotionWishlistItemDeleter(ctrl)
 		wantErr := errors.New("unexpected error")
 		{
-			input := &service.GetSteamWishlistInput{}
-			output := &service.GetSteamWishlistOutput{
-				Wishlist: &model.SteamStoreWishlist{
-					Response: &model.SteamStoreResponse{
-						Items: []*model.SteamStoreItem{
-							{
-								AppID: 1,
-							},
-						},
-					},
-				},
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
 			}
-			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
 		}
 		{
-			input := &service.GetSteamVideoGameDetailsInput{
-				AppID: 1,
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
 			}
-			output := &service.GetSteamVideoGameDetailsOutput{
-				VideoGameDetails: &model.SteamStoreVideoGameDetails{
-					AppID: 1,
-					Title: "Title1",
-					CurrentPrice: &model.SteamCurrentPrice{
-						Number: json.Number("100000"),
-					},
-					ReleaseDate: &model.SteamReleaseDate{
-						Date: "01 Jan, 2021",
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{},
 					},
 				},
 			}
-			sVGGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
 		}
 		{
 			input := &service.GetNotionWishlistInput{}
@@ -972,10 +11231,10 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 								},
 							},
 							CurrentPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(2000)),
+								Number: pointer.Ptr(json.Number("2000")),
 							},
 							LowestPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(1500)),
+								Number: pointer.Ptr(json.Number("1500")),
 							},
 							NotionReleaseDate: &model.NotionReleaseDate{
 								NotionDate: &model.NotionDate{
@@ -989,8 +11248,32 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
 		}
 		{
-			input := &service.CreateNotionWishlistItemInput{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamOwnedGamesInput{
+				SteamID64: "76561197960287930",
+			}
+			output := &service.GetSteamOwnedGamesOutput{
+				OwnedGames: &model.SteamOwnedGames{
+					Response: &model.SteamOwnedGamesResponse{
+						GameCount: 0,
+						Games:     []*model.SteamOwnedGame{},
+					},
+				},
+			}
+			sOGGetter.EXPECT().GetSteamOwnedGames(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.DeleteNotionWishlistItemInput{
 				WishlistItem: &model.NotionWishlistItem{
+					ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
 					Parent: &model.NotionParent{
 						DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 					},
@@ -999,7 +11282,7 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 							Title: []*model.NotionContent{
 								{
 									NotionText: &model.NotionText{
-										NotionContent: "1",
+										NotionContent: "3",
 									},
 								},
 							},
@@ -1008,16 +11291,16 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 							RichText: []*model.NotionContent{
 								{
 									NotionText: &model.NotionText{
-										NotionContent: "Title1",
+										NotionContent: "Title3",
 									},
 								},
 							},
 						},
 						CurrentPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(1000)),
+							Number: pointer.Ptr(json.Number("2000")),
 						},
 						LowestPrice: &model.NotionPrice{
-							Number: nil,
+							Number: pointer.Ptr(json.Number("1500")),
 						},
 						NotionReleaseDate: &model.NotionReleaseDate{
 							NotionDate: &model.NotionDate{
@@ -1027,7 +11310,7 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 					},
 				},
 			}
-			nWICreator.EXPECT().CreateNotionWishlistItem(gomock.Any(), input).Return(nil, wantErr)
+			nWIDeleter.EXPECT().DeleteNotionWishlistItem(gomock.Any(), input).Return(nil, wantErr)
 		}
 
 		// Execute the method to be tested
@@ -1036,62 +11319,63 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			NotionAPIKey:     "dummy-notion-api-key",
 			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 		}
-		n := NewGamePricesNotifier(cfg, sWGetter, sVGGetter, nWGetter, nWICreator, nil, nil, nil)
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, sOGGetter, nil, sVGPGetter, nil, nWGetter, nil, nil, nWIDeleter, nil, nil, nil, nil, nil, nil, nil)
 		input := &usecase.NotifyVideoGamePricesInput{}
 		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
 			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
 		}
 	})
 
-	t.Run("Negative case: Failed to update a Notion wishlist item", func(t *testing.T) {
+	t.Run("Negative case: Failed to get owned games on Steam", func(t *testing.T) {
 		t.Parallel()
 
 		// Create mocks
 		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
 		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
-		sVGGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sOGGetter := steam.NewMockSteamOwnedGamesGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
 		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
-		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
 		wantErr := errors.New("unexpected error")
 		{
-			input := &service.GetSteamWishlistInput{}
-			output := &service.GetSteamWishlistOutput{
-				Wishlist: &model.SteamStoreWishlist{
-					Response: &model.SteamStoreResponse{
-						Items: []*model.SteamStoreItem{
-							{
-								AppID: 1,
-							},
-						},
-					},
-				},
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
 			}
-			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
 		}
 		{
-			input := &service.GetSteamVideoGameDetailsInput{
-				AppID: 1,
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
 			}
-			output := &service.GetSteamVideoGameDetailsOutput{
-				VideoGameDetails: &model.SteamStoreVideoGameDetails{
-					AppID: 1,
-					Title: "Title1",
-					CurrentPrice: &model.SteamCurrentPrice{
-						Number: json.Number("100000"),
-					},
-					ReleaseDate: &model.SteamReleaseDate{
-						Date: "01 Jan, 2021",
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{},
 					},
 				},
 			}
-			sVGGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
 		}
 		{
 			input := &service.GetNotionWishlistInput{}
 			output := &service.GetNotionWishlistOutput{
 				WishlistItems: []*model.NotionWishlistItem{
 					{
-						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
 						Parent: &model.NotionParent{
 							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 						},
@@ -1100,7 +11384,7 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 								Title: []*model.NotionContent{
 									{
 										NotionText: &model.NotionText{
-											NotionContent: "1",
+											NotionContent: "3",
 										},
 									},
 								},
@@ -1109,16 +11393,16 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 								RichText: []*model.NotionContent{
 									{
 										NotionText: &model.NotionText{
-											NotionContent: "Title1",
+											NotionContent: "Title3",
 										},
 									},
 								},
 							},
 							CurrentPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(2000)),
+								Number: pointer.Ptr(json.Number("2000")),
 							},
 							LowestPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(1500)),
+								Number: pointer.Ptr(json.Number("1500")),
 							},
 							NotionReleaseDate: &model.NotionReleaseDate{
 								NotionDate: &model.NotionDate{
@@ -1127,6 +11411,88 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 							},
 						},
 					},
+				},
+			}
+			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamOwnedGamesInput{
+				SteamID64: "76561197960287930",
+			}
+			sOGGetter.EXPECT().GetSteamOwnedGames(gomock.Any(), input).Return(nil, wantErr)
+		}
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		cfg := &config.NotionConfig{
+			NotionAPIKey:     "dummy-notion-api-key",
+			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		}
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, sOGGetter, nil, sVGPGetter, nil, nWGetter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		input := &usecase.NotifyVideoGamePricesInput{}
+		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+
+	t.Run("Negative case: Failed to mark a Notion wishlist item as purchased", func(t *testing.T) {
+		t.Parallel()
+
+		// Create mocks
+		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
+		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sOGGetter := steam.NewMockSteamOwnedGamesGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
+		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		wantErr := errors.New("unexpected error")
+		{
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
+			output := &service.GetSteamWishlistOutput{
+				Wishlist: &model.SteamStoreWishlist{
+					Response: &model.SteamStoreResponse{
+						Items: []*model.SteamStoreItem{},
+					},
+				},
+			}
+			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetNotionWishlistInput{}
+			output := &service.GetNotionWishlistOutput{
+				WishlistItems: []*model.NotionWishlistItem{
 					{
 						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
 						Parent: &model.NotionParent{
@@ -1152,10 +11518,10 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 								},
 							},
 							CurrentPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(2000)),
+								Number: pointer.Ptr(json.Number("2000")),
 							},
 							LowestPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(1500)),
+								Number: pointer.Ptr(json.Number("1500")),
 							},
 							NotionReleaseDate: &model.NotionReleaseDate{
 								NotionDate: &model.NotionDate{
@@ -1168,40 +11534,49 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			}
 			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
 		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamOwnedGamesInput{
+				SteamID64: "76561197960287930",
+			}
+			output := &service.GetSteamOwnedGamesOutput{
+				OwnedGames: &model.SteamOwnedGames{
+					Response: &model.SteamOwnedGamesResponse{
+						GameCount: 1,
+						Games: []*model.SteamOwnedGame{
+							{
+								AppID: 3,
+							},
+						},
+					},
+				},
+			}
+			sOGGetter.EXPECT().GetSteamOwnedGames(gomock.Any(), input).Return(output, nil)
+		}
 		{
 			input := &service.UpdateNotionWishlistItemInput{
 				WishlistItem: &model.NotionWishlistItem{
-					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
 					Properties: &model.NotionProperties{
-						NotionAppID: &model.NotionAppID{
-							Title: []*model.NotionContent{
-								{
-									NotionText: &model.NotionText{
-										NotionContent: "1",
-									},
-								},
-							},
-						},
-						NotionTitle: &model.NotionTitle{
-							RichText: []*model.NotionContent{
-								{
-									NotionText: &model.NotionText{
-										NotionContent: "Title1",
-									},
-								},
-							},
-						},
-						CurrentPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(1000)),
-						},
-						LowestPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(1000)),
+						Purchased: &model.NotionCheckbox{
+							Checkbox: true,
 						},
-						NotionReleaseDate: &model.NotionReleaseDate{
+						PurchaseDate: &model.NotionPurchaseDate{
 							NotionDate: &model.NotionDate{
-								Start: "2021-01-01",
+								Start: "2025-01-02T03:04:05Z",
 							},
 						},
+						PurchasePrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("2000")),
+						},
 					},
 				},
 			}
@@ -1214,28 +11589,61 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			NotionAPIKey:     "dummy-notion-api-key",
 			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 		}
-		n := NewGamePricesNotifier(cfg, sWGetter, sVGGetter, nWGetter, nil, nWIUpdater, nil, nil)
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, sOGGetter, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, nil, nil, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
 		input := &usecase.NotifyVideoGamePricesInput{}
 		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
 			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
 		}
 	})
 
-	t.Run("Negative case: Failed to delete a Notion wishlist item", func(t *testing.T) {
+	t.Run("Negative case: Failed to notify video game prices on all channels", func(t *testing.T) {
 		t.Parallel()
 
 		// Create mocks
 		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
 		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
 		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
-		nWIDeleter := notion.NewMockNotionWishlistItemDeleter(ctrl)
+		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
 		wantErr := errors.New("unexpected error")
 		{
-			input := &service.GetSteamWishlistInput{}
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
 			output := &service.GetSteamWishlistOutput{
 				Wishlist: &model.SteamStoreWishlist{
 					Response: &model.SteamStoreResponse{
-						Items: []*model.SteamStoreItem{},
+						Items: []*model.SteamStoreItem{
+							{
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
+							},
+						},
 					},
 				},
 			}
@@ -1246,7 +11654,7 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			output := &service.GetNotionWishlistOutput{
 				WishlistItems: []*model.NotionWishlistItem{
 					{
-						ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+						ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 						Parent: &model.NotionParent{
 							DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 						},
@@ -1255,7 +11663,7 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 								Title: []*model.NotionContent{
 									{
 										NotionText: &model.NotionText{
-											NotionContent: "3",
+											NotionContent: "1",
 										},
 									},
 								},
@@ -1264,16 +11672,16 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 								RichText: []*model.NotionContent{
 									{
 										NotionText: &model.NotionText{
-											NotionContent: "Title3",
+											NotionContent: "Title1",
 										},
 									},
 								},
 							},
 							CurrentPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(2000)),
+								Number: pointer.Ptr(json.Number("2000")),
 							},
 							LowestPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(1500)),
+								Number: pointer.Ptr(json.Number("1500")),
 							},
 							NotionReleaseDate: &model.NotionReleaseDate{
 								NotionDate: &model.NotionDate{
@@ -1287,46 +11695,98 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
 		}
 		{
-			input := &service.DeleteNotionWishlistItemInput{
-				WishlistItem: &model.NotionWishlistItem{
-					ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
-					Parent: &model.NotionParent{
-						DatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
 					},
-					Properties: &model.NotionProperties{
-						NotionAppID: &model.NotionAppID{
-							Title: []*model.NotionContent{
-								{
-									NotionText: &model.NotionText{
-										NotionContent: "3",
-									},
-								},
-							},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
 						},
-						NotionTitle: &model.NotionTitle{
-							RichText: []*model.NotionContent{
-								{
-									NotionText: &model.NotionText{
-										NotionContent: "Title3",
-									},
-								},
-							},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
 						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
 						CurrentPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(2000)),
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
+							},
 						},
 						LowestPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(1500)),
+							Number: pointer.Ptr(json.Number("1500")),
 						},
-						NotionReleaseDate: &model.NotionReleaseDate{
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
 							NotionDate: &model.NotionDate{
-								Start: "2021-01-01",
+								Start: "2024-04-30T09:19:18Z",
 							},
 						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
+							},
+						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
 					},
 				},
 			}
-			nWIDeleter.EXPECT().DeleteNotionWishlistItem(gomock.Any(), input).Return(nil, wantErr)
+			output := &service.UpdateNotionWishlistItemOutput{}
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
+					1: {
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassNewLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+			}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(nil, wantErr)
 		}
 
 		// Execute the method to be tested
@@ -1335,32 +11795,59 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			NotionAPIKey:     "dummy-notion-api-key",
 			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 		}
-		n := NewGamePricesNotifier(cfg, sWGetter, nil, nWGetter, nil, nil, nWIDeleter, nil)
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
 		input := &usecase.NotifyVideoGamePricesInput{}
 		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
 			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
 		}
 	})
 
-	t.Run("Negative case: Failed to notify video game prices on Discord", func(t *testing.T) {
+	t.Run("Negative case: Failed to record the notification state on the Notion DB", func(t *testing.T) {
 		t.Parallel()
 
 		// Create mocks
 		ctrl := gomock.NewController(t)
+		sUIDResolver := steam.NewMockSteamUserIDResolver(ctrl)
 		sWGetter := steam.NewMockSteamWishlistGetter(ctrl)
-		sVGGetter := steam.NewMockSteamVideoGameDetailsGetter(ctrl)
+		sVGPGetter := steam.NewMockSteamVideoGamePricesGetter(ctrl)
 		nWGetter := notion.NewMockNotionWishlistGetter(ctrl)
 		nWIUpdater := notion.NewMockNotionWishlistItemUpdater(ctrl)
-		vGPODNotifier := discord.NewMockVideoGamePricesOnDiscordNotifier(ctrl)
+		dNotifier := notifier.NewMockDealNotifier(ctrl)
+		phRecorder := boltdb.NewMockPriceObservationsRecorder(ctrl)
 		wantErr := errors.New("unexpected error")
 		{
-			input := &service.GetSteamWishlistInput{}
+			input := &service.ResolveSteamUserIDInput{
+				SteamUserID: "dummy_steam_user_id",
+			}
+			output := &service.ResolveSteamUserIDOutput{
+				SteamID64: "76561197960287930",
+			}
+			sUIDResolver.EXPECT().ResolveSteamUserID(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.GetSteamWishlistInput{
+				SteamUserID: "76561197960287930",
+			}
 			output := &service.GetSteamWishlistOutput{
 				Wishlist: &model.SteamStoreWishlist{
 					Response: &model.SteamStoreResponse{
 						Items: []*model.SteamStoreItem{
 							{
-								AppID: 1,
+								AppID:     1,
+								Priority:  1,
+								DateAdded: 1714468758,
 							},
 						},
 					},
@@ -1368,24 +11855,6 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			}
 			sWGetter.EXPECT().GetSteamWishlist(gomock.Any(), input).Return(output, nil)
 		}
-		{
-			input := &service.GetSteamVideoGameDetailsInput{
-				AppID: 1,
-			}
-			output := &service.GetSteamVideoGameDetailsOutput{
-				VideoGameDetails: &model.SteamStoreVideoGameDetails{
-					AppID: 1,
-					Title: "Title1",
-					CurrentPrice: &model.SteamCurrentPrice{
-						Number: json.Number("100000"),
-					},
-					ReleaseDate: &model.SteamReleaseDate{
-						Date: "01 Jan, 2021",
-					},
-				},
-			}
-			sVGGetter.EXPECT().GetSteamVideoGameDetails(gomock.Any(), input).Return(output, nil)
-		}
 		{
 			input := &service.GetNotionWishlistInput{}
 			output := &service.GetNotionWishlistOutput{
@@ -1415,10 +11884,10 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 								},
 							},
 							CurrentPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(2000)),
+								Number: pointer.Ptr(json.Number("2000")),
 							},
 							LowestPrice: &model.NotionPrice{
-								Number: pointer.Ptr(uint64(1500)),
+								Number: pointer.Ptr(json.Number("1500")),
 							},
 							NotionReleaseDate: &model.NotionReleaseDate{
 								NotionDate: &model.NotionDate{
@@ -1431,40 +11900,77 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			}
 			nWGetter.EXPECT().GetNotionWishlist(gomock.Any(), input).Return(output, nil)
 		}
+		{
+			input := &service.GetSteamVideoGamePricesInput{
+				AppIDs: []model.SteamAppID{1},
+			}
+			output := &service.GetSteamVideoGamePricesOutput{
+				VideoGamePrices: map[model.SteamAppID]*model.SteamCurrentPrice{
+					1: {
+						Currency: "JPY",
+						Number:   json.Number("100000"),
+					},
+				},
+			}
+			sVGPGetter.EXPECT().GetSteamVideoGamePrices(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.RecordPriceObservationsInput{
+				PriceObservations: []*model.PriceObservation{
+					{
+						AppID:      1,
+						ObservedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						FinalPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						RegularPrice: model.Money{
+							Currency: "JPY",
+							Amount:   1000,
+						},
+						DiscountPercent: 0,
+					},
+				},
+			}
+			phRecorder.EXPECT().RecordPriceObservations(gomock.Any(), input).Return(&service.RecordPriceObservationsOutput{}, nil)
+		}
 		{
 			input := &service.UpdateNotionWishlistItemInput{
 				WishlistItem: &model.NotionWishlistItem{
 					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 					Properties: &model.NotionProperties{
-						NotionAppID: &model.NotionAppID{
-							Title: []*model.NotionContent{
-								{
-									NotionText: &model.NotionText{
-										NotionContent: "1",
-									},
-								},
-							},
+						CurrentPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
 						},
-						NotionTitle: &model.NotionTitle{
-							RichText: []*model.NotionContent{
-								{
-									NotionText: &model.NotionText{
-										NotionContent: "Title1",
-									},
-								},
+						Currency: &model.NotionSelect{
+							Select: &model.NotionSelectOption{
+								Name: "JPY",
 							},
 						},
-						CurrentPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(1000)),
-						},
 						LowestPrice: &model.NotionPrice{
-							Number: pointer.Ptr(uint64(1000)),
+							Number: pointer.Ptr(json.Number("1500")),
 						},
-						NotionReleaseDate: &model.NotionReleaseDate{
+						Priority: &model.NotionPriority{
+							Number: 1,
+						},
+						DateAdded: &model.NotionDateAdded{
 							NotionDate: &model.NotionDate{
-								Start: "2021-01-01",
+								Start: "2024-04-30T09:19:18Z",
+							},
+						},
+						WantedBy: &model.NotionMultiSelect{
+							MultiSelect: []*model.NotionSelectOption{
+								{
+									Name: "dummy_steam_user_id",
+								},
 							},
 						},
+						RegularPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						DiscountPercent: &model.NotionPercent{
+							Number: pointer.Ptr(uint32(0)),
+						},
 					},
 				},
 			}
@@ -1472,16 +11978,43 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(output, nil)
 		}
 		{
-			input := &service.NotifyVideoGamePricesOnDiscordInput{
-				DiscordContents: map[model.SteamAppID]*model.DiscordContent{
+			input := &service.NotifyDealsInput{
+				Contents: map[model.SteamAppID]*model.DealContent{
 					1: {
-						Title:        "Title1",
-						CurrentPrice: 1000,
-						LowestPrice:  1500,
+						Title:           "Title1",
+						Priority:        1,
+						CurrentPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						LowestPrice:     &model.Money{Currency: "JPY", Amount: 1500},
+						RegularPrice:    model.Money{Currency: "JPY", Amount: 1000},
+						DiscountPercent: 0,
+						DealClass:       model.DealClassNewLow,
+						TriggeredRules:  []model.DealRule{model.DealRuleLowestPrice},
+					},
+				},
+			}
+			output := &service.NotifyDealsOutput{}
+			dNotifier.EXPECT().NotifyDeals(gomock.Any(), input).Return(output, nil)
+		}
+		{
+			input := &service.UpdateNotionWishlistItemInput{
+				WishlistItem: &model.NotionWishlistItem{
+					ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+					Properties: &model.NotionProperties{
+						LowestPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedPrice: &model.NotionPrice{
+							Number: pointer.Ptr(json.Number("1000")),
+						},
+						LastNotifiedAt: &model.NotionNotifiedAt{
+							NotionDate: &model.NotionDate{
+								Start: "2025-01-02T03:04:05Z",
+							},
+						},
 					},
 				},
 			}
-			vGPODNotifier.EXPECT().NotifyVideoGamePricesOnDiscord(gomock.Any(), input).Return(nil, wantErr)
+			nWIUpdater.EXPECT().UpdateNotionWishlistItem(gomock.Any(), input).Return(nil, wantErr)
 		}
 
 		// Execute the method to be tested
@@ -1490,7 +12023,19 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 			NotionAPIKey:     "dummy-notion-api-key",
 			NotionDatabaseID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
 		}
-		n := NewGamePricesNotifier(cfg, sWGetter, sVGGetter, nWGetter, nil, nWIUpdater, nil, vGPODNotifier)
+		steamCfg := &config.SteamConfig{
+			SteamUserIDs: []string{
+				"dummy_steam_user_id",
+			},
+			SteamCountryCode: "jp",
+		}
+		notifierCfg := &config.NotifierConfig{
+			NotificationCooldown: 7 * 24 * time.Hour,
+			NearLowPercent:       10,
+		}
+		evaluator := model.NewDealRuleEvaluator(notifierCfg.NearLowPercent)
+		n := NewGamePricesNotifier(cfg, steamCfg, notifierCfg, evaluator, nil, sUIDResolver, sWGetter, nil, nil, sVGPGetter, nil, nWGetter, nil, nWIUpdater, nil, dNotifier, phRecorder, nil, nil, nil, nil, nil)
+		n.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
 		input := &usecase.NotifyVideoGamePricesInput{}
 		if _, gotErr := n.NotifyVideoGamePrices(ctx, input); !errors.Is(gotErr, wantErr) {
 			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
@@ -1498,31 +12043,57 @@ func TestNotifyVideoGamePrices(t *testing.T) {
 	})
 }
 
-func TestNotifyErrorOnDiscord(t *testing.T) {
+func TestNotifyError(t *testing.T) {
 	t.Parallel()
 
-	t.Run("Positive case: Successfully notify an error on Discord", func(t *testing.T) {
+	t.Run("Positive case: Successfully notify an error on all channels", func(t *testing.T) {
 		t.Parallel()
 
 		// Create a mock
 		ctrl := gomock.NewController(t)
-		dENotifier := discord.NewMockErrorOnDiscordNotifier(ctrl)
+		eNotifier := notifier.NewMockErrorNotifier(ctrl)
 		generatedErr := errors.New("generated error")
 		{
-			input := &service.NotifyErrorOnDiscordInput{
+			input := &service.NotifyErrorInput{
 				GeneratedError: generatedErr,
 			}
-			output := &service.NotifyErrorOnDiscordOutput{}
-			dENotifier.EXPECT().NotifyErrorOnDiscord(gomock.Any(), input).Return(output, nil)
+			output := &service.NotifyErrorOutput{}
+			eNotifier.EXPECT().NotifyError(gomock.Any(), input).Return(output, nil)
 		}
 
 		// Execute the method to be tested
 		ctx := t.Context()
-		cfg := &config.DiscordConfig{
-			DiscordWebhookID:    "dummy-discord-webhook-id",
-			DiscordWebhookToken: "dummy-discord-webhook-token",
+		n := NewErrorNotifier(eNotifier)
+		input := &usecase.NotifyErrorInput{
+			GeneratedError: generatedErr,
+		}
+		if _, err := n.NotifyError(ctx, input); err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+	})
+
+	t.Run("Positive case: A failed channel is reported without an error", func(t *testing.T) {
+		t.Parallel()
+
+		// Create a mock
+		ctrl := gomock.NewController(t)
+		eNotifier := notifier.NewMockErrorNotifier(ctrl)
+		generatedErr := errors.New("generated error")
+		{
+			input := &service.NotifyErrorInput{
+				GeneratedError: generatedErr,
+			}
+			output := &service.NotifyErrorOutput{
+				FailedChannels: map[string]error{
+					"discord": errors.New("unexpected error"),
+				},
+			}
+			eNotifier.EXPECT().NotifyError(gomock.Any(), input).Return(output, nil)
 		}
-		n := NewErrorOnDiscordNotifier(cfg, dENotifier)
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		n := NewErrorNotifier(eNotifier)
 		input := &usecase.NotifyErrorInput{
 			GeneratedError: generatedErr,
 		}
@@ -1531,28 +12102,24 @@ func TestNotifyErrorOnDiscord(t *testing.T) {
 		}
 	})
 
-	t.Run("Negative case: Failed to notify an error on Discord", func(t *testing.T) {
+	t.Run("Negative case: Failed to notify an error on all channels", func(t *testing.T) {
 		t.Parallel()
 
 		// Create a mock
 		ctrl := gomock.NewController(t)
-		dENotifier := discord.NewMockErrorOnDiscordNotifier(ctrl)
+		eNotifier := notifier.NewMockErrorNotifier(ctrl)
 		generatedErr := errors.New("generated error")
 		wantErr := errors.New("unexpected error")
 		{
-			input := &service.NotifyErrorOnDiscordInput{
+			input := &service.NotifyErrorInput{
 				GeneratedError: generatedErr,
 			}
-			dENotifier.EXPECT().NotifyErrorOnDiscord(gomock.Any(), input).Return(nil, wantErr)
+			eNotifier.EXPECT().NotifyError(gomock.Any(), input).Return(nil, wantErr)
 		}
 
 		// Execute the method to be tested
 		ctx := t.Context()
-		cfg := &config.DiscordConfig{
-			DiscordWebhookID:    "dummy-discord-webhook-id",
-			DiscordWebhookToken: "dummy-discord-webhook-token",
-		}
-		n := NewErrorOnDiscordNotifier(cfg, dENotifier)
+		n := NewErrorNotifier(eNotifier)
 		input := &usecase.NotifyErrorInput{
 			GeneratedError: generatedErr,
 		}
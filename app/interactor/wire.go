@@ -8,7 +8,9 @@ import (
 // A wire set for the interactor package
 var Set = wire.NewSet(
 	NewGamePricesNotifier,
-	NewErrorOnDiscordNotifier,
+	NewErrorNotifier,
+	NewBasketRecommender,
 	wire.Bind(new(usecase.VideoGamePricesNotifier), new(*videoGamePricesNotifier)),
-	wire.Bind(new(usecase.ErrorNotifier), new(*errorOnDiscordNotifier)),
+	wire.Bind(new(usecase.ErrorNotifier), new(*errorNotifier)),
+	wire.Bind(new(usecase.BasketRecommender), new(*basketRecommender)),
 )
@@ -0,0 +1,170 @@
+package model
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+)
+
+// The time zone where a month of the budget starts
+//
+// [FYI]
+// The app runs on the schedule in JST, so the budget is reset at the start of a month in JST
+var budgetLocation = time.FixedZone("JST", 9*60*60)
+
+// A video game which can be put into a recommended basket
+//
+// [FYI]
+// The priority is the rank of a video game on the Steam wishlist, and 0 means that it has not been ranked yet
+type BasketItem struct {
+	AppID    SteamAppID
+	Title    string
+	Priority uint32
+	Price    Money
+}
+
+// A purchase of a video game
+type Purchase struct {
+	PurchasedAt time.Time
+	Price       Money
+}
+
+// A recommended basket of deals within a monthly budget
+//
+// [FYI]
+// The spent is the total price of video games purchased this month, and the total is the total price of the items
+type Basket struct {
+	Items  []*BasketItem
+	Total  Money
+	Budget Money
+	Spent  Money
+}
+
+// Get the amount of the budget available after the purchases of this month
+func (b *Basket) Available() Money {
+	if b.Spent.Amount >= b.Budget.Amount {
+		return Money{Currency: b.Budget.Currency}
+	}
+
+	return Money{Currency: b.Budget.Currency, Amount: b.Budget.Amount - b.Spent.Amount}
+}
+
+// Get the amount of the budget left after buying the basket
+func (b *Basket) Left() Money {
+	available := b.Available()
+	if b.Total.Amount >= available.Amount {
+		return Money{Currency: available.Currency}
+	}
+
+	return Money{Currency: available.Currency, Amount: available.Amount - b.Total.Amount}
+}
+
+// Get the start of the month of the budget which includes a time
+// e.g. 2025-01-31T15:00:00Z -> 2025-02-01T00:00:00+09:00
+func StartOfBudgetMonth(t time.Time) time.Time {
+	t = t.In(budgetLocation)
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, budgetLocation)
+}
+
+// Sum prices of purchases in the currency since a time
+//
+// [FYI]
+// Purchases in a different currency are ignored because they cannot be compared with the budget
+func SumPurchases(purchases []*Purchase, currency CurrencyCode, since time.Time) Money {
+	spent := Money{Currency: currency}
+	for _, v := range purchases {
+		if v.Price.Currency != currency || v.PurchasedAt.Before(since) {
+			continue
+		}
+		spent.Amount += v.Price.Amount
+	}
+
+	return spent
+}
+
+// Compare priorities on the Steam wishlist so that ranked video games come first
+func comparePriority(a, b uint32) int {
+	switch {
+	case a == b:
+		return 0
+	case a == 0:
+		return 1
+	case b == 0:
+		return -1
+	default:
+		return cmp.Compare(a, b)
+	}
+}
+
+// Recommend a basket of video games which maximises their priorities within an available budget
+//
+// [FYI]
+// This is a 0/1 knapsack problem. The value of a video game is its rank among the candidates in reverse order
+// (e.g. the highest priority of 3 candidates is worth 3), and video games with the same priority are worth the same.
+// The dynamic programming is indexed by the total value instead of the total price,
+// so that its cost does not depend on the minor units of the currency.
+// Among baskets of the same value, the cheapest one is chosen. The items are sorted by their priorities
+func RecommendBasket(candidates []*BasketItem, available Money) []*BasketItem {
+	items := make([]*BasketItem, 0, len(candidates))
+	for _, v := range candidates {
+		if v.Price.Currency == available.Currency && v.Price.Amount <= available.Amount {
+			items = append(items, v)
+		}
+	}
+	slices.SortStableFunc(items, func(a, b *BasketItem) int {
+		if c := comparePriority(a.Priority, b.Priority); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.AppID, b.AppID)
+	})
+
+	// Assign values in reverse order of priorities
+	values := make([]int, len(items))
+	var totalValue int
+	for i := range items {
+		rank := i
+		for rank > 0 && items[rank-1].Priority == items[i].Priority {
+			rank--
+		}
+		values[i] = len(items) - rank
+		totalValue += values[i]
+	}
+
+	// Find the minimum price to achieve each total value
+	costs := make([]uint64, totalValue+1)
+	for v := 1; v <= totalValue; v++ {
+		costs[v] = math.MaxUint64
+	}
+	chosen := make([][]bool, len(items))
+	for i, item := range items {
+		chosen[i] = make([]bool, totalValue+1)
+		for v := totalValue; v >= values[i]; v-- {
+			prev := costs[v-values[i]]
+			if prev == math.MaxUint64 || prev+item.Price.Amount >= costs[v] {
+				continue
+			}
+			costs[v] = prev + item.Price.Amount
+			chosen[i][v] = true
+		}
+	}
+
+	best := 0
+	for v := totalValue; v > 0; v-- {
+		if costs[v] <= available.Amount {
+			best = v
+			break
+		}
+	}
+
+	basket := make([]*BasketItem, 0)
+	for i := len(items) - 1; i >= 0 && best > 0; i-- {
+		if chosen[i][best] {
+			basket = append(basket, items[i])
+			best -= values[i]
+		}
+	}
+	slices.Reverse(basket)
+
+	return basket
+}
@@ -0,0 +1,138 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRecommendBasket(t *testing.T) {
+	t.Parallel()
+
+	jpy := func(amount uint64) Money {
+		return Money{Currency: "JPY", Amount: amount}
+	}
+	item1 := &BasketItem{AppID: 1, Title: "Title1", Priority: 1, Price: jpy(6000)}
+	item2 := &BasketItem{AppID: 2, Title: "Title2", Priority: 2, Price: jpy(2500)}
+	item3 := &BasketItem{AppID: 3, Title: "Title3", Priority: 3, Price: jpy(2500)}
+	item4 := &BasketItem{AppID: 4, Title: "Title4", Priority: 0, Price: jpy(1000)}
+
+	testCases := map[string]struct {
+		candidates []*BasketItem
+		available  Money
+		want       []*BasketItem
+	}{
+		"Positive case: The cheapest basket is chosen among baskets of the same priorities": {
+			candidates: []*BasketItem{item1, item2, item3},
+			available:  jpy(6000),
+			want:       []*BasketItem{item2, item3},
+		},
+		"Positive case: Several lower priorities are worth more than one higher priority": {
+			candidates: []*BasketItem{item1, item2, item3, {AppID: 5, Title: "Title5", Priority: 5, Price: jpy(1000)}},
+			available:  jpy(6000),
+			want:       []*BasketItem{item2, item3, {AppID: 5, Title: "Title5", Priority: 5, Price: jpy(1000)}},
+		},
+		"Positive case: The highest priority is chosen if it fits with another": {
+			candidates: []*BasketItem{item3, item2, item1},
+			available:  jpy(9000),
+			want:       []*BasketItem{item1, item2},
+		},
+		"Positive case: All candidates are chosen if they fit in the budget": {
+			candidates: []*BasketItem{item4, item1, item2, item3},
+			available:  jpy(20000),
+			want:       []*BasketItem{item1, item2, item3, item4},
+		},
+		"Positive case: An unranked video game fills the rest of the budget": {
+			candidates: []*BasketItem{item1, item4},
+			available:  jpy(7000),
+			want:       []*BasketItem{item1, item4},
+		},
+		"Positive case: No candidate fits in the budget": {
+			candidates: []*BasketItem{item1, item2},
+			available:  jpy(2000),
+			want:       []*BasketItem{},
+		},
+		"Positive case: Candidates in a different currency are ignored": {
+			candidates: []*BasketItem{item1, {AppID: 5, Priority: 1, Price: Money{Currency: "AUD", Amount: 100}}},
+			available:  jpy(6000),
+			want:       []*BasketItem{item1},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the function to be tested
+			got := RecommendBasket(tc.candidates, tc.available)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("got(-) want(+)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSumPurchases(t *testing.T) {
+	t.Parallel()
+
+	since := StartOfBudgetMonth(time.Date(2025, 1, 31, 15, 0, 0, 0, time.UTC))
+	if want := time.Date(2025, 2, 1, 0, 0, 0, 0, budgetLocation); !since.Equal(want) {
+		t.Fatalf("\ngot: %v\nwant: %v", since, want)
+	}
+
+	purchases := []*Purchase{
+		{PurchasedAt: time.Date(2025, 1, 31, 14, 59, 59, 0, time.UTC), Price: Money{Currency: "JPY", Amount: 1000}},
+		{PurchasedAt: time.Date(2025, 1, 31, 15, 0, 0, 0, time.UTC), Price: Money{Currency: "JPY", Amount: 2000}},
+		{PurchasedAt: time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), Price: Money{Currency: "JPY", Amount: 500}},
+		{PurchasedAt: time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), Price: Money{Currency: "AUD", Amount: 1999}},
+	}
+	got := SumPurchases(purchases, "JPY", since)
+	want := Money{Currency: "JPY", Amount: 2500}
+	if got != want {
+		t.Errorf("\ngot: %v\nwant: %v", got, want)
+	}
+}
+
+func TestBasketLeft(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		basket        *Basket
+		wantAvailable Money
+		wantLeft      Money
+	}{
+		"Positive case: The budget is left after the basket": {
+			basket: &Basket{
+				Budget: Money{Currency: "JPY", Amount: 10000},
+				Spent:  Money{Currency: "JPY", Amount: 3000},
+				Total:  Money{Currency: "JPY", Amount: 6500},
+			},
+			wantAvailable: Money{Currency: "JPY", Amount: 7000},
+			wantLeft:      Money{Currency: "JPY", Amount: 500},
+		},
+		"Positive case: The budget has already been spent": {
+			basket: &Basket{
+				Budget: Money{Currency: "JPY", Amount: 10000},
+				Spent:  Money{Currency: "JPY", Amount: 12000},
+				Total:  Money{Currency: "JPY"},
+			},
+			wantAvailable: Money{Currency: "JPY"},
+			wantLeft:      Money{Currency: "JPY"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the methods to be tested
+			if got := tc.basket.Available(); got != tc.wantAvailable {
+				t.Errorf("\ngot: %v\nwant: %v", got, tc.wantAvailable)
+			}
+			if got := tc.basket.Left(); got != tc.wantLeft {
+				t.Errorf("\ngot: %v\nwant: %v", got, tc.wantLeft)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// A content of a deal of a video game notified on the notification channels
+//
+// [FYI]
+// The priority is the rank of a video game on the Steam wishlist, and 0 means that it has not been ranked yet.
+// The lowest price is the one before the current price is compared with it, and it is nil if it is not set.
+// The triggered rules are the deal rules which matched the video game
+type DealContent struct {
+	Title           string
+	Priority        uint32
+	CurrentPrice    Money
+	LowestPrice     *Money
+	RegularPrice    Money
+	DiscountPercent uint32
+	DealClass       DealClass
+	TriggeredRules  []DealRule
+}
+
+// A content of a video game skipped because it cannot be retrieved from the Steam Store
+type SkippedContent struct {
+	AppID  SteamAppID
+	Title  string
+	Reason string
+}
+
+// Sort app IDs of contents by the priority on the Steam wishlist in ascending order
+//
+// [FYI]
+// Video games that have not been ranked yet (priority 0) are placed at the end,
+// and video games with the same priority are sorted by a video game title in ascending order
+func SortContentAppIDs(contents map[SteamAppID]*DealContent) []SteamAppID {
+	return slices.SortedFunc(maps.Keys(contents), func(a, b SteamAppID) int {
+		x, y := contents[a], contents[b]
+		if x.Priority != y.Priority {
+			switch {
+			case x.Priority == 0:
+				return 1
+			case y.Priority == 0:
+				return -1
+			default:
+				return cmp.Compare(x.Priority, y.Priority)
+			}
+		}
+		if c := strings.Compare(x.Title, y.Title); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a, b)
+	})
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSortContentAppIDs(t *testing.T) {
+	t.Parallel()
+
+	contents := map[SteamAppID]*DealContent{
+		1: {Title: "D", Priority: 0},
+		2: {Title: "C", Priority: 2},
+		3: {Title: "B", Priority: 1},
+		4: {Title: "A", Priority: 2},
+		5: {Title: "A", Priority: 2},
+	}
+
+	// Execute the function to be tested
+	got := SortContentAppIDs(contents)
+	want := []SteamAppID{3, 4, 5, 2, 1}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("got(-) want(+)\n%s", diff)
+	}
+}
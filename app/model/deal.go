@@ -0,0 +1,263 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var errDealRuleEvaluation = errors.New("failed to evaluate a deal rule")
+
+// A classification of a deal of a video game compared with its lowest price
+type DealClass uint8
+
+const (
+	// The current price is lower than the lowest price
+	DealClassNewLow DealClass = iota
+	// The current price is equal to the lowest price
+	DealClassMatchesLow
+	// The current price is higher than the lowest price, but it is within a margin of the lowest price
+	DealClassNearLow
+	// The current price is not close to the lowest price, but it matches other rules (e.g. a target price)
+	DealClassOtherRules
+)
+
+// Deal classes in the order of their importance
+var DealClasses = []DealClass{DealClassNewLow, DealClassMatchesLow, DealClassNearLow, DealClassOtherRules}
+
+// Classify a deal of a video game with its current and lowest prices
+//
+// [FYI]
+// nearLowPercent is the margin of the lowest price in percent, and 0 disables DealClassNearLow
+// e.g. ({JPY, 1050}, {JPY, 1000}, 10) -> DealClassNearLow because 1050 is within 10% of 1000.
+// false is returned if the current price is not a deal or the currencies are different
+func ClassifyDeal(currentPrice, lowestPrice Money, nearLowPercent uint32) (DealClass, bool) {
+	if currentPrice.Currency != lowestPrice.Currency {
+		return 0, false
+	}
+
+	switch {
+	case currentPrice.Amount < lowestPrice.Amount:
+		return DealClassNewLow, true
+	case currentPrice.Amount == lowestPrice.Amount:
+		return DealClassMatchesLow, true
+	case currentPrice.Amount*100 <= lowestPrice.Amount*(100+uint64(nearLowPercent)):
+		return DealClassNearLow, nearLowPercent > 0
+	default:
+		return 0, false
+	}
+}
+
+// Get a heading of a section of the deal class in a message
+func (c DealClass) Heading() string {
+	switch c {
+	case DealClassNewLow:
+		return "New all-time lows"
+	case DealClassMatchesLow:
+		return "Matching the lowest prices"
+	case DealClassNearLow:
+		return "Close to the lowest prices"
+	case DealClassOtherRules:
+		return "Matching your own rules"
+	default:
+		return "Other deals"
+	}
+}
+
+// A rule which triggers a notification of a video game
+//
+// [FYI]
+// The rule is named after the column of the Notion DB which configures it
+type DealRule string
+
+const (
+	// The current price is a new low, matches the lowest price, or is close to it
+	DealRuleLowestPrice DealRule = "Lowest Price"
+	// The current price is lower than or equal to the target price
+	DealRuleTargetPrice DealRule = "Target Price"
+	// The discount is greater than or equal to the minimum discount
+	DealRuleMinDiscount DealRule = "Min Discount %"
+)
+
+// Variables of deal facts which expressions of deal rules can refer to
+const (
+	// The current price in the major units of its currency
+	DealVariablePrice = "price"
+	// The regular price in the major units of its currency
+	DealVariableRegularPrice = "regular_price"
+	// The discount in percent
+	DealVariableDiscount = "discount"
+	// The lowest price in the major units of its currency
+	DealVariableLowest = "lowest"
+	// The target price in the major units of its currency
+	DealVariableTarget = "target"
+	// The minimum discount in percent
+	DealVariableMinDiscount = "min_discount"
+	// The rank on the Steam wishlist (0 means that it has not been ranked yet)
+	DealVariablePriority = "priority"
+	// The percentage of positive reviews on the Steam Store
+	DealVariableReviewScore = "review_score"
+)
+
+// Variables of deal facts in the order of the documentation
+var DealVariables = []string{
+	DealVariablePrice,
+	DealVariableRegularPrice,
+	DealVariableDiscount,
+	DealVariableLowest,
+	DealVariableTarget,
+	DealVariableMinDiscount,
+	DealVariablePriority,
+	DealVariableReviewScore,
+}
+
+// Facts of a video game to evaluate deal rules
+//
+// [FYI]
+// The lowest price, the target price, and the minimum discount are nil if they are not set on the Notion DB.
+// The regular price is nil if it is not available, and the review score is nil if it is not retrieved
+type DealFacts struct {
+	CurrentPrice       Money
+	RegularPrice       *Money
+	LowestPrice        *Money
+	TargetPrice        *Money
+	DiscountPercent    uint32
+	MinDiscountPercent *uint32
+	Priority           uint32
+	ReviewScore        *float64
+}
+
+// Get values of variables of the deal facts
+//
+// [FYI]
+// Variables which are not set are not included,
+// and prices in a different currency from the current price are not included either
+func (f *DealFacts) Variables() map[string]float64 {
+	vars := map[string]float64{
+		DealVariablePrice:    f.CurrentPrice.Float64(),
+		DealVariableDiscount: float64(f.DiscountPercent),
+		DealVariablePriority: float64(f.Priority),
+	}
+
+	prices := map[string]*Money{
+		DealVariableRegularPrice: f.RegularPrice,
+		DealVariableLowest:       f.LowestPrice,
+		DealVariableTarget:       f.TargetPrice,
+	}
+	for name, price := range prices {
+		if price != nil && price.Currency == f.CurrentPrice.Currency {
+			vars[name] = price.Float64()
+		}
+	}
+
+	if f.MinDiscountPercent != nil {
+		vars[DealVariableMinDiscount] = float64(*f.MinDiscountPercent)
+	}
+	if f.ReviewScore != nil {
+		vars[DealVariableReviewScore] = *f.ReviewScore
+	}
+
+	return vars
+}
+
+// A result of evaluating deal rules of a video game
+type DealEvaluation struct {
+	DealClass      DealClass
+	TriggeredRules []DealRule
+}
+
+// An interface of a boolean expression of deal facts
+type DealExpression interface {
+	// Evaluate the expression with values of variables
+	Evaluate(vars map[string]float64) (bool, error)
+	// Get the names of variables which the expression refers to
+	Variables() []string
+}
+
+// A deal rule defined by an expression in the configuration
+// e.g. {"Big sale", "discount >= 50 && price <= lowest * 1.1"}
+type ExpressionDealRule struct {
+	Name       DealRule
+	Expression DealExpression
+}
+
+// An evaluator of deal rules
+//
+// [FYI]
+// A video game is a deal if any rule matches, and all matched rules are reported.
+// Expression rules are evaluated after the rules configured on the Notion DB
+type DealRuleEvaluator struct {
+	nearLowPercent  uint32
+	expressionRules []*ExpressionDealRule
+}
+
+// Generate a new DealRuleEvaluator
+func NewDealRuleEvaluator(nearLowPercent uint32, expressionRules ...*ExpressionDealRule) *DealRuleEvaluator {
+	return &DealRuleEvaluator{
+		nearLowPercent:  nearLowPercent,
+		expressionRules: expressionRules,
+	}
+}
+
+// Check whether any expression rule refers to a variable
+//
+// [FYI]
+// This is used to skip retrieving facts which no rule needs (e.g. review scores)
+func (e *DealRuleEvaluator) RequiresVariable(name string) bool {
+	for _, v := range e.expressionRules {
+		if slices.Contains(v.Expression.Variables(), name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Evaluate deal rules of a video game
+//
+// [FYI]
+// false is returned if no rule matches
+func (e *DealRuleEvaluator) Evaluate(facts *DealFacts) (*DealEvaluation, bool, error) {
+	evaluation := &DealEvaluation{
+		DealClass:      DealClassOtherRules,
+		TriggeredRules: make([]DealRule, 0),
+	}
+
+	if facts.LowestPrice != nil {
+		if dealClass, ok := ClassifyDeal(facts.CurrentPrice, *facts.LowestPrice, e.nearLowPercent); ok {
+			evaluation.DealClass = dealClass
+			evaluation.TriggeredRules = append(evaluation.TriggeredRules, DealRuleLowestPrice)
+		}
+	}
+
+	if facts.TargetPrice != nil &&
+		facts.TargetPrice.Currency == facts.CurrentPrice.Currency &&
+		facts.CurrentPrice.Amount <= facts.TargetPrice.Amount {
+		evaluation.TriggeredRules = append(evaluation.TriggeredRules, DealRuleTargetPrice)
+	}
+
+	if facts.MinDiscountPercent != nil &&
+		facts.DiscountPercent > 0 &&
+		facts.DiscountPercent >= *facts.MinDiscountPercent {
+		evaluation.TriggeredRules = append(evaluation.TriggeredRules, DealRuleMinDiscount)
+	}
+
+	if len(e.expressionRules) > 0 {
+		vars := facts.Variables()
+		for _, v := range e.expressionRules {
+			matched, err := v.Expression.Evaluate(vars)
+			if err != nil {
+				return nil, false, fmt.Errorf("%w: %s: %w", errDealRuleEvaluation, v.Name, err)
+			}
+			if matched {
+				evaluation.TriggeredRules = append(evaluation.TriggeredRules, v.Name)
+			}
+		}
+	}
+
+	if len(evaluation.TriggeredRules) == 0 {
+		return nil, false, nil
+	}
+
+	return evaluation, true, nil
+}
@@ -0,0 +1,238 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/pointer"
+)
+
+func TestClassifyDeal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentPrice   Money
+		lowestPrice    Money
+		nearLowPercent uint32
+		wantClass      DealClass
+		wantOK         bool
+	}{
+		"Positive case: The current price is a new low": {
+			currentPrice:   Money{Currency: "JPY", Amount: 900},
+			lowestPrice:    Money{Currency: "JPY", Amount: 1000},
+			nearLowPercent: 10,
+			wantClass:      DealClassNewLow,
+			wantOK:         true,
+		},
+		"Positive case: The current price matches the lowest price": {
+			currentPrice:   Money{Currency: "JPY", Amount: 1000},
+			lowestPrice:    Money{Currency: "JPY", Amount: 1000},
+			nearLowPercent: 10,
+			wantClass:      DealClassMatchesLow,
+			wantOK:         true,
+		},
+		"Positive case: The current price is within the margin of the lowest price": {
+			currentPrice:   Money{Currency: "JPY", Amount: 1100},
+			lowestPrice:    Money{Currency: "JPY", Amount: 1000},
+			nearLowPercent: 10,
+			wantClass:      DealClassNearLow,
+			wantOK:         true,
+		},
+		"Positive case: The current price is beyond the margin of the lowest price": {
+			currentPrice:   Money{Currency: "JPY", Amount: 1101},
+			lowestPrice:    Money{Currency: "JPY", Amount: 1000},
+			nearLowPercent: 10,
+			wantOK:         false,
+		},
+		"Positive case: The margin is disabled": {
+			currentPrice:   Money{Currency: "JPY", Amount: 1001},
+			lowestPrice:    Money{Currency: "JPY", Amount: 1000},
+			nearLowPercent: 0,
+			wantOK:         false,
+		},
+		"Positive case: The currencies are different": {
+			currentPrice:   Money{Currency: "AUD", Amount: 900},
+			lowestPrice:    Money{Currency: "JPY", Amount: 1000},
+			nearLowPercent: 10,
+			wantOK:         false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the function to be tested
+			gotClass, gotOK := ClassifyDeal(tc.currentPrice, tc.lowestPrice, tc.nearLowPercent)
+			if gotOK != tc.wantOK {
+				t.Errorf("\ngot: %v\nwant: %v", gotOK, tc.wantOK)
+			}
+			if gotOK && gotClass != tc.wantClass {
+				t.Errorf("\ngot: %v\nwant: %v", gotClass, tc.wantClass)
+			}
+		})
+	}
+}
+
+func TestDealRuleEvaluatorEvaluate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		facts     *DealFacts
+		rules     []*ExpressionDealRule
+		wantClass DealClass
+		wantRules []DealRule
+		wantOK    bool
+		wantErr   bool
+	}{
+		"Positive case: The lowest price rule matches": {
+			facts: &DealFacts{
+				CurrentPrice: Money{Currency: "JPY", Amount: 1000},
+				LowestPrice:  &Money{Currency: "JPY", Amount: 1000},
+			},
+			wantClass: DealClassMatchesLow,
+			wantRules: []DealRule{DealRuleLowestPrice},
+			wantOK:    true,
+		},
+		"Positive case: The target price rule matches regardless of the lowest price": {
+			facts: &DealFacts{
+				CurrentPrice: Money{Currency: "JPY", Amount: 1000},
+				LowestPrice:  &Money{Currency: "JPY", Amount: 500},
+				TargetPrice:  &Money{Currency: "JPY", Amount: 1000},
+			},
+			wantClass: DealClassOtherRules,
+			wantRules: []DealRule{DealRuleTargetPrice},
+			wantOK:    true,
+		},
+		"Positive case: The minimum discount rule matches without the lowest price": {
+			facts: &DealFacts{
+				CurrentPrice:       Money{Currency: "JPY", Amount: 1000},
+				DiscountPercent:    50,
+				MinDiscountPercent: pointer.Ptr(uint32(50)),
+			},
+			wantClass: DealClassOtherRules,
+			wantRules: []DealRule{DealRuleMinDiscount},
+			wantOK:    true,
+		},
+		"Positive case: All matched rules are reported": {
+			facts: &DealFacts{
+				CurrentPrice:       Money{Currency: "JPY", Amount: 900},
+				LowestPrice:        &Money{Currency: "JPY", Amount: 1000},
+				TargetPrice:        &Money{Currency: "JPY", Amount: 1000},
+				DiscountPercent:    50,
+				MinDiscountPercent: pointer.Ptr(uint32(30)),
+			},
+			wantClass: DealClassNewLow,
+			wantRules: []DealRule{DealRuleLowestPrice, DealRuleTargetPrice, DealRuleMinDiscount},
+			wantOK:    true,
+		},
+		"Positive case: An expression rule matches with variables of the facts": {
+			facts: &DealFacts{
+				CurrentPrice:    Money{Currency: "AUD", Amount: 1999},
+				LowestPrice:     &Money{Currency: "AUD", Amount: 1500},
+				DiscountPercent: 50,
+				ReviewScore:     pointer.Ptr(87.5),
+			},
+			rules: []*ExpressionDealRule{
+				{
+					Name: "Big sale",
+					Expression: &stubDealExpression{
+						want: map[string]float64{"price": 19.99, "lowest": 15, "discount": 50, "priority": 0, "review_score": 87.5},
+					},
+				},
+				{
+					Name:       "Never",
+					Expression: &stubDealExpression{},
+				},
+			},
+			wantClass: DealClassOtherRules,
+			wantRules: []DealRule{"Big sale"},
+			wantOK:    true,
+		},
+		"Positive case: No rule matches": {
+			facts: &DealFacts{
+				CurrentPrice:       Money{Currency: "JPY", Amount: 2000},
+				LowestPrice:        &Money{Currency: "JPY", Amount: 1000},
+				TargetPrice:        &Money{Currency: "JPY", Amount: 1000},
+				DiscountPercent:    0,
+				MinDiscountPercent: pointer.Ptr(uint32(0)),
+			},
+			wantOK: false,
+		},
+		"Negative case: An expression rule fails to be evaluated": {
+			facts: &DealFacts{
+				CurrentPrice: Money{Currency: "JPY", Amount: 1000},
+			},
+			rules: []*ExpressionDealRule{
+				{
+					Name:       "Broken",
+					Expression: &stubDealExpression{err: errors.New("unexpected error")},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			e := NewDealRuleEvaluator(10, tc.rules...)
+			got, gotOK, err := e.Evaluate(tc.facts)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\ngot: %v\nwant: %v", err, tc.wantErr)
+			}
+			if gotOK != tc.wantOK {
+				t.Fatalf("\ngot: %v\nwant: %v", gotOK, tc.wantOK)
+			}
+			if !gotOK {
+				return
+			}
+			if got.DealClass != tc.wantClass {
+				t.Errorf("\ngot: %v\nwant: %v", got.DealClass, tc.wantClass)
+			}
+			if diff := cmp.Diff(got.TriggeredRules, tc.wantRules); diff != "" {
+				t.Errorf("got(-) want(+)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDealRuleEvaluatorRequiresVariable(t *testing.T) {
+	t.Parallel()
+
+	e := NewDealRuleEvaluator(
+		0,
+		&ExpressionDealRule{
+			Name:       "Well reviewed",
+			Expression: &stubDealExpression{variables: []string{DealVariableDiscount, DealVariableReviewScore}},
+		},
+	)
+	if got := e.RequiresVariable(DealVariableReviewScore); !got {
+		t.Errorf("\ngot: %v\nwant: %v", got, true)
+	}
+	if got := e.RequiresVariable(DealVariableTarget); got {
+		t.Errorf("\ngot: %v\nwant: %v", got, false)
+	}
+}
+
+// A stub of DealExpression which matches only if the variables are the same as wanted
+type stubDealExpression struct {
+	want      map[string]float64
+	variables []string
+	err       error
+}
+
+func (e *stubDealExpression) Evaluate(vars map[string]float64) (bool, error) {
+	if e.err != nil {
+		return false, e.err
+	}
+
+	return e.want != nil && cmp.Equal(vars, e.want), nil
+}
+
+func (e *stubDealExpression) Variables() []string {
+	return e.variables
+}
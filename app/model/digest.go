@@ -0,0 +1,121 @@
+package model
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+var errInvalidWeekday = errors.New("invalid weekday")
+
+// A deal queued for the weekly digest instead of being notified instantly
+//
+// [FYI]
+// The content is the same as the one notified instantly, and the queued time is when the deal was found
+type QueuedDeal struct {
+	AppID    SteamAppID
+	QueuedAt time.Time
+	Content  *DealContent
+}
+
+// A video game on the wishlist which is currently discounted
+type DiscountedGame struct {
+	AppID           SteamAppID
+	Title           string
+	CurrentPrice    Money
+	RegularPrice    Money
+	DiscountPercent uint32
+}
+
+// Get the amount saved by buying a video game at the current price
+func (g *DiscountedGame) Savings() Money {
+	if g.CurrentPrice.Amount >= g.RegularPrice.Amount {
+		return Money{Currency: g.CurrentPrice.Currency}
+	}
+
+	return Money{Currency: g.CurrentPrice.Currency, Amount: g.RegularPrice.Amount - g.CurrentPrice.Amount}
+}
+
+// A weekly digest of deals
+//
+// [FYI]
+// The queued deals are the deals found since the last digest in the order they were found.
+// The discounted games are sorted by the biggest discounts first,
+// and the total savings is nil if no video game is discounted
+type Digest struct {
+	QueuedDeals     []*QueuedDeal
+	DiscountedGames []*DiscountedGame
+	TotalSavings    *Money
+}
+
+// Generate a new Digest
+//
+// [FYI]
+// Video games whose currencies are different from the first one are not counted in the total savings
+func NewDigest(queuedDeals []*QueuedDeal, discountedGames []*DiscountedGame) *Digest {
+	digest := &Digest{
+		QueuedDeals: slices.SortedFunc(slices.Values(queuedDeals), func(a, b *QueuedDeal) int {
+			if c := a.QueuedAt.Compare(b.QueuedAt); c != 0 {
+				return c
+			}
+
+			return cmp.Compare(a.AppID, b.AppID)
+		}),
+		DiscountedGames: slices.SortedFunc(slices.Values(discountedGames), func(a, b *DiscountedGame) int {
+			if a.DiscountPercent != b.DiscountPercent {
+				return cmp.Compare(b.DiscountPercent, a.DiscountPercent)
+			}
+			if c := cmp.Compare(b.Savings().Amount, a.Savings().Amount); c != 0 {
+				return c
+			}
+
+			return strings.Compare(a.Title, b.Title)
+		}),
+	}
+
+	if len(digest.DiscountedGames) > 0 {
+		digest.TotalSavings = &Money{Currency: digest.DiscountedGames[0].CurrentPrice.Currency}
+		for _, v := range digest.DiscountedGames {
+			if v.CurrentPrice.Currency != digest.TotalSavings.Currency {
+				continue
+			}
+
+			digest.TotalSavings.Amount += v.Savings().Amount
+		}
+	}
+
+	return digest
+}
+
+// Parse a weekday in English regardless of the case (e.g. "Sunday", "sun")
+func ParseWeekday(s string) (time.Weekday, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		name := strings.ToLower(d.String())
+		if s == name || (len(s) == 3 && strings.HasPrefix(name, s)) {
+			return d, nil
+		}
+	}
+
+	return 0, errInvalidWeekday
+}
+
+// Check whether the weekly digest is due
+//
+// [FYI]
+// The digest is due on the weekday in JST, and it is sent only once a day even if the app runs several times.
+// The last sent time is zero if the digest has never been sent
+func IsDigestDue(now, lastSentAt time.Time, weekday time.Weekday) bool {
+	now = now.In(budgetLocation)
+	if now.Weekday() != weekday {
+		return false
+	}
+	if lastSentAt.IsZero() {
+		return true
+	}
+
+	lastSentAt = lastSentAt.In(budgetLocation)
+	return lastSentAt.YearDay() != now.YearDay() || lastSentAt.Year() != now.Year()
+}
@@ -0,0 +1,190 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/pointer"
+)
+
+func TestNewDigest(t *testing.T) {
+	t.Parallel()
+
+	// Generate a discounted video game
+	newDiscountedGame := func(appID SteamAppID, title string, currentPrice, regularPrice uint64, discountPercent uint32) *DiscountedGame {
+		return &DiscountedGame{
+			AppID:           appID,
+			Title:           title,
+			CurrentPrice:    Money{Currency: "JPY", Amount: currentPrice},
+			RegularPrice:    Money{Currency: "JPY", Amount: regularPrice},
+			DiscountPercent: discountPercent,
+		}
+	}
+	firstRun := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	secondRun := firstRun.Add(24 * time.Hour)
+
+	testCases := map[string]struct {
+		queuedDeals     []*QueuedDeal
+		discountedGames []*DiscountedGame
+		want            *Digest
+	}{
+		"Positive case: The deals are sorted in the order they were found, and the biggest discounts come first": {
+			queuedDeals: []*QueuedDeal{
+				{AppID: 2, QueuedAt: secondRun},
+				{AppID: 3, QueuedAt: firstRun},
+				{AppID: 1, QueuedAt: secondRun},
+			},
+			discountedGames: []*DiscountedGame{
+				newDiscountedGame(1, "Title1", 1500, 2000, 25),
+				newDiscountedGame(2, "Title2", 1000, 4000, 75),
+				newDiscountedGame(3, "Title3", 500, 2000, 75),
+			},
+			want: &Digest{
+				QueuedDeals: []*QueuedDeal{
+					{AppID: 3, QueuedAt: firstRun},
+					{AppID: 1, QueuedAt: secondRun},
+					{AppID: 2, QueuedAt: secondRun},
+				},
+				DiscountedGames: []*DiscountedGame{
+					newDiscountedGame(2, "Title2", 1000, 4000, 75),
+					newDiscountedGame(3, "Title3", 500, 2000, 75),
+					newDiscountedGame(1, "Title1", 1500, 2000, 25),
+				},
+				TotalSavings: &Money{Currency: "JPY", Amount: 5000},
+			},
+		},
+		"Positive case: The total savings is not set if no video game is discounted": {
+			queuedDeals: []*QueuedDeal{
+				{AppID: 1, QueuedAt: firstRun},
+			},
+			want: &Digest{
+				QueuedDeals: []*QueuedDeal{
+					{AppID: 1, QueuedAt: firstRun},
+				},
+			},
+		},
+		"Positive case: Video games in a different currency are not counted in the total savings": {
+			discountedGames: []*DiscountedGame{
+				newDiscountedGame(1, "Title1", 1000, 2000, 50),
+				{
+					AppID:           2,
+					Title:           "Title2",
+					CurrentPrice:    Money{Currency: "AUD", Amount: 1000},
+					RegularPrice:    Money{Currency: "AUD", Amount: 4000},
+					DiscountPercent: 75,
+				},
+			},
+			want: &Digest{
+				DiscountedGames: []*DiscountedGame{
+					{
+						AppID:           2,
+						Title:           "Title2",
+						CurrentPrice:    Money{Currency: "AUD", Amount: 1000},
+						RegularPrice:    Money{Currency: "AUD", Amount: 4000},
+						DiscountPercent: 75,
+					},
+					newDiscountedGame(1, "Title1", 1000, 2000, 50),
+				},
+				TotalSavings: &Money{Currency: "AUD", Amount: 3000},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the function to be tested
+			got := NewDigest(tc.queuedDeals, tc.discountedGames)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("got(-) want(+)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		s       string
+		want    *time.Weekday
+		wantErr bool
+	}{
+		"Positive case: Parse a full name of a weekday": {
+			s:    "Sunday",
+			want: pointer.Ptr(time.Sunday),
+		},
+		"Positive case: Parse an abbreviated name of a weekday regardless of the case": {
+			s:    " FRI ",
+			want: pointer.Ptr(time.Friday),
+		},
+		"Negative case: The weekday is unknown": {
+			s:       "Someday",
+			wantErr: true,
+		},
+		"Negative case: The weekday is too short": {
+			s:       "s",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the function to be tested
+			got, err := ParseWeekday(tc.s)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\ngot: %v\nwant: %v", err, tc.wantErr)
+			}
+			if tc.want != nil && got != *tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", got, *tc.want)
+			}
+		})
+	}
+}
+
+func TestIsDigestDue(t *testing.T) {
+	t.Parallel()
+
+	// 2025-01-05T09:00:00+09:00 is Sunday in JST, but it is Saturday in UTC
+	now := time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		lastSentAt time.Time
+		weekday    time.Weekday
+		want       bool
+	}{
+		"Positive case: The digest has never been sent": {
+			weekday: time.Sunday,
+			want:    true,
+		},
+		"Positive case: The digest was sent last week": {
+			lastSentAt: now.AddDate(0, 0, -7),
+			weekday:    time.Sunday,
+			want:       true,
+		},
+		"Positive case: The digest has already been sent today": {
+			lastSentAt: now.Add(-time.Hour),
+			weekday:    time.Sunday,
+			want:       false,
+		},
+		"Positive case: It is not the weekday of the digest": {
+			weekday: time.Saturday,
+			want:    false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the function to be tested
+			if got := IsDigestDue(now, tc.lastSentAt, tc.weekday); got != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", got, tc.want)
+			}
+		})
+	}
+}
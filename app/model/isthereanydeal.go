@@ -0,0 +1,44 @@
+package model
+
+import "encoding/json"
+
+// An ID of the Steam shop on IsThereAnyDeal
+const IsThereAnyDealSteamShopID int = 61
+
+// An ID of a video game on IsThereAnyDeal
+type IsThereAnyDealGameID string
+
+// A lowest price of a video game in each shop on IsThereAnyDeal
+type IsThereAnyDealStoreLow struct {
+	ID   IsThereAnyDealGameID  `json:"id"`
+	Lows []*IsThereAnyDealDeal `json:"lows"`
+}
+
+// A deal of a video game on IsThereAnyDeal
+type IsThereAnyDealDeal struct {
+	Shop      *IsThereAnyDealShop  `json:"shop"`
+	Price     *IsThereAnyDealPrice `json:"price"`
+	Regular   *IsThereAnyDealPrice `json:"regular"`
+	Cut       uint32               `json:"cut"`
+	Timestamp string               `json:"timestamp"`
+}
+
+// A shop on IsThereAnyDeal
+type IsThereAnyDealShop struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// A price on IsThereAnyDeal
+//
+// [FYI]
+// Amount is in the major units of the currency (e.g. 19.99 for 19.99 AUD)
+type IsThereAnyDealPrice struct {
+	Amount   json.Number  `json:"amount"`
+	Currency CurrencyCode `json:"currency"`
+}
+
+// Convert the price into Money
+func (p *IsThereAnyDealPrice) ToMoney() (*Money, error) {
+	return ParseMoney(p.Currency, p.Amount.String())
+}
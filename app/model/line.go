@@ -0,0 +1,78 @@
+package model
+
+// Types of LINE messages, Flex Message components and actions
+const (
+	LineMessageTypeText      string = "text"
+	LineMessageTypeFlex      string = "flex"
+	LineFlexTypeCarousel     string = "carousel"
+	LineFlexTypeBubble       string = "bubble"
+	LineFlexTypeBox          string = "box"
+	LineFlexTypeText         string = "text"
+	LineFlexTypeButton       string = "button"
+	LineFlexLayoutVertical   string = "vertical"
+	LineActionTypeURI        string = "uri"
+	LineFlexButtonStyleLink  string = "link"
+	LineFlexTextWeightBold   string = "bold"
+	LineFlexTextSizeSmall    string = "sm"
+	LineFlexTextColorCaption string = "#888888"
+)
+
+// A body of a request to push messages with the LINE Messaging API
+//
+// [FYI]
+// ref. https://developers.line.biz/en/reference/messaging-api/#send-push-message
+type LinePushMessageBody struct {
+	To       string         `json:"to"`
+	Messages []*LineMessage `json:"messages"`
+}
+
+// A message of LINE
+//
+// [FYI]
+// A text message has the text, and a Flex Message has the alternative text and the contents.
+// The alternative text is shown in notifications and on devices which cannot show Flex Messages
+type LineMessage struct {
+	Type     string        `json:"type"`
+	Text     string        `json:"text,omitempty"`
+	AltText  string        `json:"altText,omitempty"`
+	Contents *LineCarousel `json:"contents,omitempty"`
+}
+
+// A carousel container of a Flex Message
+type LineCarousel struct {
+	Type     string        `json:"type"`
+	Contents []*LineBubble `json:"contents"`
+}
+
+// A bubble container of a Flex Message
+type LineBubble struct {
+	Type   string   `json:"type"`
+	Body   *LineBox `json:"body"`
+	Footer *LineBox `json:"footer,omitempty"`
+}
+
+// A box component of a Flex Message
+type LineBox struct {
+	Type     string               `json:"type"`
+	Layout   string               `json:"layout"`
+	Contents []*LineFlexComponent `json:"contents"`
+}
+
+// A text or button component of a Flex Message
+type LineFlexComponent struct {
+	Type   string      `json:"type"`
+	Text   string      `json:"text,omitempty"`
+	Weight string      `json:"weight,omitempty"`
+	Size   string      `json:"size,omitempty"`
+	Color  string      `json:"color,omitempty"`
+	Wrap   bool        `json:"wrap,omitempty"`
+	Style  string      `json:"style,omitempty"`
+	Action *LineAction `json:"action,omitempty"`
+}
+
+// A URI action of a button component
+type LineAction struct {
+	Type  string `json:"type"`
+	Label string `json:"label"`
+	URI   string `json:"uri"`
+}
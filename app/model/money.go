@@ -0,0 +1,153 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var errInvalidMoneyAmount = errors.New("invalid money amount")
+
+// A currency code in ISO 4217 format (e.g. "JPY", "AUD", "USD")
+type CurrencyCode string
+
+// Get the symbol of the currency
+//
+// [FYI]
+// The currency code followed by a space is returned if the symbol is not registered
+// e.g. "JPY" -> "¥", "AUD" -> "A$", "CHF" -> "CHF "
+func (c CurrencyCode) Symbol() string {
+	switch c {
+	case "JPY", "CNY":
+		return "¥"
+	case "USD":
+		return "$"
+	case "AUD":
+		return "A$"
+	case "CAD":
+		return "CA$"
+	case "NZD":
+		return "NZ$"
+	case "EUR":
+		return "€"
+	case "GBP":
+		return "£"
+	case "KRW":
+		return "₩"
+	default:
+		return string(c) + " "
+	}
+}
+
+// Get the number of decimal places of the currency
+//
+// [FYI]
+// Most currencies supported by the Steam Store have two decimal places,
+// but some of them (e.g. JPY and KRW) do not have minor units
+func (c CurrencyCode) Exponent() uint8 {
+	switch c {
+	case "JPY", "KRW", "VND", "CLP":
+		return 0
+	default:
+		return 2
+	}
+}
+
+// An amount of money in the minor units of its currency
+// e.g. {JPY, 7678} -> 7678 (JPY), {AUD, 1999} -> 19.99 (AUD)
+type Money struct {
+	Currency CurrencyCode
+	Amount   uint64
+}
+
+// An amount of money in the major units of a currency which is decided later
+// e.g. "10000" is 10,000 JPY in JPY and 10,000.00 AUD in AUD
+type MajorAmount struct {
+	amount *big.Rat
+}
+
+// Parse a non-negative decimal string into MajorAmount
+func ParseMajorAmount(s string) (*MajorAmount, error) {
+	amount, ok := new(big.Rat).SetString(s)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %q", errInvalidMoneyAmount, s)
+	}
+
+	return &MajorAmount{amount: amount}, nil
+}
+
+// Convert the amount into Money in a currency
+//
+// [FYI]
+// Digits beyond the minor units of the currency are truncated
+// e.g. ("19.999", "AUD") -> {AUD, 1999}
+func (a *MajorAmount) ToMoney(currency CurrencyCode) (*Money, error) {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(currency.Exponent())), nil)
+	amount := new(big.Rat).Mul(a.amount, new(big.Rat).SetInt(scale))
+	minorUnits := new(big.Int).Quo(amount.Num(), amount.Denom())
+	if !minorUnits.IsUint64() {
+		return nil, fmt.Errorf("%w: %q", errInvalidMoneyAmount, a.amount.FloatString(int(currency.Exponent())))
+	}
+
+	return &Money{
+		Currency: currency,
+		Amount:   minorUnits.Uint64(),
+	}, nil
+}
+
+// Parse a decimal string in the major units of a currency into Money
+//
+// [FYI]
+// Digits beyond the minor units of the currency are truncated
+// e.g. ("AUD", "19.999") -> {AUD, 1999}
+func ParseMoney(currency CurrencyCode, s string) (*Money, error) {
+	amount, err := ParseMajorAmount(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return amount.ToMoney(currency)
+}
+
+// Format the amount of money as a decimal string without a currency code
+// e.g. {JPY, 7678} -> "7678", {AUD, 1999} -> "19.99"
+func (m Money) String() string {
+	exponent := int(m.Currency.Exponent())
+	if exponent == 0 {
+		return strconv.FormatUint(m.Amount, 10)
+	}
+
+	digits := fmt.Sprintf("%0*d", exponent+1, m.Amount)
+	point := len(digits) - exponent
+
+	return digits[:point] + "." + digits[point:]
+}
+
+// Convert the amount of money into a number in the major units of its currency
+// e.g. {JPY, 7678} -> 7678, {AUD, 1999} -> 19.99
+func (m Money) Float64() float64 {
+	return float64(m.Amount) / math.Pow10(int(m.Currency.Exponent()))
+}
+
+// Format the amount of money with the symbol of its currency and thousands separators
+// e.g. {JPY, 1000} -> "¥1,000", {AUD, 123456} -> "A$1,234.56"
+func (m Money) Format() string {
+	integer, fraction, _ := strings.Cut(m.String(), ".")
+
+	var b strings.Builder
+	b.WriteString(m.Currency.Symbol())
+	for i, r := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+	if fraction != "" {
+		b.WriteString("." + fraction)
+	}
+
+	return b.String()
+}
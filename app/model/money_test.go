@@ -0,0 +1,204 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	t.Parallel()
+
+	positiveTestCases := map[string]struct {
+		currency CurrencyCode
+		s        string
+		want     Money
+	}{
+		"Positive case: Successfully parse a price in JPY": {
+			currency: "JPY",
+			s:        "7678",
+			want:     Money{Currency: "JPY", Amount: 7678},
+		},
+		"Positive case: Successfully parse a price in AUD": {
+			currency: "AUD",
+			s:        "19.99",
+			want:     Money{Currency: "AUD", Amount: 1999},
+		},
+		"Positive case: Digits beyond the minor units are truncated": {
+			currency: "USD",
+			s:        "19.999",
+			want:     Money{Currency: "USD", Amount: 1999},
+		},
+	}
+
+	for name, tc := range positiveTestCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the function to be tested
+			got, err := ParseMoney(tc.currency, tc.s)
+			if err != nil {
+				t.Errorf("\ngot: %v\nwant: %v", err, nil)
+			}
+			if *got != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", *got, tc.want)
+			}
+		})
+	}
+
+	negativeTestCases := map[string]struct {
+		s string
+	}{
+		"Negative case: The price to be tested is invalid": {
+			s: "invalid",
+		},
+		"Negative case: The price to be tested is negative": {
+			s: "-1",
+		},
+	}
+
+	for name, tc := range negativeTestCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the function to be tested
+			if _, err := ParseMoney("JPY", tc.s); err == nil {
+				t.Errorf("\ngot: %v\nwant: an error generated in money.go", nil)
+			}
+		})
+	}
+}
+
+func TestMajorAmountToMoney(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currency CurrencyCode
+		want     Money
+	}{
+		"Positive case: Successfully convert an amount into JPY": {
+			currency: "JPY",
+			want:     Money{Currency: "JPY", Amount: 10000},
+		},
+		"Positive case: Successfully convert an amount into AUD": {
+			currency: "AUD",
+			want:     Money{Currency: "AUD", Amount: 1000050},
+		},
+	}
+
+	amount, err := ParseMajorAmount("10000.5")
+	if err != nil {
+		t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			got, err := amount.ToMoney(tc.currency)
+			if err != nil {
+				t.Errorf("\ngot: %v\nwant: %v", err, nil)
+			}
+			if *got != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", *got, tc.want)
+			}
+		})
+	}
+}
+func TestMoneyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		money Money
+		want  string
+	}{
+		"Positive case: Format a price in JPY": {
+			money: Money{Currency: "JPY", Amount: 7678},
+			want:  "7678",
+		},
+		"Positive case: Format a price in AUD": {
+			money: Money{Currency: "AUD", Amount: 1999},
+			want:  "19.99",
+		},
+		"Positive case: Format a price in USD less than 1 dollar": {
+			money: Money{Currency: "USD", Amount: 5},
+			want:  "0.05",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			if got := tc.money.String(); got != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		money Money
+		want  string
+	}{
+		"Positive case: Format a price in JPY with thousands separators": {
+			money: Money{Currency: "JPY", Amount: 1234567},
+			want:  "¥1,234,567",
+		},
+		"Positive case: Format a price in JPY less than 1000 yen": {
+			money: Money{Currency: "JPY", Amount: 250},
+			want:  "¥250",
+		},
+		"Positive case: Format a price in AUD": {
+			money: Money{Currency: "AUD", Amount: 123456},
+			want:  "A$1,234.56",
+		},
+		"Positive case: Format a price in a currency without a registered symbol": {
+			money: Money{Currency: "CHF", Amount: 1999},
+			want:  "CHF 19.99",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			if got := tc.money.Format(); got != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMoneyFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		money Money
+		want  float64
+	}{
+		"Positive case: Convert a price in JPY": {
+			money: Money{Currency: "JPY", Amount: 7678},
+			want:  7678,
+		},
+		"Positive case: Convert a price in AUD": {
+			money: Money{Currency: "AUD", Amount: 1999},
+			want:  19.99,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			if got := tc.money.Float64(); got != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", got, tc.want)
+			}
+		})
+	}
+}
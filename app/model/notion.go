@@ -2,7 +2,9 @@ package model
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
+	"strings"
 	"time"
 )
 
@@ -37,11 +39,27 @@ type NotionParent struct {
 
 // Properties of NotionWishlistItem
 type NotionProperties struct {
-	NotionAppID       *NotionAppID       `json:"App ID,omitempty"`
-	NotionTitle       *NotionTitle       `json:"Title,omitempty"`
-	CurrentPrice      *NotionPrice       `json:"Current Price,omitempty"`
-	LowestPrice       *NotionPrice       `json:"Lowest Price,omitempty"`
-	NotionReleaseDate *NotionReleaseDate `json:"Release Date,omitempty"`
+	NotionAppID       *NotionAppID        `json:"App ID,omitempty"`
+	NotionTitle       *NotionTitle        `json:"Title,omitempty"`
+	CurrentPrice      *NotionPrice        `json:"Current Price,omitempty"`
+	Currency          *NotionSelect       `json:"Currency,omitempty"`
+	LowestPrice       *NotionPrice        `json:"Lowest Price,omitempty"`
+	NotionReleaseDate *NotionReleaseDate  `json:"Release Date,omitempty"`
+	Priority          *NotionPriority     `json:"Priority,omitempty"`
+	DateAdded         *NotionDateAdded    `json:"Date Added,omitempty"`
+	WantedBy          *NotionMultiSelect  `json:"Wanted By,omitempty"`
+	RegularPrice      *NotionPrice        `json:"Regular Price,omitempty"`
+	DiscountPercent   *NotionPercent      `json:"Discount %,omitempty"`
+	ReleaseDateText   *NotionRichText     `json:"Release Date Text,omitempty"`
+	Purchased         *NotionCheckbox     `json:"Purchased,omitempty"`
+	PurchaseDate      *NotionPurchaseDate `json:"Purchase Date,omitempty"`
+	PurchasePrice     *NotionPrice        `json:"Purchase Price,omitempty"`
+	LastNotifiedPrice *NotionPrice        `json:"Last Notified Price,omitempty"`
+	LastNotifiedAt    *NotionNotifiedAt   `json:"Last Notified At,omitempty"`
+	TargetPrice       *NotionPrice        `json:"Target Price,omitempty"`
+	MinDiscount       *NotionPercent      `json:"Min Discount %,omitempty"`
+	Muted             *NotionCheckbox     `json:"Muted,omitempty"`
+	SnoozeUntil       *NotionSnoozeUntil  `json:"Snooze Until,omitempty"`
 }
 
 // An app ID of NotionProperties
@@ -54,6 +72,35 @@ type NotionTitle struct {
 	RichText []*NotionContent `json:"rich_text"`
 }
 
+// Generate a new NotionTitle from a plain text
+func NewNotionTitle(text string) *NotionTitle {
+	return &NotionTitle{
+		RichText: []*NotionContent{
+			{
+				NotionText: &NotionText{
+					NotionContent: text,
+				},
+			},
+		},
+	}
+}
+
+// Get the plain text of NotionTitle
+func (t *NotionTitle) String() string {
+	if t == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, v := range t.RichText {
+		if v != nil && v.NotionText != nil {
+			b.WriteString(v.NotionText.NotionContent)
+		}
+	}
+
+	return b.String()
+}
+
 // A content of NotionTitle
 type NotionContent struct {
 	NotionText *NotionText `json:"text"`
@@ -65,8 +112,54 @@ type NotionText struct {
 }
 
 // A price of NotionProperties
+//
+// [FYI]
+// The price is stored in the major units of its currency (e.g. 19.99 for 19.99 AUD)
 type NotionPrice struct {
-	Number *uint64 `json:"number"`
+	Number *json.Number `json:"number"`
+}
+
+// Generate a new NotionPrice from Money
+func NewNotionPrice(m *Money) *NotionPrice {
+	if m == nil {
+		return &NotionPrice{Number: nil}
+	}
+
+	number := json.Number(m.String())
+	return &NotionPrice{Number: &number}
+}
+
+// Convert the price into Money of the given currency
+func (p *NotionPrice) ToMoney(ctx context.Context, currency CurrencyCode) (*Money, error) {
+	if p == nil || p.Number == nil {
+		return nil, nil
+	}
+
+	m, err := ParseMoney(currency, p.Number.String())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to convert the price to Money", slog.Any("error", err))
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Check whether the prices of NotionProperties are stored in a currency
+//
+// [FYI]
+// The prices are stored without their currency, so the currency is stored in its own property.
+// Prices stored before the currency was recorded are regarded as prices in the given currency
+func (p *NotionProperties) IsPricedIn(currency CurrencyCode) bool {
+	stored := p.Currency.String()
+	return stored == "" || CurrencyCode(stored) == currency
+}
+
+// A percentage of NotionProperties
+//
+// [FYI]
+// The percentage is stored as an integer (e.g. 75 for 75%), and it is set to nil if it is not available
+type NotionPercent struct {
+	Number *uint32 `json:"number"`
 }
 
 // A release date of NotionProperties
@@ -74,9 +167,242 @@ type NotionReleaseDate struct {
 	NotionDate *NotionDate `json:"date"`
 }
 
+// Generate a new NotionReleaseDate from a parsed release date
+//
+// [FYI]
+// The release date is set as a range if its precision is a month, a quarter, or a year
+// e.g. "Q3 2025" is set from 2025-07-01 to 2025-09-30
+// The date is set to nil if the precision is unknown (e.g. "Coming soon")
+func NewNotionReleaseDate(releaseDate *ReleaseDate) *NotionReleaseDate {
+	if releaseDate == nil || releaseDate.Precision == ReleaseDatePrecisionUnknown {
+		return &NotionReleaseDate{NotionDate: nil}
+	}
+
+	notionDate := &NotionDate{
+		Start: releaseDate.Start.Format(time.DateOnly),
+	}
+	if releaseDate.Precision != ReleaseDatePrecisionDay {
+		notionDate.End = releaseDate.End.Format(time.DateOnly)
+	}
+
+	return &NotionReleaseDate{NotionDate: notionDate}
+}
+
+// Check whether the release date is set to a day
+//
+// [FYI]
+// false is returned if the release date is not set (e.g. "Coming soon") or it is a range (e.g. "Q3 2025")
+func (d *NotionReleaseDate) IsDayPrecision() bool {
+	return d != nil && d.NotionDate != nil && d.NotionDate.Start != "" && d.NotionDate.End == ""
+}
+
+// A rich text property of NotionProperties
+type NotionRichText struct {
+	RichText []*NotionContent `json:"rich_text"`
+}
+
+// Generate a new NotionRichText from a plain text
+func NewNotionRichText(text string) *NotionRichText {
+	if text == "" {
+		return &NotionRichText{RichText: []*NotionContent{}}
+	}
+
+	return &NotionRichText{
+		RichText: []*NotionContent{
+			{
+				NotionText: &NotionText{
+					NotionContent: text,
+				},
+			},
+		},
+	}
+}
+
+// A priority of NotionProperties
+//
+// [FYI]
+// The priority is the rank of a video game on the Steam wishlist, and 0 means that it has not been ranked yet
+type NotionPriority struct {
+	Number uint32 `json:"number"`
+}
+
+// A date when a video game was added to the Steam wishlist
+type NotionDateAdded struct {
+	NotionDate *NotionDate `json:"date"`
+}
+
+// Generate a new NotionDateAdded from a Unix timestamp in seconds
+//
+// [FYI]
+// The date is set to nil if the timestamp is not available
+func NewNotionDateAdded(unix int64) *NotionDateAdded {
+	if unix <= 0 {
+		return &NotionDateAdded{NotionDate: nil}
+	}
+
+	return &NotionDateAdded{
+		NotionDate: &NotionDate{
+			Start: time.Unix(unix, 0).UTC().Format(time.RFC3339),
+		},
+	}
+}
+
+// A date when a purchase of a video game was detected
+type NotionPurchaseDate struct {
+	NotionDate *NotionDate `json:"date"`
+}
+
+// Generate a new NotionPurchaseDate from time.Time
+func NewNotionPurchaseDate(t time.Time) *NotionPurchaseDate {
+	return &NotionPurchaseDate{
+		NotionDate: &NotionDate{
+			Start: t.UTC().Format(time.RFC3339),
+		},
+	}
+}
+
+// Convert the date when a purchase of a video game was detected to time.Time
+//
+// [FYI]
+// nil is returned if the date is not set.
+// A date without time (e.g. edited by hand on the Notion DB) is parsed as the start of the day in JST
+func (p *NotionPurchaseDate) ToTime(ctx context.Context) (*time.Time, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	return (&NotionNotifiedAt{NotionDate: p.NotionDate}).ToTime(ctx)
+}
+
+// A date when a video game was notified last time
+type NotionNotifiedAt struct {
+	NotionDate *NotionDate `json:"date"`
+}
+
+// Generate a new NotionNotifiedAt from time.Time
+//
+// [FYI]
+// The date is set to nil if the time is zero, which clears the property on the Notion DB
+func NewNotionNotifiedAt(t time.Time) *NotionNotifiedAt {
+	if t.IsZero() {
+		return &NotionNotifiedAt{NotionDate: nil}
+	}
+
+	return &NotionNotifiedAt{
+		NotionDate: &NotionDate{
+			Start: t.UTC().Format(time.RFC3339),
+		},
+	}
+}
+
+// Convert the date when a video game was notified last time to time.Time
+//
+// [FYI]
+// nil is returned if the date is not set.
+// A date without time (e.g. edited by hand on the Notion DB) is parsed as the start of the day in JST
+func (n *NotionNotifiedAt) ToTime(ctx context.Context) (*time.Time, error) {
+	if n == nil || n.NotionDate == nil || n.NotionDate.Start == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, n.NotionDate.Start); err == nil {
+		return &t, nil
+	}
+
+	return n.NotionDate.ToTime(ctx)
+}
+
+// A date until when notifications of a video game are snoozed
+type NotionSnoozeUntil struct {
+	NotionDate *NotionDate `json:"date"`
+}
+
+// Check whether notifications of a video game are snoozed at a time
+//
+// [FYI]
+// false is returned if the date is not set.
+// A date without time is snoozed through the end of the day in JST (e.g. "2025-01-02" until 2025-01-03T00:00:00+09:00),
+// and a date with time is snoozed until the time
+func (s *NotionSnoozeUntil) IsSnoozed(ctx context.Context, now time.Time) (bool, error) {
+	if s == nil || s.NotionDate == nil || s.NotionDate.Start == "" {
+		return false, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, s.NotionDate.Start); err == nil {
+		return now.Before(t), nil
+	}
+
+	t, err := s.NotionDate.ToTime(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return now.Before(t.AddDate(0, 0, 1)), nil
+}
+
+// A checkbox property of NotionProperties
+type NotionCheckbox struct {
+	Checkbox bool `json:"checkbox"`
+}
+
+// Check whether the checkbox is checked
+func (c *NotionCheckbox) IsChecked() bool {
+	return c != nil && c.Checkbox
+}
+
+// A multi-select property of NotionProperties
+type NotionMultiSelect struct {
+	MultiSelect []*NotionSelectOption `json:"multi_select"`
+}
+
+// Generate a new NotionMultiSelect from names of options
+func NewNotionMultiSelect(names []string) *NotionMultiSelect {
+	options := make([]*NotionSelectOption, 0, len(names))
+	for _, v := range names {
+		options = append(options, &NotionSelectOption{Name: v})
+	}
+
+	return &NotionMultiSelect{MultiSelect: options}
+}
+
+// A select property of NotionProperties
+type NotionSelect struct {
+	Select *NotionSelectOption `json:"select"`
+}
+
+// Generate a new NotionSelect of the currency of Money
+//
+// [FYI]
+// nil is returned if the price is not available, which keeps the property as it is on the Notion DB
+func NewNotionCurrency(m *Money) *NotionSelect {
+	if m == nil {
+		return nil
+	}
+
+	return &NotionSelect{Select: &NotionSelectOption{Name: string(m.Currency)}}
+}
+
+// Get the name of the selected option, or an empty string if nothing is selected
+func (s *NotionSelect) String() string {
+	if s == nil || s.Select == nil {
+		return ""
+	}
+
+	return s.Select.Name
+}
+
+// An option of NotionMultiSelect and NotionSelect
+type NotionSelectOption struct {
+	Name string `json:"name"`
+}
+
 // A date of NotionReleaseDate
+//
+// [FYI]
+// The end date is set only if the date is a range
 type NotionDate struct {
 	Start string `json:"start"`
+	End   string `json:"end,omitempty"`
 }
 
 // Convert date string to time.Time (JST)
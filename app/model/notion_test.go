@@ -2,7 +2,9 @@ package model
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 )
 
 func TestNotionToTime(t *testing.T) {
@@ -53,3 +55,267 @@ func TestNotionToTime(t *testing.T) {
 		})
 	}
 }
+
+func TestNotionPriceToMoney(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Positive case: Successfully convert a price into Money", func(t *testing.T) {
+		t.Parallel()
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		price := NewNotionPrice(&Money{Currency: "AUD", Amount: 1999})
+		got, err := price.ToMoney(ctx, "AUD")
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := Money{Currency: "AUD", Amount: 1999}
+		if *got != want {
+			t.Errorf("\ngot: %v\nwant: %v", *got, want)
+		}
+	})
+
+	t.Run("Positive case: The price to be tested is not filled in", func(t *testing.T) {
+		t.Parallel()
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		price := NewNotionPrice(nil)
+		got, err := price.ToMoney(ctx, "JPY")
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if got != nil {
+			t.Errorf("\ngot: %v\nwant: %v", got, nil)
+		}
+	})
+
+	t.Run("Negative case: The price to be tested is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		number := json.Number("-1")
+		price := &NotionPrice{Number: &number}
+		if _, err := price.ToMoney(ctx, "JPY"); err == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated in money.go", nil)
+		}
+	})
+}
+
+func TestNewNotionDateAdded(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Positive case: Convert a Unix timestamp successfully", func(t *testing.T) {
+		t.Parallel()
+
+		// Execute the function to be tested
+		got := NewNotionDateAdded(1714468758)
+		want := "2024-04-30T09:19:18Z"
+		if got.NotionDate == nil || got.NotionDate.Start != want {
+			t.Errorf("\ngot: %v\nwant: %v", got.NotionDate, want)
+		}
+	})
+
+	t.Run("Positive case: The Unix timestamp is not available", func(t *testing.T) {
+		t.Parallel()
+
+		// Execute the function to be tested
+		got := NewNotionDateAdded(0)
+		if got.NotionDate != nil {
+			t.Errorf("\ngot: %v\nwant: %v", got.NotionDate, nil)
+		}
+	})
+}
+
+func TestNotionPropertiesIsPricedIn(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		properties *NotionProperties
+		want       bool
+	}{
+		"Positive case: The prices are stored in the same currency": {
+			properties: &NotionProperties{Currency: NewNotionCurrency(&Money{Currency: "JPY"})},
+			want:       true,
+		},
+		"Positive case: The prices are stored in another currency": {
+			properties: &NotionProperties{Currency: NewNotionCurrency(&Money{Currency: "AUD"})},
+			want:       false,
+		},
+		"Positive case: The currency has not been recorded yet": {
+			properties: &NotionProperties{},
+			want:       true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			if got := tc.properties.IsPricedIn("JPY"); got != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNotionReleaseDateIsDayPrecision(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		releaseDate *NotionReleaseDate
+		want        bool
+	}{
+		"Positive case: The release date is set to a day": {
+			releaseDate: &NotionReleaseDate{NotionDate: &NotionDate{Start: "2025-01-02"}},
+			want:        true,
+		},
+		"Positive case: The release date is a range": {
+			releaseDate: &NotionReleaseDate{NotionDate: &NotionDate{Start: "2025-07-01", End: "2025-09-30"}},
+			want:        false,
+		},
+		"Positive case: The release date is not set": {
+			releaseDate: &NotionReleaseDate{},
+			want:        false,
+		},
+		"Positive case: The release date property is not filled": {
+			releaseDate: nil,
+			want:        false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			if got := tc.releaseDate.IsDayPrecision(); got != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNotionNotifiedAtToTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		notifiedAt *NotionNotifiedAt
+		want       string
+	}{
+		"Positive case: Convert a date with time successfully": {
+			notifiedAt: NewNotionNotifiedAt(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
+			want:       "2025-01-02 03:04:05 +0000 UTC",
+		},
+		"Positive case: Convert a date with time in the format of the Notion API successfully": {
+			notifiedAt: &NotionNotifiedAt{
+				NotionDate: &NotionDate{
+					Start: "2025-01-02T12:04:00.000+09:00",
+				},
+			},
+			want: "2025-01-02 03:04:00 +0000 UTC",
+		},
+		"Positive case: Convert a date without time successfully": {
+			notifiedAt: &NotionNotifiedAt{
+				NotionDate: &NotionDate{
+					Start: "2025-01-02",
+				},
+			},
+			want: "2025-01-01 15:00:00 +0000 UTC",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			ctx := t.Context()
+			got, err := tc.notifiedAt.ToTime(ctx)
+			if err != nil {
+				t.Errorf("\ngot: %v\nwant: %v", err, nil)
+			}
+			if got == nil || got.UTC().String() != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("Positive case: The date is not set", func(t *testing.T) {
+		t.Parallel()
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		got, err := NewNotionNotifiedAt(time.Time{}).ToTime(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if got != nil {
+			t.Errorf("\ngot: %v\nwant: %v", got, nil)
+		}
+	})
+}
+
+func TestNotionSnoozeUntilIsSnoozed(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	testCases := map[string]struct {
+		snoozeUntil *NotionSnoozeUntil
+		want        bool
+	}{
+		"Positive case: A video game is snoozed until a time in the future": {
+			snoozeUntil: &NotionSnoozeUntil{NotionDate: &NotionDate{Start: "2025-01-02T12:05:00.000+09:00"}},
+			want:        true,
+		},
+		"Positive case: A video game is not snoozed after the time": {
+			snoozeUntil: &NotionSnoozeUntil{NotionDate: &NotionDate{Start: "2025-01-02T12:04:05.000+09:00"}},
+			want:        false,
+		},
+		"Positive case: A video game is snoozed through the end of the day": {
+			snoozeUntil: &NotionSnoozeUntil{NotionDate: &NotionDate{Start: "2025-01-02"}},
+			want:        true,
+		},
+		"Positive case: A video game is not snoozed after the day": {
+			snoozeUntil: &NotionSnoozeUntil{NotionDate: &NotionDate{Start: "2025-01-01"}},
+			want:        false,
+		},
+		"Positive case: The date is not set": {
+			snoozeUntil: &NotionSnoozeUntil{NotionDate: nil},
+			want:        false,
+		},
+		"Positive case: The property is not set": {
+			snoozeUntil: nil,
+			want:        false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			ctx := t.Context()
+			got, err := tc.snoozeUntil.IsSnoozed(ctx, now)
+			if err != nil {
+				t.Errorf("\ngot: %v\nwant: %v", err, nil)
+			}
+			if got != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("Negative case: The date is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		// Execute the method to be tested
+		ctx := t.Context()
+		snoozeUntil := &NotionSnoozeUntil{NotionDate: &NotionDate{Start: "2025-13-32"}}
+		if _, err := snoozeUntil.IsSnoozed(ctx, now); err == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated by the library", nil)
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// An observation of a price of a video game on the Steam Store
+//
+// [FYI]
+// The observation is recorded every run to build the price history of a video game.
+// The final price is the price after a discount, and the regular price is the price before it
+type PriceObservation struct {
+	AppID           SteamAppID
+	ObservedAt      time.Time
+	FinalPrice      Money
+	RegularPrice    Money
+	DiscountPercent uint32
+}
+
+// Generate a new PriceObservation from a current price on the Steam Store
+//
+// [FYI]
+// nil is returned if the video game has no price (e.g. free-to-play or unreleased video games)
+func NewPriceObservation(
+	ctx context.Context,
+	appID SteamAppID,
+	observedAt time.Time,
+	currentPrice *SteamCurrentPrice,
+) (*PriceObservation, error) {
+	if currentPrice == nil {
+		return nil, nil
+	}
+
+	finalPrice, err := currentPrice.ToMoney(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to convert the final price to Money", slog.Any("error", err))
+		return nil, err
+	}
+
+	regularPrice, err := currentPrice.RegularPriceToMoney(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to convert the regular price to Money", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &PriceObservation{
+		AppID:           appID,
+		ObservedAt:      observedAt,
+		FinalPrice:      *finalPrice,
+		RegularPrice:    *regularPrice,
+		DiscountPercent: currentPrice.DiscountPercent,
+	}, nil
+}
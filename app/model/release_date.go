@@ -0,0 +1,135 @@
+package model
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A precision of a release date
+type ReleaseDatePrecision string
+
+const (
+	ReleaseDatePrecisionDay     ReleaseDatePrecision = "day"
+	ReleaseDatePrecisionMonth   ReleaseDatePrecision = "month"
+	ReleaseDatePrecisionQuarter ReleaseDatePrecision = "quarter"
+	ReleaseDatePrecisionYear    ReleaseDatePrecision = "year"
+	ReleaseDatePrecisionUnknown ReleaseDatePrecision = "unknown"
+)
+
+var (
+	// Layouts of release dates with the precision of a day
+	// e.g. "14 Nov, 2024", "Nov 14, 2024", "2024年11月14日"
+	releaseDateDayLayouts = []string{
+		"2 Jan, 2006",
+		"2 Jan 2006",
+		"Jan 2, 2006",
+		"2 January, 2006",
+		"2 January 2006",
+		"January 2, 2006",
+		"2006年1月2日",
+	}
+
+	// Layouts of release dates with the precision of a month
+	// e.g. "Nov 2025", "November 2025", "2025年11月"
+	releaseDateMonthLayouts = []string{
+		"Jan 2006",
+		"Jan, 2006",
+		"January 2006",
+		"January, 2006",
+		"2006年1月",
+	}
+
+	// Layouts of release dates with the precision of a year
+	// e.g. "2025", "2025年"
+	releaseDateYearLayouts = []string{
+		"2006",
+		"2006年",
+	}
+
+	// Patterns of release dates with the precision of a quarter
+	// e.g. "Q3 2025", "2025 Q3", "2025年第3四半期"
+	releaseDateQuarterPatterns = []struct {
+		regexp       *regexp.Regexp
+		yearIndex    int
+		quarterIndex int
+	}{
+		{regexp: regexp.MustCompile(`^Q([1-4])\s*(\d{4})$`), yearIndex: 2, quarterIndex: 1},
+		{regexp: regexp.MustCompile(`^(\d{4})\s*Q([1-4])$`), yearIndex: 1, quarterIndex: 2},
+		{regexp: regexp.MustCompile(`^(\d{4})年\s*第([1-4])四半期$`), yearIndex: 1, quarterIndex: 2},
+	}
+)
+
+// A release date parsed with its precision
+//
+// [FYI]
+// Start and End are the first and the last day of the period that the release date represents.
+// They are the same day if the precision is a day, and they are zero if the precision is unknown.
+// Text keeps the original text so that unreleased video games keep useful information
+// e.g. "Coming soon", "To be announced"
+type ReleaseDate struct {
+	Start     time.Time
+	End       time.Time
+	Precision ReleaseDatePrecision
+	Text      string
+}
+
+// Parse the release date with its precision
+//
+// [FYI]
+// The release date varies depending on the video game and the locale
+// e.g. "1 Nov, 2024", "Nov 2025", "Q3 2025", "2025", "Coming soon", and "2024年11月1日"
+func (d *SteamReleaseDate) Parse() *ReleaseDate {
+	text := strings.TrimSpace(d.Date)
+	releaseDate := &ReleaseDate{
+		Precision: ReleaseDatePrecisionUnknown,
+		Text:      text,
+	}
+
+	if t, ok := parseInLayouts(releaseDateDayLayouts, text); ok {
+		releaseDate.Start, releaseDate.End = t, t
+		releaseDate.Precision = ReleaseDatePrecisionDay
+		return releaseDate
+	}
+
+	if t, ok := parseInLayouts(releaseDateMonthLayouts, text); ok {
+		releaseDate.Start, releaseDate.End = t, t.AddDate(0, 1, -1)
+		releaseDate.Precision = ReleaseDatePrecisionMonth
+		return releaseDate
+	}
+
+	for _, v := range releaseDateQuarterPatterns {
+		matches := v.regexp.FindStringSubmatch(text)
+		if matches == nil {
+			continue
+		}
+
+		// The matches consist of digits only, so they are always converted successfully
+		year, _ := strconv.Atoi(matches[v.yearIndex])
+		quarter, _ := strconv.Atoi(matches[v.quarterIndex])
+		start := time.Date(year, time.Month((quarter-1)*3+1), 1, 0, 0, 0, 0, time.UTC)
+		releaseDate.Start, releaseDate.End = start, start.AddDate(0, 3, -1)
+		releaseDate.Precision = ReleaseDatePrecisionQuarter
+		return releaseDate
+	}
+
+	if t, ok := parseInLayouts(releaseDateYearLayouts, text); ok {
+		releaseDate.Start, releaseDate.End = t, t.AddDate(1, 0, -1)
+		releaseDate.Precision = ReleaseDatePrecisionYear
+		return releaseDate
+	}
+
+	return releaseDate
+}
+
+// Parse a text in the first matching layout
+func parseInLayouts(layouts []string, text string) (time.Time, bool) {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, text); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSteamReleaseDateParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		date          string
+		wantStart     string
+		wantEnd       string
+		wantPrecision ReleaseDatePrecision
+	}{
+		"Positive case: Parse a release date with the precision of a day": {
+			date:          "14 Nov, 2024",
+			wantStart:     "2024-11-14",
+			wantEnd:       "2024-11-14",
+			wantPrecision: ReleaseDatePrecisionDay,
+		},
+		"Positive case: Parse a release date in the US format": {
+			date:          "Nov 14, 2024",
+			wantStart:     "2024-11-14",
+			wantEnd:       "2024-11-14",
+			wantPrecision: ReleaseDatePrecisionDay,
+		},
+		"Positive case: Parse a release date in the Japanese format": {
+			date:          "2024年11月14日",
+			wantStart:     "2024-11-14",
+			wantEnd:       "2024-11-14",
+			wantPrecision: ReleaseDatePrecisionDay,
+		},
+		"Positive case: Parse a release date with the precision of a month": {
+			date:          "Nov 2025",
+			wantStart:     "2025-11-01",
+			wantEnd:       "2025-11-30",
+			wantPrecision: ReleaseDatePrecisionMonth,
+		},
+		"Positive case: Parse a release date with the precision of a month in the Japanese format": {
+			date:          "2025年2月",
+			wantStart:     "2025-02-01",
+			wantEnd:       "2025-02-28",
+			wantPrecision: ReleaseDatePrecisionMonth,
+		},
+		"Positive case: Parse a release date with the precision of a quarter": {
+			date:          "Q3 2025",
+			wantStart:     "2025-07-01",
+			wantEnd:       "2025-09-30",
+			wantPrecision: ReleaseDatePrecisionQuarter,
+		},
+		"Positive case: Parse a release date with the precision of a quarter in the Japanese format": {
+			date:          "2025年第4四半期",
+			wantStart:     "2025-10-01",
+			wantEnd:       "2025-12-31",
+			wantPrecision: ReleaseDatePrecisionQuarter,
+		},
+		"Positive case: Parse a release date with the precision of a year": {
+			date:          "2025",
+			wantStart:     "2025-01-01",
+			wantEnd:       "2025-12-31",
+			wantPrecision: ReleaseDatePrecisionYear,
+		},
+		"Positive case: Parse a release date which is not decided yet": {
+			date:          "Coming soon",
+			wantPrecision: ReleaseDatePrecisionUnknown,
+		},
+		"Positive case: Parse an empty release date": {
+			date:          "",
+			wantPrecision: ReleaseDatePrecisionUnknown,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			releaseDate := SteamReleaseDate{
+				Date: tc.date,
+			}
+			got := releaseDate.Parse()
+
+			// Format the start and end dates to compare them easily
+			gotStart, gotEnd := "", ""
+			if !got.Start.IsZero() {
+				gotStart, gotEnd = got.Start.Format("2006-01-02"), got.End.Format("2006-01-02")
+			}
+			if diff := cmp.Diff(
+				[]string{gotStart, gotEnd, string(got.Precision), got.Text},
+				[]string{tc.wantStart, tc.wantEnd, string(tc.wantPrecision), tc.date},
+			); diff != "" {
+				t.Errorf("got(-) want(+)\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package model
+
+// Types of Slack Block Kit blocks and elements
+const (
+	SlackBlockTypeHeader  string = "header"
+	SlackBlockTypeSection string = "section"
+	SlackBlockTypeContext string = "context"
+	SlackTextTypePlain    string = "plain_text"
+	SlackTextTypeMarkdown string = "mrkdwn"
+	SlackElementButton    string = "button"
+)
+
+// A body of a Slack message sent to an incoming webhook
+//
+// [FYI]
+// The text is shown in notifications and as a fallback, and the blocks are shown in the message
+// ref. https://api.slack.com/reference/block-kit/blocks
+type SlackMessageBody struct {
+	Text   string        `json:"text"`
+	Blocks []*SlackBlock `json:"blocks,omitempty"`
+}
+
+// A block of a Slack message
+//
+// [FYI]
+// The accessory is a button shown on the right side of a section block,
+// and the elements are texts of a context block
+type SlackBlock struct {
+	Type      string       `json:"type"`
+	Text      *SlackText   `json:"text,omitempty"`
+	Accessory *SlackButton `json:"accessory,omitempty"`
+	Elements  []*SlackText `json:"elements,omitempty"`
+}
+
+// A text object of a Slack message
+type SlackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// A button element which opens a URL
+type SlackButton struct {
+	Type string     `json:"type"`
+	Text *SlackText `json:"text"`
+	URL  string     `json:"url"`
+}
@@ -1,12 +1,112 @@
 package model
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
-	"time"
+	"regexp"
+	"strconv"
 )
 
+var (
+	// An error returned if a video game is not available in the region of the Steam Store
+	ErrSteamVideoGameUnavailableInRegion = errors.New("video game unavailable in the region")
+
+	// An error returned if a video game is delisted from the Steam Store
+	ErrSteamVideoGameDelisted = errors.New("video game delisted from the Steam Store")
+
+	// An error returned if a response of the Steam Store is malformed
+	ErrSteamMalformedResponse = errors.New("malformed Steam Store response")
+
+	// An error returned if it fails to be checked why a video game is not available on the Steam Store
+	ErrSteamVideoGameAvailabilityUnknown = errors.New("availability of a video game unknown")
+)
+
+// Check whether an error means that a video game cannot be retrieved from the Steam Store
+//
+// [FYI]
+// Such a video game should be skipped and reported instead of stopping the whole process
+func IsSteamVideoGameUnavailable(err error) bool {
+	return errors.Is(err, ErrSteamVideoGameUnavailableInRegion) ||
+		errors.Is(err, ErrSteamVideoGameDelisted) ||
+		errors.Is(err, ErrSteamMalformedResponse) ||
+		errors.Is(err, ErrSteamVideoGameAvailabilityUnknown)
+}
+
+// The smallest SteamID64 of an individual account
+//
+// [FYI]
+// A SteamID64 of an individual account consists of 17 digits starting with "7656119"
+// ref. https://developer.valvesoftware.com/wiki/SteamID
+const steamID64IndividualBase uint64 = 76561197960265728
+
+// A pattern of vanity names of Steam Community profiles
+var steamVanityNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{2,32}$`)
+
+// Check whether a Steam user ID is a valid SteamID64 of an individual account
+func IsSteamID64(steamUserID string) bool {
+	if len(steamUserID) != 17 {
+		return false
+	}
+
+	id, err := strconv.ParseUint(steamUserID, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	return id >= steamID64IndividualBase
+}
+
+// Check whether a Steam user ID is a valid vanity name of a Steam Community profile
+func IsSteamVanityName(steamUserID string) bool {
+	return steamVanityNamePattern.MatchString(steamUserID)
+}
+
+// A response of ISteamUser/ResolveVanityURL of the Steam Web API
+type SteamResolveVanityURLResponse struct {
+	Response *SteamResolveVanityURLResult `json:"response"`
+}
+
+// A result of SteamResolveVanityURLResponse
+//
+// [FYI]
+// The success is 1 if the vanity name is resolved, and 42 if no profile matches it
+type SteamResolveVanityURLResult struct {
+	SteamID string `json:"steamid"`
+	Success int    `json:"success"`
+	Message string `json:"message"`
+}
+
+// A Steam Community profile in the XML format
+//
+// [FYI]
+// The error is set instead of the SteamID64 if no profile matches the vanity name
+type SteamCommunityProfile struct {
+	SteamID64 string `xml:"steamID64"`
+	Error     string `xml:"error"`
+}
+
+// Owned games of a Steam account
+type SteamOwnedGames struct {
+	Response *SteamOwnedGamesResponse `json:"response"`
+}
+
+// A response of SteamOwnedGames
+//
+// [FYI]
+// The games are empty if the game details of the Steam Community profile are private
+type SteamOwnedGamesResponse struct {
+	GameCount uint32            `json:"game_count"`
+	Games     []*SteamOwnedGame `json:"games"`
+}
+
+// A game of SteamOwnedGamesResponse
+type SteamOwnedGame struct {
+	AppID uint64 `json:"appid"`
+}
+
 // A wishlist on Steam
 type SteamStoreWishlist struct {
 	Response *SteamStoreResponse `json:"response"`
@@ -20,9 +120,47 @@ type SteamStoreResponse struct {
 // An app ID of Steam Store
 type SteamAppID uint64
 
+// The URL of store pages of video games on the Steam Store
+const steamStoreAppURL string = "https://store.steampowered.com/app/"
+
+// Get the URL of the store page of a video game on the Steam Store
+func (id SteamAppID) StoreURL() string {
+	return steamStoreAppURL + strconv.FormatUint(uint64(id), 10) + "/"
+}
+
 // An Item of SteamResponse
+//
+// [FYI]
+// The priority is the rank of a video game on the wishlist (1 is the highest),
+// and it is 0 if the video game has not been ranked yet.
+// The date added is a Unix timestamp in seconds.
 type SteamStoreItem struct {
-	AppID uint64 `json:"appid"`
+	AppID     uint64 `json:"appid"`
+	Priority  uint32 `json:"priority"`
+	DateAdded int64  `json:"date_added"`
+}
+
+// An item of wishlists merged across Steam accounts
+//
+// [FYI]
+// The priority is the highest rank among the accounts, and 0 means that no account has ranked the video game yet.
+// The date added is the earliest Unix timestamp in seconds among the accounts.
+type SteamWishlistItem struct {
+	AppID     SteamAppID
+	Priority  uint32
+	DateAdded int64
+	WantedBy  []string
+}
+
+// Merge an item of a wishlist of a Steam account into SteamWishlistItem
+func (i *SteamWishlistItem) Merge(steamUserID string, item *SteamStoreItem) {
+	if item.Priority != 0 && (i.Priority == 0 || item.Priority < i.Priority) {
+		i.Priority = item.Priority
+	}
+	if item.DateAdded > 0 && (i.DateAdded <= 0 || item.DateAdded < i.DateAdded) {
+		i.DateAdded = item.DateAdded
+	}
+	i.WantedBy = append(i.WantedBy, steamUserID)
 }
 
 // A video game details on Steam
@@ -34,26 +172,136 @@ type SteamStoreVideoGameDetails struct {
 }
 
 // A current price of SteamStoreVideoGameDetails
+//
+// [FYI]
+// Initial is the regular price before a discount, and DiscountPercent is 0 if the video game is not on sale
 type SteamCurrentPrice struct {
-	Number json.Number
+	Currency        CurrencyCode
+	Number          json.Number
+	Initial         json.Number
+	DiscountPercent uint32
 }
 
-// Convert the current price format
+// Convert the current price into Money
 //
 // [FYI]
-// Retrieved price contains decimal places
-// e.g. 100000 -> 1000 (JPY)
-func (p *SteamCurrentPrice) ConvertPriceFormat(ctx context.Context) (*uint64, error) {
-	currentPrice, err := p.Number.Int64()
+// Retrieved price always contains two decimal places regardless of the currency
+// e.g. {JPY, 100000} -> 1000 (JPY), {AUD, 1999} -> 19.99 (AUD)
+func (p *SteamCurrentPrice) ToMoney(ctx context.Context) (*Money, error) {
+	return p.convertToMoney(ctx, p.Number)
+}
+
+// Convert the regular price into Money
+//
+// [FYI]
+// The current price is used as the regular price if the regular price is not available
+func (p *SteamCurrentPrice) RegularPriceToMoney(ctx context.Context) (*Money, error) {
+	if p.Initial == "" {
+		return p.convertToMoney(ctx, p.Number)
+	}
+
+	return p.convertToMoney(ctx, p.Initial)
+}
+
+// Convert a price retrieved from the Steam Store into Money
+func (p *SteamCurrentPrice) convertToMoney(ctx context.Context, number json.Number) (*Money, error) {
+	price, err := number.Int64()
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to convert the current price to int64", slog.Any("error", err))
+		slog.ErrorContext(ctx, "failed to convert the price to int64", slog.Any("error", err))
 		return nil, err
 	}
 
-	// Remove the last two digits
-	convertedPrice := uint64(currentPrice) / 100
+	// Remove the digits that the currency does not have as its minor units
+	convertedPrice := uint64(price)
+	for range 2 - p.Currency.Exponent() {
+		convertedPrice /= 10
+	}
+
+	return &Money{
+		Currency: p.Currency,
+		Amount:   convertedPrice,
+	}, nil
+}
+
+// A response of the Steam Store app details API
+//
+// [FYI]
+// The response is keyed by app IDs in string format
+type SteamStoreVideoGameDetailsResponse map[string]*SteamStoreVideoGameDetailsResult
+
+// A result of SteamStoreVideoGameDetailsResponse
+//
+// [FYI]
+// "success" is false if a video game is delisted or not available in the region
+type SteamStoreVideoGameDetailsResult struct {
+	Success bool                            `json:"success"`
+	Data    *SteamStoreVideoGameDetailsData `json:"data"`
+}
+
+// Data of SteamStoreVideoGameDetailsResult
+type SteamStoreVideoGameDetailsData struct {
+	Name          string                   `json:"name"`
+	PriceOverview *SteamStorePriceOverview `json:"price_overview"`
+	ReleaseDate   *SteamStoreReleaseDate   `json:"release_date"`
+}
+
+// A release date of SteamStoreVideoGameDetailsData
+type SteamStoreReleaseDate struct {
+	ComingSoon bool   `json:"coming_soon"`
+	Date       string `json:"date"`
+}
+
+// A response of the Steam Store app details API filtered by price overviews
+//
+// [FYI]
+// The response is keyed by app IDs in string format
+type SteamStorePriceOverviewResponse map[string]*SteamStorePriceOverviewResult
+
+// A result of SteamStorePriceOverviewResponse
+type SteamStorePriceOverviewResult struct {
+	Success bool                         `json:"success"`
+	Data    *SteamStorePriceOverviewData `json:"data"`
+}
+
+// Data of SteamStorePriceOverviewResult
+type SteamStorePriceOverviewData struct {
+	PriceOverview *SteamStorePriceOverview `json:"price_overview"`
+}
+
+// Unmarshal data of SteamStorePriceOverviewResult
+//
+// [FYI]
+// The Steam Store returns an empty array instead of an object if the price is not available
+// e.g. free-to-play games, games that are not sold yet, etc.
+func (d *SteamStorePriceOverviewData) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("[]")) {
+		return nil
+	}
+
+	type alias SteamStorePriceOverviewData
+	return json.Unmarshal(b, (*alias)(d))
+}
 
-	return &convertedPrice, nil
+// A price overview of SteamStorePriceOverviewData
+type SteamStorePriceOverview struct {
+	Currency        CurrencyCode `json:"currency"`
+	Initial         json.Number  `json:"initial"`
+	Final           json.Number  `json:"final"`
+	DiscountPercent uint32       `json:"discount_percent"`
+}
+
+// Convert the price overview into the current price of a video game
+func (o *SteamStorePriceOverview) ToSteamCurrentPrice() *SteamCurrentPrice {
+	if o == nil {
+		return nil
+	}
+
+	return &SteamCurrentPrice{
+		Currency:        o.Currency,
+		Number:          o.Final,
+		Initial:         o.Initial,
+		DiscountPercent: o.DiscountPercent,
+	}
 }
 
 // A release date of SteamStoreVideoGameDetails
@@ -61,18 +309,37 @@ type SteamReleaseDate struct {
 	Date string
 }
 
-// Convert date string into time.Time (JST)
-func (d *SteamReleaseDate) ToTime(ctx context.Context) (*time.Time, error) {
-	loc, err := time.LoadLocation("Asia/Tokyo")
-	if err != nil {
-		slog.ErrorContext(ctx, "failed to load location", slog.Any("error", err))
-		return nil, err
-	}
-	parsedTime, err := time.ParseInLocation("2 Jan, 2006", d.Date, loc)
-	if err != nil {
-		slog.WarnContext(ctx, "failed to parse string to time.Time", slog.Any("error", err))
-		return nil, err
+// A response of the Steam Store app reviews API
+//
+// [FYI]
+// "success" is 1 if the reviews are retrieved
+type SteamStoreReviewsResponse struct {
+	Success      int                      `json:"success"`
+	QuerySummary *SteamStoreReviewSummary `json:"query_summary"`
+}
+
+// A summary of reviews of a video game on the Steam Store
+//
+// [FYI]
+// The review score is a class of reviews from 0 to 9 (e.g. 8 for "Very Positive"),
+// and the review score description is its text shown on the Steam Store
+type SteamStoreReviewSummary struct {
+	ReviewScore     uint32 `json:"review_score"`
+	ReviewScoreDesc string `json:"review_score_desc"`
+	TotalPositive   uint64 `json:"total_positive"`
+	TotalNegative   uint64 `json:"total_negative"`
+	TotalReviews    uint64 `json:"total_reviews"`
+}
+
+// Get the percentage of positive reviews
+//
+// [FYI]
+// false is returned if the video game has no reviews
+// e.g. {TotalPositive: 87, TotalReviews: 100} -> 87
+func (s *SteamStoreReviewSummary) PositivePercent() (float64, bool) {
+	if s == nil || s.TotalReviews == 0 {
+		return 0, false
 	}
 
-	return &parsedTime, nil
+	return float64(s.TotalPositive) * 100 / float64(s.TotalReviews), true
 }
@@ -1,86 +1,158 @@
 package model
 
 import (
-	"context"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
-func TestToUint64(t *testing.T) {
+func TestSteamToMoney(t *testing.T) {
 	t.Parallel()
 
-	t.Run("Positive case: Successfully convert json.Number into uint64", func(t *testing.T) {
-		t.Parallel()
+	positiveTestCases := map[string]struct {
+		currentPrice SteamCurrentPrice
+		want         Money
+	}{
+		"Positive case: Successfully convert a price in JPY into Money": {
+			currentPrice: SteamCurrentPrice{Currency: "JPY", Number: "100000"},
+			want:         Money{Currency: "JPY", Amount: 1000},
+		},
+		"Positive case: Successfully convert a price in AUD into Money": {
+			currentPrice: SteamCurrentPrice{Currency: "AUD", Number: "1999"},
+			want:         Money{Currency: "AUD", Amount: 1999},
+		},
+	}
 
-		// Execute the method to be tested
-		ctx := t.Context()
-		currentPrice := SteamCurrentPrice{Number: "100000"}
-		got, err := currentPrice.ConvertPriceFormat(ctx)
-		if err != nil {
-			t.Errorf("\ngot: %v\nwant: %v", err, nil)
-		}
-		want := uint64(1000)
-		if *got != want {
-			t.Errorf("\ngot: %v\nwant: %v", *got, want)
-		}
-	})
+	for name, tc := range positiveTestCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			ctx := t.Context()
+			got, err := tc.currentPrice.ToMoney(ctx)
+			if err != nil {
+				t.Errorf("\ngot: %v\nwant: %v", err, nil)
+			}
+			if *got != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", *got, tc.want)
+			}
+		})
+	}
 
 	t.Run("Negative case: Failed to convert json.Number into int64", func(t *testing.T) {
 		t.Parallel()
 
 		// Execute the method to be tested
 		ctx := t.Context()
-		currentPrice := SteamCurrentPrice{Number: "9223372036854775808"}
-		if _, err := currentPrice.ConvertPriceFormat(ctx); err == nil {
+		currentPrice := SteamCurrentPrice{Currency: "JPY", Number: "9223372036854775808"}
+		if _, err := currentPrice.ToMoney(ctx); err == nil {
 			t.Errorf("\ngot: %v\nwant: an error generated by the library", nil)
 		}
 	})
 }
 
-func TestToTime(t *testing.T) {
+func TestSteamRegularPriceToMoney(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentPrice SteamCurrentPrice
+		want         Money
+	}{
+		"Positive case: Successfully convert a regular price into Money": {
+			currentPrice: SteamCurrentPrice{Currency: "JPY", Number: "25000", Initial: "100000", DiscountPercent: 75},
+			want:         Money{Currency: "JPY", Amount: 1000},
+		},
+		"Positive case: The current price is used if the regular price is not available": {
+			currentPrice: SteamCurrentPrice{Currency: "AUD", Number: "1999"},
+			want:         Money{Currency: "AUD", Amount: 1999},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			ctx := t.Context()
+			got, err := tc.currentPrice.RegularPriceToMoney(ctx)
+			if err != nil {
+				t.Errorf("\ngot: %v\nwant: %v", err, nil)
+			}
+			if *got != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", *got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSteamWishlistItemMerge(t *testing.T) {
 	t.Parallel()
 
-	t.Run("Positive case: Successfully convert json.Number into time.Time", func(t *testing.T) {
+	t.Run("Positive case: Merge items of wishlists of multiple Steam accounts", func(t *testing.T) {
 		t.Parallel()
 
 		// Execute the method to be tested
-		ctx := t.Context()
-		releaseDate := SteamReleaseDate{
-			Date: "11 Nov, 2021",
-		}
-		got, err := releaseDate.ToTime(ctx)
-		if err != nil {
-			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		got := &SteamWishlistItem{AppID: 1}
+		got.Merge("dummy_steam_user_id_1", &SteamStoreItem{AppID: 1, Priority: 0, DateAdded: 1714468758})
+		got.Merge("dummy_steam_user_id_2", &SteamStoreItem{AppID: 1, Priority: 5, DateAdded: 1714468748})
+		got.Merge("dummy_steam_user_id_3", &SteamStoreItem{AppID: 1, Priority: 3, DateAdded: 0})
+		want := &SteamWishlistItem{
+			AppID:     1,
+			Priority:  3,
+			DateAdded: 1714468748,
+			WantedBy:  []string{"dummy_steam_user_id_1", "dummy_steam_user_id_2", "dummy_steam_user_id_3"},
 		}
-		want := "2021-11-11 00:00:00 +0900 JST"
-		if got.String() != want {
-			t.Errorf("\ngot: %v\nwant: %v", got, want)
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
 		}
 	})
+}
 
-	negativeTestCases := map[string]struct {
-		date string
+func TestSteamStoreReviewSummaryPositivePercent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		summary *SteamStoreReviewSummary
+		want    float64
+		wantOK  bool
 	}{
-		"Negative case: The date string to be tested is invalid": {
-			date: "2024-13-32",
+		"Positive case: Get the percentage of positive reviews": {
+			summary: &SteamStoreReviewSummary{TotalPositive: 87, TotalNegative: 13, TotalReviews: 100},
+			want:    87,
+			wantOK:  true,
+		},
+		"Positive case: A video game has no reviews": {
+			summary: &SteamStoreReviewSummary{},
+			wantOK:  false,
 		},
-		"Negative case: The date string to be tested is empty": {
-			date: "",
+		"Positive case: A summary is nil": {
+			summary: nil,
+			wantOK:  false,
 		},
 	}
 
-	for name, tc := range negativeTestCases {
+	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
 			// Execute the method to be tested
-			ctx, cancel := context.WithCancel(context.Background())
-			defer cancel()
-			releaseDate := SteamReleaseDate{
-				Date: tc.date,
+			got, gotOK := tc.summary.PositivePercent()
+			if gotOK != tc.wantOK {
+				t.Errorf("\ngot: %v\nwant: %v", gotOK, tc.wantOK)
 			}
-			if _, err := releaseDate.ToTime(ctx); err == nil {
-				t.Errorf("\ngot: %v\nwant: %v", err, nil)
+			if got != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", got, tc.want)
 			}
 		})
 	}
 }
+
+func TestSteamAppIDStoreURL(t *testing.T) {
+	t.Parallel()
+
+	// Execute the method to be tested
+	got := SteamAppID(1091500).StoreURL()
+	if want := "https://store.steampowered.com/app/1091500/"; got != want {
+		t.Errorf("\ngot: %v\nwant: %v", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+package model
+
+// A parse mode of Telegram messages
+const TelegramParseModeMarkdownV2 string = "MarkdownV2"
+
+// A body of a request to send a message with the Telegram Bot API
+//
+// [FYI]
+// The text is formatted with MarkdownV2, and link previews are disabled
+// so that a store page of a video game is not expanded under a list of deals
+// ref. https://core.telegram.org/bots/api#sendmessage
+type TelegramSendMessageBody struct {
+	ChatID             string                        `json:"chat_id"`
+	Text               string                        `json:"text"`
+	ParseMode          string                        `json:"parse_mode"`
+	LinkPreviewOptions *TelegramLinkPreviewOptions   `json:"link_preview_options,omitempty"`
+	ReplyMarkup        *TelegramInlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Options of a link preview of a Telegram message
+type TelegramLinkPreviewOptions struct {
+	IsDisabled bool `json:"is_disabled"`
+}
+
+// An inline keyboard shown under a Telegram message
+type TelegramInlineKeyboardMarkup struct {
+	InlineKeyboard [][]*TelegramInlineKeyboardButton `json:"inline_keyboard"`
+}
+
+// A button of an inline keyboard which opens a URL
+type TelegramInlineKeyboardButton struct {
+	Text string `json:"text"`
+	URL  string `json:"url"`
+}
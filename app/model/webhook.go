@@ -0,0 +1,132 @@
+package model
+
+import "time"
+
+// The version of the schema of webhook payloads
+//
+// [FYI]
+// The version is incremented only when a field is removed or its meaning is changed,
+// so receivers should ignore unknown fields which may be added in the same version
+const WebhookSchemaVersion uint32 = 1
+
+// Events of webhook payloads
+const (
+	// Deals of video games are notified
+	WebhookEventDeals string = "deals"
+	// An error occurred while the app was running
+	WebhookEventError string = "error"
+)
+
+// Deal classes of webhook payloads, which correspond to DealClass
+const (
+	WebhookDealClassNewLow      string = "new_low"
+	WebhookDealClassMatchesLow  string = "matches_low"
+	WebhookDealClassNearLow     string = "near_low"
+	WebhookDealClassOtherRules  string = "other_rules"
+	WebhookDealClassUnspecified string = "unspecified"
+)
+
+// A payload POSTed to a generic webhook
+//
+// [FYI]
+// The ID is unique to a notification, and it is the same as the Idempotency-Key header,
+// which does not change while the notification is retried.
+// The sections which are empty or not due (e.g. the basket and the digest) are omitted,
+// and the error is set only for the "error" event.
+// All prices are amounts in the minor units of their currencies, so a price is divided by
+// 10 to the power of the currency exponent to get the amount in the major units
+// e.g. {"currency": "AUD", "currency_exponent": 2, "current_price": 1999} -> A$19.99
+type WebhookPayload struct {
+	Version uint32                `json:"version"`
+	ID      string                `json:"id"`
+	Event   string                `json:"event"`
+	SentAt  time.Time             `json:"sent_at"`
+	Deals   []*WebhookDeal        `json:"deals,omitempty"`
+	Basket  *WebhookBasket        `json:"basket,omitempty"`
+	Digest  *WebhookDigest        `json:"digest,omitempty"`
+	Skipped []*WebhookSkippedGame `json:"skipped,omitempty"`
+	Error   *WebhookError         `json:"error,omitempty"`
+}
+
+// A deal of a video game in a webhook payload
+//
+// [FYI]
+// The lowest price is null if it is unknown, and the priority is 0 if the video game has not been ranked yet
+type WebhookDeal struct {
+	AppID            SteamAppID `json:"app_id"`
+	Title            string     `json:"title"`
+	StoreURL         string     `json:"store_url"`
+	Priority         uint32     `json:"priority"`
+	Currency         string     `json:"currency"`
+	CurrencyExponent uint8      `json:"currency_exponent"`
+	CurrentPrice     uint64     `json:"current_price"`
+	RegularPrice     uint64     `json:"regular_price"`
+	LowestPrice      *uint64    `json:"lowest_price"`
+	DiscountPercent  uint32     `json:"discount_percent"`
+	DealClass        string     `json:"deal_class"`
+	TriggeredRules   []string   `json:"triggered_rules"`
+}
+
+// A recommended basket of deals within the monthly budget in a webhook payload
+type WebhookBasket struct {
+	Currency         string               `json:"currency"`
+	CurrencyExponent uint8                `json:"currency_exponent"`
+	Budget           uint64               `json:"budget"`
+	Spent            uint64               `json:"spent"`
+	Available        uint64               `json:"available"`
+	Total            uint64               `json:"total"`
+	Left             uint64               `json:"left"`
+	Items            []*WebhookBasketItem `json:"items"`
+}
+
+// A video game in a recommended basket in a webhook payload
+type WebhookBasketItem struct {
+	AppID    SteamAppID `json:"app_id"`
+	Title    string     `json:"title"`
+	StoreURL string     `json:"store_url"`
+	Priority uint32     `json:"priority"`
+	Price    uint64     `json:"price"`
+}
+
+// A weekly digest in a webhook payload
+//
+// [FYI]
+// The total savings is omitted if no video game is discounted
+type WebhookDigest struct {
+	QueuedDeals     []*WebhookDeal           `json:"queued_deals"`
+	DiscountedGames []*WebhookDiscountedGame `json:"discounted_games"`
+	TotalSavings    *WebhookMoney            `json:"total_savings,omitempty"`
+}
+
+// An amount of money in a webhook payload which is not tied to a video game
+type WebhookMoney struct {
+	Currency         string `json:"currency"`
+	CurrencyExponent uint8  `json:"currency_exponent"`
+	Amount           uint64 `json:"amount"`
+}
+
+// A video game on the wishlist which is currently discounted in a webhook payload
+type WebhookDiscountedGame struct {
+	AppID            SteamAppID `json:"app_id"`
+	Title            string     `json:"title"`
+	StoreURL         string     `json:"store_url"`
+	Currency         string     `json:"currency"`
+	CurrencyExponent uint8      `json:"currency_exponent"`
+	CurrentPrice     uint64     `json:"current_price"`
+	RegularPrice     uint64     `json:"regular_price"`
+	DiscountPercent  uint32     `json:"discount_percent"`
+	Savings          uint64     `json:"savings"`
+}
+
+// A video game skipped because it cannot be retrieved from the Steam Store in a webhook payload
+type WebhookSkippedGame struct {
+	AppID    SteamAppID `json:"app_id"`
+	Title    string     `json:"title"`
+	StoreURL string     `json:"store_url"`
+	Reason   string     `json:"reason"`
+}
+
+// An error in a webhook payload
+type WebhookError struct {
+	Message string `json:"message"`
+}
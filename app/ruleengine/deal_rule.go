@@ -0,0 +1,77 @@
+package ruleengine
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+)
+
+var _ service.DealRuleEvaluator = (*model.DealRuleEvaluator)(nil)
+
+// Generate a new DealRuleEvaluator with deal rules in the configuration
+//
+// [FYI]
+// All deal rules are compiled when the app starts, so that an invalid rule is reported before any notification
+func NewDealRuleEvaluator(ctx context.Context, cfg *config.NotifierConfig) (*model.DealRuleEvaluator, error) {
+	expressionRules := make([]*model.ExpressionDealRule, 0, len(cfg.DealRules))
+	for _, v := range cfg.DealRules {
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+
+		rule, err := parseDealRule(v)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to parse a deal rule", slog.String("rule", v), slog.Any("error", err))
+			return nil, err
+		}
+
+		expressionRules = append(expressionRules, rule)
+	}
+
+	return model.NewDealRuleEvaluator(cfg.NearLowPercent, expressionRules...), nil
+}
+
+// Parse a deal rule in the form of "name: expression"
+//
+// [FYI]
+// The expression itself is used as the name if the name is omitted
+// e.g. "Big sale: discount >= 50" -> {"Big sale", "discount >= 50"}, "discount >= 50" -> {"discount >= 50", "discount >= 50"}
+func parseDealRule(s string) (*model.ExpressionDealRule, error) {
+	name, src, ok := strings.Cut(s, ":")
+	if !ok {
+		src = name
+	}
+	name, src = strings.TrimSpace(name), strings.TrimSpace(src)
+	if name == "" || src == "" {
+		return nil, fmt.Errorf("%w: %q", errInvalidDealRule, s)
+	}
+
+	expression, err := Compile(src)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", errInvalidDealRule, s, err)
+	}
+
+	for _, v := range expression.Variables() {
+		if !slices.Contains(model.DealVariables, v) {
+			return nil, fmt.Errorf(
+				"%w: %q: %w: %s (available: %s)",
+				errInvalidDealRule,
+				s,
+				errUnknownVariable,
+				v,
+				strings.Join(model.DealVariables, ", "),
+			)
+		}
+	}
+
+	return &model.ExpressionDealRule{
+		Name:       model.DealRule(name),
+		Expression: expression,
+	}, nil
+}
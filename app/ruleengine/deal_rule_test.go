@@ -0,0 +1,85 @@
+package ruleengine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/pointer"
+)
+
+func TestNewDealRuleEvaluator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Positive case: Successfully evaluate deal rules in the configuration", func(t *testing.T) {
+		t.Parallel()
+
+		// Execute the function to be tested
+		ctx := t.Context()
+		cfg := &config.NotifierConfig{
+			DealRules: []string{
+				"Big sale: discount >= 50 && price <= lowest * 1.1",
+				" ",
+				"review_score >= 95",
+				"Cheap: price <= 10",
+			},
+		}
+		e, err := NewDealRuleEvaluator(ctx, cfg)
+		if err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+		facts := &model.DealFacts{
+			CurrentPrice:    model.Money{Currency: "AUD", Amount: 1599},
+			LowestPrice:     &model.Money{Currency: "AUD", Amount: 1499},
+			DiscountPercent: 60,
+			ReviewScore:     pointer.Ptr(96.0),
+		}
+		got, ok, err := e.Evaluate(facts)
+		if err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if !ok {
+			t.Fatalf("\ngot: %v\nwant: %v", ok, true)
+		}
+		want := []model.DealRule{"Big sale", "review_score >= 95"}
+		if diff := cmp.Diff(got.TriggeredRules, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+		if !e.RequiresVariable(model.DealVariableReviewScore) {
+			t.Errorf("\ngot: %v\nwant: %v", false, true)
+		}
+	})
+
+	negativeTestCases := map[string]struct {
+		rule    string
+		wantErr error
+	}{
+		"Negative case: A deal rule refers to an unknown variable": {
+			rule:    "Popular: followers >= 1000",
+			wantErr: errUnknownVariable,
+		},
+		"Negative case: A deal rule has no expression": {
+			rule:    "Empty:",
+			wantErr: errInvalidDealRule,
+		},
+		"Negative case: A deal rule has an invalid expression": {
+			rule:    "Broken: discount >=",
+			wantErr: errUnexpectedToken,
+		},
+	}
+
+	for name, tc := range negativeTestCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the function to be tested
+			ctx := t.Context()
+			cfg := &config.NotifierConfig{DealRules: []string{tc.rule}}
+			if _, gotErr := NewDealRuleEvaluator(ctx, cfg); !errors.Is(gotErr, tc.wantErr) {
+				t.Errorf("\ngot: %v\nwant: %v", gotErr, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package ruleengine
+
+import "errors"
+
+var (
+	errUnexpectedCharacter = errors.New("unexpected character")
+	errUnexpectedToken     = errors.New("unexpected token")
+	errTypeMismatch        = errors.New("type mismatch")
+	errUndefinedVariable   = errors.New("undefined variable")
+	errUnknownVariable     = errors.New("unknown variable")
+	errDivisionByZero      = errors.New("division by zero")
+	errInvalidDealRule     = errors.New("invalid deal rule")
+)
@@ -0,0 +1,81 @@
+package ruleengine
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+// A kind of a token of an expression
+type tokenKind uint8
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+)
+
+// A token of an expression
+type token struct {
+	kind   tokenKind
+	text   string
+	number float64
+	pos    int
+}
+
+// Operators sorted by their length in descending order to match the longest one first
+var operators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "+", "-", "*", "/", "!"}
+
+// Split an expression into tokens
+func tokenize(src string) ([]token, error) {
+	tokens := make([]token, 0)
+	runes := []rune(src)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, text: ")", pos: i})
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			text := string(runes[start:i])
+			number, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q at %d", errUnexpectedToken, text, start)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: text, number: number, pos: start})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[start:i]), pos: start})
+		default:
+			matched := false
+			for _, op := range operators {
+				if i+len(op) <= len(runes) && string(runes[i:i+len(op)]) == op {
+					tokens = append(tokens, token{kind: tokenOperator, text: op, pos: i})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("%w: %q at %d", errUnexpectedCharacter, r, i)
+			}
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, text: "end of the expression", pos: len(runes)}), nil
+}
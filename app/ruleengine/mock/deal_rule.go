@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./deal_rule.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./deal_rule.go -destination=../ruleengine/mock/deal_rule.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	model "github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDealRuleEvaluator is a mock of DealRuleEvaluator interface.
+type MockDealRuleEvaluator struct {
+	ctrl     *gomock.Controller
+	recorder *MockDealRuleEvaluatorMockRecorder
+	isgomock struct{}
+}
+
+// MockDealRuleEvaluatorMockRecorder is the mock recorder for MockDealRuleEvaluator.
+type MockDealRuleEvaluatorMockRecorder struct {
+	mock *MockDealRuleEvaluator
+}
+
+// NewMockDealRuleEvaluator creates a new mock instance.
+func NewMockDealRuleEvaluator(ctrl *gomock.Controller) *MockDealRuleEvaluator {
+	mock := &MockDealRuleEvaluator{ctrl: ctrl}
+	mock.recorder = &MockDealRuleEvaluatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDealRuleEvaluator) EXPECT() *MockDealRuleEvaluatorMockRecorder {
+	return m.recorder
+}
+
+// Evaluate mocks base method.
+func (m *MockDealRuleEvaluator) Evaluate(facts *model.DealFacts) (*model.DealEvaluation, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", facts)
+	ret0, _ := ret[0].(*model.DealEvaluation)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockDealRuleEvaluatorMockRecorder) Evaluate(facts any) *MockDealRuleEvaluatorEvaluateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockDealRuleEvaluator)(nil).Evaluate), facts)
+	return &MockDealRuleEvaluatorEvaluateCall{Call: call}
+}
+
+// MockDealRuleEvaluatorEvaluateCall wrap *gomock.Call
+type MockDealRuleEvaluatorEvaluateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDealRuleEvaluatorEvaluateCall) Return(arg0 *model.DealEvaluation, arg1 bool, arg2 error) *MockDealRuleEvaluatorEvaluateCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDealRuleEvaluatorEvaluateCall) Do(f func(*model.DealFacts) (*model.DealEvaluation, bool, error)) *MockDealRuleEvaluatorEvaluateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDealRuleEvaluatorEvaluateCall) DoAndReturn(f func(*model.DealFacts) (*model.DealEvaluation, bool, error)) *MockDealRuleEvaluatorEvaluateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RequiresVariable mocks base method.
+func (m *MockDealRuleEvaluator) RequiresVariable(name string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequiresVariable", name)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// RequiresVariable indicates an expected call of RequiresVariable.
+func (mr *MockDealRuleEvaluatorMockRecorder) RequiresVariable(name any) *MockDealRuleEvaluatorRequiresVariableCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequiresVariable", reflect.TypeOf((*MockDealRuleEvaluator)(nil).RequiresVariable), name)
+	return &MockDealRuleEvaluatorRequiresVariableCall{Call: call}
+}
+
+// MockDealRuleEvaluatorRequiresVariableCall wrap *gomock.Call
+type MockDealRuleEvaluatorRequiresVariableCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDealRuleEvaluatorRequiresVariableCall) Return(arg0 bool) *MockDealRuleEvaluatorRequiresVariableCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDealRuleEvaluatorRequiresVariableCall) Do(f func(string) bool) *MockDealRuleEvaluatorRequiresVariableCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDealRuleEvaluatorRequiresVariableCall) DoAndReturn(f func(string) bool) *MockDealRuleEvaluatorRequiresVariableCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,339 @@
+package ruleengine
+
+import (
+	"fmt"
+	"slices"
+)
+
+// A type of a value of an expression
+type valueType uint8
+
+const (
+	typeNumber valueType = iota
+	typeBool
+)
+
+// Get the name of the type
+func (t valueType) String() string {
+	if t == typeBool {
+		return "bool"
+	}
+
+	return "number"
+}
+
+// A value of an expression
+type value struct {
+	number  float64
+	boolean bool
+}
+
+// A node of a syntax tree of an expression
+type node interface {
+	typ() valueType
+	eval(vars map[string]float64) (value, error)
+}
+
+// A parser of an expression with recursive descent
+//
+// [FYI]
+// The precedence of operators is as follows, from the lowest to the highest
+// "||" < "&&" < "==", "!=", "<", "<=", ">", ">=" < "+", "-" < "*", "/" < unary "!", "-"
+type parser struct {
+	tokens    []token
+	pos       int
+	variables []string
+}
+
+// Parse tokens into a syntax tree
+func parse(tokens []token) (node, []string, error) {
+	p := &parser{tokens: tokens, variables: make([]string, 0)}
+	n, err := p.parseOr()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, nil, fmt.Errorf("%w: %q at %d", errUnexpectedToken, t.text, t.pos)
+	}
+
+	return n, p.variables, nil
+}
+
+// Get the current token
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// Consume the current token if it is one of the operators
+func (p *parser) accept(ops ...string) (string, bool) {
+	t := p.peek()
+	if t.kind == tokenOperator && slices.Contains(ops, t.text) {
+		p.pos++
+		return t.text, true
+	}
+
+	return "", false
+}
+
+func (p *parser) parseOr() (node, error) {
+	return p.parseBinary(p.parseAnd, typeBool, "||")
+}
+
+func (p *parser) parseAnd() (node, error) {
+	return p.parseBinary(p.parseComparison, typeBool, "&&")
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	op, ok := p.accept("==", "!=", "<=", ">=", "<", ">")
+	if !ok {
+		return left, nil
+	}
+
+	right, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := expectType(op, typeNumber, left, right); err != nil {
+		return nil, err
+	}
+
+	return &binaryNode{op: op, left: left, right: right}, nil
+}
+
+func (p *parser) parseAdditive() (node, error) {
+	return p.parseBinary(p.parseMultiplicative, typeNumber, "+", "-")
+}
+
+func (p *parser) parseMultiplicative() (node, error) {
+	return p.parseBinary(p.parseUnary, typeNumber, "*", "/")
+}
+
+// Parse a left-associative binary expression whose operands have the same type
+func (p *parser) parseBinary(next func() (node, error), operandType valueType, ops ...string) (node, error) {
+	left, err := next()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op, ok := p.accept(ops...)
+		if !ok {
+			return left, nil
+		}
+
+		right, err := next()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := expectType(op, operandType, left, right); err != nil {
+			return nil, err
+		}
+
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary() (node, error) {
+	op, ok := p.accept("!", "-")
+	if !ok {
+		return p.parsePrimary()
+	}
+
+	operand, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	operandType := typeNumber
+	if op == "!" {
+		operandType = typeBool
+	}
+	if err := expectType(op, operandType, operand); err != nil {
+		return nil, err
+	}
+
+	return &unaryNode{op: op, operand: operand}, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokenNumber:
+		p.pos++
+		return &literalNode{valueType: typeNumber, value: value{number: t.number}}, nil
+	case tokenIdent:
+		p.pos++
+		switch t.text {
+		case "true", "false":
+			return &literalNode{valueType: typeBool, value: value{boolean: t.text == "true"}}, nil
+		default:
+			if !slices.Contains(p.variables, t.text) {
+				p.variables = append(p.variables, t.text)
+			}
+			return &variableNode{name: t.text}, nil
+		}
+	case tokenLeftParen:
+		p.pos++
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek().kind != tokenRightParen {
+			return nil, fmt.Errorf("%w: %q at %d, want \")\"", errUnexpectedToken, p.peek().text, p.peek().pos)
+		}
+		p.pos++
+		return n, nil
+	default:
+		return nil, fmt.Errorf("%w: %q at %d", errUnexpectedToken, t.text, t.pos)
+	}
+}
+
+// Check whether operands of an operator have the expected type
+func expectType(op string, want valueType, operands ...node) error {
+	for _, v := range operands {
+		if v.typ() != want {
+			return fmt.Errorf("%w: %q requires %s operands, but got %s", errTypeMismatch, op, want, v.typ())
+		}
+	}
+
+	return nil
+}
+
+// A literal of a number or a boolean
+type literalNode struct {
+	valueType valueType
+	value     value
+}
+
+func (n *literalNode) typ() valueType {
+	return n.valueType
+}
+
+func (n *literalNode) eval(_ map[string]float64) (value, error) {
+	return n.value, nil
+}
+
+// A variable which is a number
+type variableNode struct {
+	name string
+}
+
+func (n *variableNode) typ() valueType {
+	return typeNumber
+}
+
+func (n *variableNode) eval(vars map[string]float64) (value, error) {
+	v, ok := vars[n.name]
+	if !ok {
+		return value{}, fmt.Errorf("%w: %s", errUndefinedVariable, n.name)
+	}
+
+	return value{number: v}, nil
+}
+
+// A unary operation
+type unaryNode struct {
+	op      string
+	operand node
+}
+
+func (n *unaryNode) typ() valueType {
+	if n.op == "!" {
+		return typeBool
+	}
+
+	return typeNumber
+}
+
+func (n *unaryNode) eval(vars map[string]float64) (value, error) {
+	v, err := n.operand.eval(vars)
+	if err != nil {
+		return value{}, err
+	}
+
+	if n.op == "!" {
+		return value{boolean: !v.boolean}, nil
+	}
+
+	return value{number: -v.number}, nil
+}
+
+// A binary operation
+//
+// [FYI]
+// "&&" and "||" are short-circuit evaluated
+type binaryNode struct {
+	op    string
+	left  node
+	right node
+}
+
+func (n *binaryNode) typ() valueType {
+	switch n.op {
+	case "+", "-", "*", "/":
+		return typeNumber
+	default:
+		return typeBool
+	}
+}
+
+func (n *binaryNode) eval(vars map[string]float64) (value, error) {
+	left, err := n.left.eval(vars)
+	if err != nil {
+		return value{}, err
+	}
+
+	switch n.op {
+	case "&&":
+		if !left.boolean {
+			return value{boolean: false}, nil
+		}
+	case "||":
+		if left.boolean {
+			return value{boolean: true}, nil
+		}
+	}
+
+	right, err := n.right.eval(vars)
+	if err != nil {
+		return value{}, err
+	}
+
+	switch n.op {
+	case "&&", "||":
+		return value{boolean: right.boolean}, nil
+	case "==":
+		return value{boolean: left.number == right.number}, nil
+	case "!=":
+		return value{boolean: left.number != right.number}, nil
+	case "<":
+		return value{boolean: left.number < right.number}, nil
+	case "<=":
+		return value{boolean: left.number <= right.number}, nil
+	case ">":
+		return value{boolean: left.number > right.number}, nil
+	case ">=":
+		return value{boolean: left.number >= right.number}, nil
+	case "+":
+		return value{number: left.number + right.number}, nil
+	case "-":
+		return value{number: left.number - right.number}, nil
+	case "*":
+		return value{number: left.number * right.number}, nil
+	case "/":
+		if right.number == 0 {
+			return value{}, errDivisionByZero
+		}
+		return value{number: left.number / right.number}, nil
+	default:
+		return value{}, fmt.Errorf("%w: %q", errUnexpectedToken, n.op)
+	}
+}
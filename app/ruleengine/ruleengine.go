@@ -0,0 +1,76 @@
+package ruleengine
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+)
+
+// A compiled expression of a rule
+//
+// [FYI]
+// An expression consists of numbers, variables, "true", "false", parentheses, arithmetic operators (+, -, *, /),
+// comparison operators (==, !=, <, <=, >, >=), and logical operators (&&, ||, !)
+// e.g. "discount >= 50 && price <= lowest * 1.1 && review_score >= 80"
+type Expression struct {
+	src       string
+	root      node
+	variables []string
+}
+
+var _ model.DealExpression = (*Expression)(nil)
+
+// Compile an expression
+//
+// [FYI]
+// The expression is type-checked, so that it must be a boolean expression and every operator has operands of the right type
+func Compile(src string) (*Expression, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+
+	root, variables, err := parse(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if root.typ() != typeBool {
+		return nil, fmt.Errorf("%w: the expression must be bool, but got %s", errTypeMismatch, root.typ())
+	}
+
+	return &Expression{
+		src:       src,
+		root:      root,
+		variables: variables,
+	}, nil
+}
+
+// Evaluate the expression with values of variables
+//
+// [FYI]
+// The expression is false if it refers to a variable which is not given (e.g. the lowest price is not set),
+// because the rule cannot be decided without the variable
+func (e *Expression) Evaluate(vars map[string]float64) (bool, error) {
+	v, err := e.root.eval(vars)
+	if errors.Is(err, errUndefinedVariable) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return v.boolean, nil
+}
+
+// Get the names of variables which the expression refers to in the order of their appearance
+func (e *Expression) Variables() []string {
+	return slices.Clone(e.variables)
+}
+
+// Get the source of the expression
+func (e *Expression) String() string {
+	return e.src
+}
@@ -0,0 +1,171 @@
+package ruleengine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	positiveTestCases := map[string]struct {
+		src           string
+		wantVariables []string
+	}{
+		"Positive case: Compile an expression with comparison and logical operators": {
+			src:           "discount >= 50 && price <= lowest * 1.1 && review_score >= 80",
+			wantVariables: []string{"discount", "price", "lowest", "review_score"},
+		},
+		"Positive case: Compile an expression with parentheses and unary operators": {
+			src:           "!(price > target) || -discount < -30",
+			wantVariables: []string{"price", "target", "discount"},
+		},
+		"Positive case: Compile a boolean literal": {
+			src:           "true",
+			wantVariables: []string{},
+		},
+	}
+
+	for name, tc := range positiveTestCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the function to be tested
+			got, err := Compile(tc.src)
+			if err != nil {
+				t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+			}
+			if diff := cmp.Diff(got.Variables(), tc.wantVariables); diff != "" {
+				t.Errorf("got(-) want(+)\n%s", diff)
+			}
+			if got.String() != tc.src {
+				t.Errorf("\ngot: %v\nwant: %v", got.String(), tc.src)
+			}
+		})
+	}
+
+	negativeTestCases := map[string]struct {
+		src     string
+		wantErr error
+	}{
+		"Negative case: An expression has an unexpected character": {
+			src:     "price <= 1000 & discount >= 50",
+			wantErr: errUnexpectedCharacter,
+		},
+		"Negative case: An expression has an unclosed parenthesis": {
+			src:     "(price <= 1000",
+			wantErr: errUnexpectedToken,
+		},
+		"Negative case: An expression has a trailing token": {
+			src:     "price <= 1000 1000",
+			wantErr: errUnexpectedToken,
+		},
+		"Negative case: An expression is empty": {
+			src:     "",
+			wantErr: errUnexpectedToken,
+		},
+		"Negative case: An expression is not boolean": {
+			src:     "price * 2",
+			wantErr: errTypeMismatch,
+		},
+		"Negative case: A logical operator has a number operand": {
+			src:     "price && discount >= 50",
+			wantErr: errTypeMismatch,
+		},
+		"Negative case: A comparison operator has a boolean operand": {
+			src:     "(price < 10) == true",
+			wantErr: errTypeMismatch,
+		},
+	}
+
+	for name, tc := range negativeTestCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the function to be tested
+			if _, gotErr := Compile(tc.src); !errors.Is(gotErr, tc.wantErr) {
+				t.Errorf("\ngot: %v\nwant: %v", gotErr, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestExpressionEvaluate(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]float64{
+		"price":        1500,
+		"lowest":       1400,
+		"discount":     60,
+		"review_score": 92.5,
+	}
+
+	positiveTestCases := map[string]struct {
+		src  string
+		want bool
+	}{
+		"Positive case: All conditions match": {
+			src:  "discount >= 50 && price <= lowest * 1.1 && review_score >= 80",
+			want: true,
+		},
+		"Positive case: A condition does not match": {
+			src:  "discount >= 50 && price <= lowest",
+			want: false,
+		},
+		"Positive case: Either condition matches": {
+			src:  "discount >= 90 || review_score > 90",
+			want: true,
+		},
+		"Positive case: Multiplication has a higher precedence than addition": {
+			src:  "lowest + 10 * 10 == 1500",
+			want: true,
+		},
+		"Positive case: Arithmetic operators are left-associative": {
+			src:  "price - 100 - 100 == 1300 && price / 3 / 5 == 100",
+			want: true,
+		},
+		"Positive case: An expression refers to an undefined variable": {
+			src:  "discount >= 50 && price <= target",
+			want: false,
+		},
+		"Positive case: An undefined variable is not evaluated because of short-circuit evaluation": {
+			src:  "discount >= 50 || price <= target",
+			want: true,
+		},
+	}
+
+	for name, tc := range positiveTestCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute the method to be tested
+			e, err := Compile(tc.src)
+			if err != nil {
+				t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+			}
+			got, err := e.Evaluate(vars)
+			if err != nil {
+				t.Errorf("\ngot: %v\nwant: %v", err, nil)
+			}
+			if got != tc.want {
+				t.Errorf("\ngot: %v\nwant: %v", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("Negative case: An expression divides by zero", func(t *testing.T) {
+		t.Parallel()
+
+		// Execute the method to be tested
+		e, err := Compile("price / (discount - 60) > 1")
+		if err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+		wantErr := errDivisionByZero
+		if _, gotErr := e.Evaluate(vars); !errors.Is(gotErr, wantErr) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, wantErr)
+		}
+	})
+}
@@ -0,0 +1,13 @@
+package ruleengine
+
+import (
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+	"github.com/google/wire"
+)
+
+// A wire set for the ruleengine package
+var Set = wire.NewSet(
+	NewDealRuleEvaluator,
+	wire.Bind(new(service.DealRuleEvaluator), new(*model.DealRuleEvaluator)),
+)
@@ -0,0 +1,13 @@
+package service
+
+import "github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+
+//go:generate mockgen -source=./deal_rule.go -destination=../ruleengine/mock/deal_rule.go -package=mock -typed
+
+// An interface to evaluate deal rules of video games
+type DealRuleEvaluator interface {
+	// Evaluate deal rules of a video game, and false is returned if no rule matches
+	Evaluate(facts *model.DealFacts) (*model.DealEvaluation, bool, error)
+	// Check whether any deal rule refers to a variable
+	RequiresVariable(name string) bool
+}
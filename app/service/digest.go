@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+)
+
+//go:generate mockgen -source=./digest.go -destination=../external/boltdb/mock/digest.go -package=mock -typed
+
+type (
+	// An input to queue deals for the weekly digest
+	QueueDealsInput struct {
+		QueuedDeals []*model.QueuedDeal
+	}
+
+	// An output to queue deals for the weekly digest
+	QueueDealsOutput struct{}
+
+	// An interface to queue deals for the weekly digest
+	DealsQueuer interface {
+		QueueDeals(
+			ctx context.Context,
+			input *QueueDealsInput,
+		) (*QueueDealsOutput, error)
+	}
+)
+
+type (
+	// An input to get the queue of the weekly digest
+	GetDigestQueueInput struct{}
+
+	// An output to get the queue of the weekly digest
+	//
+	// [FYI]
+	// The last sent time is zero if the digest has never been sent
+	GetDigestQueueOutput struct {
+		QueuedDeals []*model.QueuedDeal
+		LastSentAt  time.Time
+	}
+
+	// An interface to get the queue of the weekly digest
+	DigestQueueGetter interface {
+		GetDigestQueue(
+			ctx context.Context,
+			input *GetDigestQueueInput,
+		) (*GetDigestQueueOutput, error)
+	}
+)
+
+type (
+	// An input to flush the queue of the weekly digest after it is sent
+	FlushDigestQueueInput struct {
+		SentAt time.Time
+	}
+
+	// An output to flush the queue of the weekly digest after it is sent
+	FlushDigestQueueOutput struct{}
+
+	// An interface to flush the queue of the weekly digest after it is sent
+	DigestQueueFlusher interface {
+		FlushDigestQueue(
+			ctx context.Context,
+			input *FlushDigestQueueInput,
+		) (*FlushDigestQueueOutput, error)
+	}
+)
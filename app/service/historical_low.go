@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+)
+
+//go:generate mockgen -source=./historical_low.go -destination=../external/isthereanydeal/mock/historical_low.go -package=mock -typed
+
+type (
+	// An input to get historical lows of video games
+	GetHistoricalLowsInput struct {
+		AppIDs []model.SteamAppID
+	}
+
+	// An output to get historical lows of video games
+	//
+	// [FYI]
+	// Video games whose historical lows are not found are not included in HistoricalLows
+	GetHistoricalLowsOutput struct {
+		HistoricalLows map[model.SteamAppID]*model.Money
+	}
+
+	// An interface to get historical lows of video games from a price tracking service
+	HistoricalLowsGetter interface {
+		GetHistoricalLows(
+			ctx context.Context,
+			input *GetHistoricalLowsInput,
+		) (*GetHistoricalLowsOutput, error)
+	}
+)
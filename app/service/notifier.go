@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+)
+
+//go:generate mockgen -source=./notifier.go -destination=../external/notifier/mock/notifier.go -package=mock -typed
+
+// A channel to notify deals of video games and errors (e.g. Discord)
+//
+// [FYI]
+// The name is used to report which channel failed to deliver a notification
+type NotificationChannel struct {
+	Name          string
+	DealNotifier  DealNotifier
+	ErrorNotifier ErrorNotifier
+}
+
+type (
+	// An input to notify deals of video games
+	//
+	// [FYI]
+	// The basket is nil if the monthly budget is not set, and the digest is nil if the weekly digest is not due
+	NotifyDealsInput struct {
+		Contents        map[model.SteamAppID]*model.DealContent
+		SkippedContents map[model.SteamAppID]*model.SkippedContent
+		Basket          *model.Basket
+		Digest          *model.Digest
+	}
+
+	// An output to notify deals of video games
+	//
+	// [FYI]
+	// Channels which failed to deliver the deals are reported with the errors keyed by the channel names
+	NotifyDealsOutput struct {
+		FailedChannels map[string]error
+	}
+
+	// An interface to notify deals of video games
+	DealNotifier interface {
+		NotifyDeals(
+			ctx context.Context,
+			input *NotifyDealsInput,
+		) (*NotifyDealsOutput, error)
+	}
+)
+
+type (
+	// An input to notify an error
+	NotifyErrorInput struct {
+		GeneratedError error
+	}
+
+	// An output to notify an error
+	//
+	// [FYI]
+	// Channels which failed to deliver the error are reported with the errors keyed by the channel names
+	NotifyErrorOutput struct {
+		FailedChannels map[string]error
+	}
+
+	// An interface to notify an error
+	ErrorNotifier interface {
+		NotifyError(
+			ctx context.Context,
+			input *NotifyErrorInput,
+		) (*NotifyErrorOutput, error)
+	}
+)
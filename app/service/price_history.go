@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+)
+
+//go:generate mockgen -source=./price_history.go -destination=../external/boltdb/mock/price_history.go -package=mock -typed
+
+type (
+	// An input to record observations of video game prices in the price history
+	RecordPriceObservationsInput struct {
+		PriceObservations []*model.PriceObservation
+	}
+
+	// An output to record observations of video game prices in the price history
+	RecordPriceObservationsOutput struct{}
+
+	// An interface to record observations of video game prices in the price history
+	PriceObservationsRecorder interface {
+		RecordPriceObservations(
+			ctx context.Context,
+			input *RecordPriceObservationsInput,
+		) (*RecordPriceObservationsOutput, error)
+	}
+)
+
+type (
+	// An input to get the price history of a video game
+	GetPriceHistoryInput struct {
+		AppID model.SteamAppID
+	}
+
+	// An output to get the price history of a video game
+	//
+	// [FYI]
+	// The observations are sorted by the time when they were observed in ascending order
+	GetPriceHistoryOutput struct {
+		PriceObservations []*model.PriceObservation
+	}
+
+	// An interface to get the price history of a video game
+	PriceHistoryGetter interface {
+		GetPriceHistory(
+			ctx context.Context,
+			input *GetPriceHistoryInput,
+		) (*GetPriceHistoryOutput, error)
+	}
+)
@@ -8,9 +8,34 @@ import (
 
 //go:generate mockgen -source=./steam.go -destination=../external/steam/mock/steam.go -package=mock -typed
 
+type (
+	// An input to resolve a Steam user ID into a SteamID64
+	//
+	// [FYI]
+	// The Steam user ID is either a SteamID64 or a vanity name of a Steam Community profile
+	ResolveSteamUserIDInput struct {
+		SteamUserID string
+	}
+
+	// An output to resolve a Steam user ID into a SteamID64
+	ResolveSteamUserIDOutput struct {
+		SteamID64 string
+	}
+
+	// An interface to resolve a Steam user ID into a SteamID64
+	SteamUserIDResolver interface {
+		ResolveSteamUserID(
+			ctx context.Context,
+			input *ResolveSteamUserIDInput,
+		) (*ResolveSteamUserIDOutput, error)
+	}
+)
+
 type (
 	// An input to get a wishlist from the Steam Store
-	GetSteamWishlistInput struct{}
+	GetSteamWishlistInput struct {
+		SteamUserID string
+	}
 
 	// An output to get a wishlist from the Steam Store
 	GetSteamWishlistOutput struct {
@@ -26,6 +51,26 @@ type (
 	}
 )
 
+type (
+	// An input to get owned games of a Steam account
+	GetSteamOwnedGamesInput struct {
+		SteamID64 string
+	}
+
+	// An output to get owned games of a Steam account
+	GetSteamOwnedGamesOutput struct {
+		OwnedGames *model.SteamOwnedGames
+	}
+
+	// An interface to get owned games of a Steam account
+	SteamOwnedGamesGetter interface {
+		GetSteamOwnedGames(
+			ctx context.Context,
+			input *GetSteamOwnedGamesInput,
+		) (*GetSteamOwnedGamesOutput, error)
+	}
+)
+
 type (
 	// An input to get video game details from the Steam Store
 	GetSteamVideoGameDetailsInput struct {
@@ -45,3 +90,50 @@ type (
 		) (*GetSteamVideoGameDetailsOutput, error)
 	}
 )
+
+type (
+	// An input to get prices of video games from the Steam Store
+	GetSteamVideoGamePricesInput struct {
+		AppIDs []model.SteamAppID
+	}
+
+	// An output to get prices of video games from the Steam Store
+	//
+	// [FYI]
+	// The current price is set to nil if the price is not available
+	// e.g. free-to-play games, games that are not sold yet, etc.
+	// Video games that cannot be retrieved are stored with the reason
+	// e.g. model.ErrSteamVideoGameUnavailableInRegion, model.ErrSteamVideoGameDelisted, etc.
+	GetSteamVideoGamePricesOutput struct {
+		VideoGamePrices       map[model.SteamAppID]*model.SteamCurrentPrice
+		UnavailableVideoGames map[model.SteamAppID]error
+	}
+
+	// An interface to get prices of video games from the Steam Store in batches
+	SteamVideoGamePricesGetter interface {
+		GetSteamVideoGamePrices(
+			ctx context.Context,
+			input *GetSteamVideoGamePricesInput,
+		) (*GetSteamVideoGamePricesOutput, error)
+	}
+)
+
+type (
+	// An input to get a review summary of a video game from the Steam Store
+	GetSteamReviewSummaryInput struct {
+		AppID model.SteamAppID
+	}
+
+	// An output to get a review summary of a video game from the Steam Store
+	GetSteamReviewSummaryOutput struct {
+		ReviewSummary *model.SteamStoreReviewSummary
+	}
+
+	// An interface to get a review summary of a video game from the Steam Store
+	SteamReviewSummaryGetter interface {
+		GetSteamReviewSummary(
+			ctx context.Context,
+			input *GetSteamReviewSummaryInput,
+		) (*GetSteamReviewSummaryOutput, error)
+	}
+)
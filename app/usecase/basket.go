@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+)
+
+type (
+	// An input to recommend a basket of deals within the monthly budget
+	//
+	// [FYI]
+	// The candidates are deals notified this time, and the purchases are used to track the spending of this month
+	RecommendBasketInput struct {
+		Candidates []*model.BasketItem
+		Purchases  []*model.Purchase
+	}
+
+	// An output to recommend a basket of deals within the monthly budget
+	//
+	// [FYI]
+	// The basket is nil if the monthly budget is not set or there are no candidates
+	RecommendBasketOutput struct {
+		Basket *model.Basket
+	}
+
+	// An interface to recommend a basket of deals within the monthly budget
+	BasketRecommender interface {
+		RecommendBasket(
+			ctx context.Context,
+			input *RecommendBasketInput,
+		) (*RecommendBasketOutput, error)
+	}
+)
@@ -5,13 +5,13 @@ import (
 )
 
 type (
-	// An input to notify video game prices on Discord
+	// An input to notify video game prices on all notification channels
 	NotifyVideoGamePricesInput struct{}
 
-	// An output to notify video game prices on Discord
+	// An output to notify video game prices on all notification channels
 	NotifyVideoGamePricesOutput struct{}
 
-	// An interface to notify video game prices on Discord
+	// An interface to notify video game prices on all notification channels
 	VideoGamePricesNotifier interface {
 		NotifyVideoGamePrices(
 			ctx context.Context,
@@ -21,15 +21,15 @@ type (
 )
 
 type (
-	// An input to notify an error on Discord
+	// An input to notify an error on all notification channels
 	NotifyErrorInput struct {
 		GeneratedError error
 	}
 
-	// An output to notify an error on Discord
+	// An output to notify an error on all notification channels
 	NotifyErrorOutput struct{}
 
-	// An interface to notify an error on Discord
+	// An interface to notify an error on all notification channels
 	ErrorNotifier interface {
 		NotifyError(ctx context.Context, input *NotifyErrorInput) (*NotifyErrorOutput, error)
 	}
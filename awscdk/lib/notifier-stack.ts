@@ -28,7 +28,34 @@ export class NotifierStack extends cdk.Stack {
         NOTION_DATABASE_ID: process.env.NOTION_DATABASE_ID ?? "",
         DISCORD_WEBHOOK_ID: process.env.DISCORD_WEBHOOK_ID ?? "",
         DISCORD_WEBHOOK_TOKEN: process.env.DISCORD_WEBHOOK_TOKEN ?? "",
-        STEAM_USER_ID: process.env.STEAM_USER_ID ?? "",
+        SLACK_WEBHOOK_URL: process.env.SLACK_WEBHOOK_URL ?? "",
+        SMTP_HOST: process.env.SMTP_HOST ?? "",
+        SMTP_PORT: process.env.SMTP_PORT ?? "",
+        SMTP_STARTTLS: process.env.SMTP_STARTTLS ?? "",
+        SMTP_USERNAME: process.env.SMTP_USERNAME ?? "",
+        SMTP_PASSWORD: process.env.SMTP_PASSWORD ?? "",
+        SMTP_FROM: process.env.SMTP_FROM ?? "",
+        SMTP_TO: process.env.SMTP_TO ?? "",
+        LINE_CHANNEL_ACCESS_TOKEN: process.env.LINE_CHANNEL_ACCESS_TOKEN ?? "",
+        LINE_TO: process.env.LINE_TO ?? "",
+        TELEGRAM_BOT_TOKEN: process.env.TELEGRAM_BOT_TOKEN ?? "",
+        TELEGRAM_CHAT_ID: process.env.TELEGRAM_CHAT_ID ?? "",
+        WEBHOOK_URL: process.env.WEBHOOK_URL ?? "",
+        WEBHOOK_SECRET: process.env.WEBHOOK_SECRET ?? "",
+        WEBHOOK_MAX_RETRIES: process.env.WEBHOOK_MAX_RETRIES ?? "",
+        WEBHOOK_RETRY_BASE_DELAY: process.env.WEBHOOK_RETRY_BASE_DELAY ?? "",
+        STEAM_USER_IDS: process.env.STEAM_USER_IDS ?? "",
+        STEAM_COUNTRY_CODE: process.env.STEAM_COUNTRY_CODE ?? "",
+        STEAM_WEB_API_KEY: process.env.STEAM_WEB_API_KEY ?? "",
+        STEAM_MAX_RETRIES: process.env.STEAM_MAX_RETRIES ?? "",
+        STEAM_RETRY_BASE_DELAY: process.env.STEAM_RETRY_BASE_DELAY ?? "",
+        STORAGE_FILE_PATH: process.env.STORAGE_FILE_PATH ?? "",
+        ISTHEREANYDEAL_API_KEY: process.env.ISTHEREANYDEAL_API_KEY ?? "",
+        NOTIFICATION_COOLDOWN: process.env.NOTIFICATION_COOLDOWN ?? "",
+        NEAR_LOW_PERCENT: process.env.NEAR_LOW_PERCENT ?? "",
+        DEAL_RULES: process.env.DEAL_RULES ?? "",
+        MONTHLY_BUDGET: process.env.MONTHLY_BUDGET ?? "",
+        DIGEST_WEEKDAY: process.env.DIGEST_WEEKDAY ?? "",
       },
       timeout: cdk.Duration.minutes(2),
       logGroup: logGroup,
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/discord"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/email"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/line"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/slack"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/telegram"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/webhook"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/service"
+)
+
+var errNoNotificationChannels = errors.New("no notification channels are configured")
+
+// Collect the notification channels which deals of video games and errors are fanned out to
+//
+// [FYI]
+// A new channel is added by providing its channel in a wire set and adding it as a parameter here.
+// Channels which are not configured are nil, and at least one channel must be configured
+func NewNotificationChannels(
+	ctx context.Context,
+	discordChannel *discord.Channel,
+	slackChannel *slack.Channel,
+	emailChannel *email.Channel,
+	lineChannel *line.Channel,
+	telegramChannel *telegram.Channel,
+	webhookChannel *webhook.Channel,
+) ([]*service.NotificationChannel, error) {
+	channels := make([]*service.NotificationChannel, 0, 6)
+	if discordChannel != nil {
+		channels = append(channels, (*service.NotificationChannel)(discordChannel))
+	}
+	if slackChannel != nil {
+		channels = append(channels, (*service.NotificationChannel)(slackChannel))
+	}
+	if emailChannel != nil {
+		channels = append(channels, (*service.NotificationChannel)(emailChannel))
+	}
+	if lineChannel != nil {
+		channels = append(channels, (*service.NotificationChannel)(lineChannel))
+	}
+	if telegramChannel != nil {
+		channels = append(channels, (*service.NotificationChannel)(telegramChannel))
+	}
+	if webhookChannel != nil {
+		channels = append(channels, (*service.NotificationChannel)(webhookChannel))
+	}
+
+	if len(channels) == 0 {
+		slog.ErrorContext(ctx, "failed to collect notification channels", slog.Any("error", errNoNotificationChannels))
+		return nil, errNoNotificationChannels
+	}
+
+	return channels, nil
+}
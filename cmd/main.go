@@ -19,11 +19,12 @@ func handler(ctx context.Context) {
 	slog.SetDefault(logger)
 
 	// Initialize the application
-	app, err := InitializeApp(ctx)
+	app, cleanup, err := InitializeApp(ctx)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to initialize the application", slog.Any("error", err))
 		os.Exit(1)
 	}
+	defer cleanup()
 
 	// Notify video game prices
 	if _, err := app.vGPNotifier.NotifyVideoGamePrices(ctx, &usecase.NotifyVideoGamePricesInput{}); err != nil {
@@ -37,6 +38,8 @@ func handler(ctx context.Context) {
 			slog.ErrorContext(ctx, "failed to notify an error", slog.Any("error", err))
 		}
 
+		// Release resources explicitly because deferred functions are not run by os.Exit
+		cleanup()
 		os.Exit(1)
 	}
 }
@@ -5,11 +5,20 @@ package main
 import (
 	"context"
 
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/boltdb"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/discord"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/email"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/httpclient"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/isthereanydeal"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/line"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/notifier"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/notion"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/slack"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/steam"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/telegram"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/webhook"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/interactor"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/ruleengine"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
 	"github.com/google/wire"
 )
@@ -20,13 +29,26 @@ var Set = wire.NewSet(
 	config.Set,
 	httpclient.Set,
 	steam.Set,
+	boltdb.Set,
+	isthereanydeal.Set,
 	notion.Set,
 	discord.Set,
+	slack.Set,
+	email.Set,
+	line.Set,
+	telegram.Set,
+	webhook.Set,
+	notifier.Set,
+	NewNotificationChannels,
+	ruleengine.Set,
 	interactor.Set,
 )
 
 // Initialize the application
-func InitializeApp(ctx context.Context) (*app, error) {
+//
+// [FYI]
+// The returned function releases resources of the application, such as the database
+func InitializeApp(ctx context.Context) (*app, func(), error) {
 	wire.Build(Set)
-	return &app{}, nil
+	return &app{}, nil, nil
 }
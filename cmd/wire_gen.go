@@ -8,11 +8,20 @@ package main
 
 import (
 	"context"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/boltdb"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/discord"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/email"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/httpclient"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/isthereanydeal"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/line"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/notifier"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/notion"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/slack"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/steam"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/telegram"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/external/webhook"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/app/interactor"
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/ruleengine"
 	"github.com/TsubasaBneAus/steam_game_price_notifier/config"
 	"github.com/google/wire"
 )
@@ -20,37 +29,114 @@ import (
 // Injectors from wire.go:
 
 // Initialize the application
-func InitializeApp(ctx context.Context) (*app, error) {
+//
+// [FYI]
+// The returned function releases resources of the application, such as the database
+func InitializeApp(ctx context.Context) (*app, func(), error) {
 	notionConfig, err := config.NewNotionConfig(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	steamConfig, err := config.NewSteamConfig(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	notifierConfig, err := config.NewNotifierConfig(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	dealRuleEvaluator, err := ruleengine.NewDealRuleEvaluator(ctx, notifierConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	basketRecommender := interactor.NewBasketRecommender(notifierConfig)
 	httpClient := httpclient.NewHTTPClient()
-	steamWishlistGetter := steam.NewSteamWishlistGetter(steamConfig, httpClient)
-	steamVideoGameDetailsGetter := steam.NewSteamVideoGameDetailsGetter(steamConfig, httpClient)
+	retryableHTTPClient := steam.NewRetryableHTTPClient(steamConfig, httpClient)
+	steamUserIDResolver := steam.NewSteamUserIDResolver(steamConfig, retryableHTTPClient)
+	steamWishlistGetter := steam.NewSteamWishlistGetter(steamConfig, retryableHTTPClient)
+	steamOwnedGamesGetter := steam.NewSteamOwnedGamesGetter(steamConfig, retryableHTTPClient)
+	steamVideoGameDetailsGetter := steam.NewSteamVideoGameDetailsGetter(steamConfig, retryableHTTPClient)
+	steamVideoGamePricesGetter := steam.NewSteamVideoGamePricesGetter(steamConfig, retryableHTTPClient)
+	steamReviewSummaryGetter := steam.NewSteamReviewSummaryGetter(steamConfig, retryableHTTPClient)
 	notionWishlistGetter := notion.NewNotionWishlistGetter(notionConfig, httpClient)
 	notionWishlistItemCreator := notion.NewNotionWishlistItemCreator(notionConfig, httpClient)
 	notionWishlistItemUpdater := notion.NewNotionWishlistItemUpdater(notionConfig, httpClient)
 	notionWishlistItemDeleter := notion.NewNotionWishlistItemDeleter(notionConfig, httpClient)
 	discordConfig, err := config.NewDiscordConfig(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	videoGamePricesOnDiscordNotifier := discord.NewVideoGamePricesOnDiscordNotifier(discordConfig, httpClient)
-	videoGamePricesNotifier := interactor.NewGamePricesNotifier(notionConfig, steamWishlistGetter, steamVideoGameDetailsGetter, notionWishlistGetter, notionWishlistItemCreator, notionWishlistItemUpdater, notionWishlistItemDeleter, videoGamePricesOnDiscordNotifier)
 	errorOnDiscordNotifier := discord.NewErrorOnDiscordNotifier(discordConfig, httpClient)
-	interactorErrorOnDiscordNotifier := interactor.NewErrorOnDiscordNotifier(discordConfig, errorOnDiscordNotifier)
-	mainApp := NewApp(videoGamePricesNotifier, interactorErrorOnDiscordNotifier)
-	return mainApp, nil
+	channel := discord.NewChannel(discordConfig, videoGamePricesOnDiscordNotifier, errorOnDiscordNotifier)
+	slackConfig, err := config.NewSlackConfig(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	videoGamePricesOnSlackNotifier := slack.NewVideoGamePricesOnSlackNotifier(slackConfig, httpClient)
+	errorOnSlackNotifier := slack.NewErrorOnSlackNotifier(slackConfig, httpClient)
+	slackChannel := slack.NewChannel(slackConfig, videoGamePricesOnSlackNotifier, errorOnSlackNotifier)
+	smtpConfig, err := config.NewSMTPConfig(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	videoGamePricesByEmailNotifier := email.NewVideoGamePricesByEmailNotifier(smtpConfig)
+	errorByEmailNotifier := email.NewErrorByEmailNotifier(smtpConfig)
+	emailChannel := email.NewChannel(smtpConfig, videoGamePricesByEmailNotifier, errorByEmailNotifier)
+	lineConfig, err := config.NewLineConfig(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	videoGamePricesOnLineNotifier := line.NewVideoGamePricesOnLineNotifier(lineConfig, httpClient)
+	errorOnLineNotifier := line.NewErrorOnLineNotifier(lineConfig, httpClient)
+	lineChannel := line.NewChannel(lineConfig, videoGamePricesOnLineNotifier, errorOnLineNotifier)
+	telegramConfig, err := config.NewTelegramConfig(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	videoGamePricesOnTelegramNotifier := telegram.NewVideoGamePricesOnTelegramNotifier(telegramConfig, httpClient)
+	errorOnTelegramNotifier := telegram.NewErrorOnTelegramNotifier(telegramConfig, httpClient)
+	telegramChannel := telegram.NewChannel(telegramConfig, videoGamePricesOnTelegramNotifier, errorOnTelegramNotifier)
+	webhookConfig, err := config.NewWebhookConfig(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	videoGamePricesWithWebhookNotifier := webhook.NewVideoGamePricesWithWebhookNotifier(webhookConfig, httpClient)
+	errorWithWebhookNotifier := webhook.NewErrorWithWebhookNotifier(webhookConfig, httpClient)
+	webhookChannel := webhook.NewChannel(webhookConfig, videoGamePricesWithWebhookNotifier, errorWithWebhookNotifier)
+	v, err := NewNotificationChannels(ctx, channel, slackChannel, emailChannel, lineChannel, telegramChannel, webhookChannel)
+	if err != nil {
+		return nil, nil, err
+	}
+	dealNotifier := notifier.NewDealNotifier(v)
+	storageConfig, err := config.NewStorageConfig(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup, err := boltdb.NewDB(ctx, storageConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	priceHistoryRepository := boltdb.NewPriceHistoryRepository(db)
+	isThereAnyDealConfig, err := config.NewIsThereAnyDealConfig(ctx)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	historicalLowsGetter := isthereanydeal.NewHistoricalLowsGetter(isThereAnyDealConfig, steamConfig, httpClient)
+	digestRepository := boltdb.NewDigestRepository(db)
+	videoGamePricesNotifier := interactor.NewGamePricesNotifier(notionConfig, steamConfig, notifierConfig, dealRuleEvaluator, basketRecommender, steamUserIDResolver, steamWishlistGetter, steamOwnedGamesGetter, steamVideoGameDetailsGetter, steamVideoGamePricesGetter, steamReviewSummaryGetter, notionWishlistGetter, notionWishlistItemCreator, notionWishlistItemUpdater, notionWishlistItemDeleter, dealNotifier, priceHistoryRepository, priceHistoryRepository, historicalLowsGetter, digestRepository, digestRepository, digestRepository)
+	errorNotifier := notifier.NewErrorNotifier(v)
+	interactorErrorNotifier := interactor.NewErrorNotifier(errorNotifier)
+	mainApp := NewApp(videoGamePricesNotifier, interactorErrorNotifier)
+	return mainApp, func() {
+		cleanup()
+	}, nil
 }
 
 // wire.go:
 
 // A wire set for the main package
 var Set = wire.NewSet(
-	NewApp, config.Set, httpclient.Set, steam.Set, notion.Set, discord.Set, interactor.Set,
+	NewApp, config.Set, httpclient.Set, steam.Set, boltdb.Set, isthereanydeal.Set, notion.Set, discord.Set, slack.Set, email.Set, line.Set, telegram.Set, webhook.Set, notifier.Set, NewNotificationChannels, ruleengine.Set, interactor.Set,
 )
@@ -2,15 +2,22 @@ package config
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"github.com/caarlos0/env/v11"
 )
 
+var errIncompleteDiscordConfig = errors.New("DISCORD_WEBHOOK_ID and DISCORD_WEBHOOK_TOKEN must be set together")
+
 // A struct to store the configuration for Discord
+//
+// [FYI]
+// Discord is disabled if both of the webhook ID and the webhook token are empty,
+// so that other notification channels can be used instead of Discord
 type DiscordConfig struct {
-	DiscordWebhookID    string `env:"DISCORD_WEBHOOK_ID,notEmpty"`
-	DiscordWebhookToken string `env:"DISCORD_WEBHOOK_TOKEN,notEmpty"`
+	DiscordWebhookID    string `env:"DISCORD_WEBHOOK_ID"`
+	DiscordWebhookToken string `env:"DISCORD_WEBHOOK_TOKEN"`
 }
 
 // Generate configuration for the Discord
@@ -26,5 +33,15 @@ func NewDiscordConfig(ctx context.Context) (*DiscordConfig, error) {
 		return nil, err
 	}
 
+	if (cfg.DiscordWebhookID == "") != (cfg.DiscordWebhookToken == "") {
+		slog.ErrorContext(ctx, "failed to load configuration for Discord", slog.Any("error", errIncompleteDiscordConfig))
+		return nil, errIncompleteDiscordConfig
+	}
+
 	return cfg, nil
 }
+
+// Check whether notifications on Discord are enabled
+func (c *DiscordConfig) Enabled() bool {
+	return c.DiscordWebhookID != "" && c.DiscordWebhookToken != ""
+}
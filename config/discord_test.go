@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"errors"
 	"testing"
 )
 
@@ -14,12 +15,16 @@ func TestNewDiscordConfig(t *testing.T) {
 		// Execute the function to be tested
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
-		if _, err := NewDiscordConfig(ctx); err != nil {
+		cfg, err := NewDiscordConfig(ctx)
+		if err != nil {
 			t.Errorf("\ngot: %v\nwant: %v", err, nil)
 		}
+		if !cfg.Enabled() {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.Enabled(), true)
+		}
 	})
 
-	t.Run("Negative case: Environment variables are missing or empty", func(t *testing.T) {
+	t.Run("Positive case: Discord is disabled if environment variables are empty", func(t *testing.T) {
 		// Set environment variables
 		t.Setenv("DISCORD_WEBHOOK_ID", "")
 		t.Setenv("DISCORD_WEBHOOK_TOKEN", "")
@@ -27,8 +32,25 @@ func TestNewDiscordConfig(t *testing.T) {
 		// Execute the function to be tested
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
-		if _, err := NewDiscordConfig(ctx); err == nil {
-			t.Errorf("\ngot: %v\nwant: an error generated in discord.go", nil)
+		cfg, err := NewDiscordConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if cfg.Enabled() {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.Enabled(), false)
+		}
+	})
+
+	t.Run("Negative case: Only one of environment variables is set", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("DISCORD_WEBHOOK_ID", "dummy_discord_webhook_id")
+		t.Setenv("DISCORD_WEBHOOK_TOKEN", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if _, gotErr := NewDiscordConfig(ctx); !errors.Is(gotErr, errIncompleteDiscordConfig) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errIncompleteDiscordConfig)
 		}
 	})
 }
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/caarlos0/env/v11"
+)
+
+// A struct to store the configuration for IsThereAnyDeal
+//
+// [FYI]
+// IsThereAnyDealAPIKey is optional, and historical lows are not retrieved if it is not set.
+// IsThereAnyDealBaseURL can be changed to use another server which has the same API as IsThereAnyDeal
+type IsThereAnyDealConfig struct {
+	IsThereAnyDealAPIKey  string `env:"ISTHEREANYDEAL_API_KEY"`
+	IsThereAnyDealBaseURL string `env:"ISTHEREANYDEAL_BASE_URL" envDefault:"https://api.isthereanydeal.com"`
+}
+
+// Generate configuration for IsThereAnyDeal
+func NewIsThereAnyDealConfig(ctx context.Context) (*IsThereAnyDealConfig, error) {
+	cfg := &IsThereAnyDealConfig{}
+	if err := env.Parse(cfg); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to load configuration for IsThereAnyDeal",
+			slog.Any("error", err),
+		)
+
+		return nil, err
+	}
+
+	return cfg, nil
+}
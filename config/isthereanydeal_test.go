@@ -0,0 +1,48 @@
+package config
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewIsThereAnyDealConfig(t *testing.T) {
+	t.Run("Positive case: Successfully load configuration for IsThereAnyDeal", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("ISTHEREANYDEAL_API_KEY", "dummy_isthereanydeal_api_key")
+		t.Setenv("ISTHEREANYDEAL_BASE_URL", "http://localhost:8080")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewIsThereAnyDealConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if want := "dummy_isthereanydeal_api_key"; cfg.IsThereAnyDealAPIKey != want {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.IsThereAnyDealAPIKey, want)
+		}
+		if want := "http://localhost:8080"; cfg.IsThereAnyDealBaseURL != want {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.IsThereAnyDealBaseURL, want)
+		}
+	})
+
+	t.Run("Positive case: The API key is optional and the base URL defaults to IsThereAnyDeal", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("ISTHEREANYDEAL_API_KEY", "")
+		t.Setenv("ISTHEREANYDEAL_BASE_URL", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewIsThereAnyDealConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if cfg.IsThereAnyDealAPIKey != "" {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.IsThereAnyDealAPIKey, "")
+		}
+		if want := "https://api.isthereanydeal.com"; cfg.IsThereAnyDealBaseURL != want {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.IsThereAnyDealBaseURL, want)
+		}
+	})
+}
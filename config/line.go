@@ -0,0 +1,48 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/caarlos0/env/v11"
+)
+
+var errIncompleteLineConfig = errors.New("LINE_CHANNEL_ACCESS_TOKEN and LINE_TO must be set together")
+
+// A struct to store the configuration for LINE
+//
+// [FYI]
+// LineChannelAccessToken is a long-lived channel access token of a Messaging API channel,
+// and LineTo is a user ID, a group ID or a room ID to push messages to.
+// LINE is disabled if both of them are empty
+type LineConfig struct {
+	LineChannelAccessToken string `env:"LINE_CHANNEL_ACCESS_TOKEN"`
+	LineTo                 string `env:"LINE_TO"`
+}
+
+// Generate configuration for LINE
+func NewLineConfig(ctx context.Context) (*LineConfig, error) {
+	cfg := &LineConfig{}
+	if err := env.Parse(cfg); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to load configuration for LINE",
+			slog.Any("error", err),
+		)
+
+		return nil, err
+	}
+
+	if (cfg.LineChannelAccessToken == "") != (cfg.LineTo == "") {
+		slog.ErrorContext(ctx, "failed to load configuration for LINE", slog.Any("error", errIncompleteLineConfig))
+		return nil, errIncompleteLineConfig
+	}
+
+	return cfg, nil
+}
+
+// Check whether notifications on LINE are enabled
+func (c *LineConfig) Enabled() bool {
+	return c.LineChannelAccessToken != "" && c.LineTo != ""
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestNewLineConfig(t *testing.T) {
+	t.Run("Positive case: Successfully load configuration for LINE", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("LINE_CHANNEL_ACCESS_TOKEN", "dummy_line_channel_access_token")
+		t.Setenv("LINE_TO", "dummy_line_to")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewLineConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if !cfg.Enabled() {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.Enabled(), true)
+		}
+	})
+
+	t.Run("Positive case: LINE is disabled if environment variables are empty", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("LINE_CHANNEL_ACCESS_TOKEN", "")
+		t.Setenv("LINE_TO", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewLineConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if cfg.Enabled() {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.Enabled(), false)
+		}
+	})
+
+	t.Run("Negative case: Only one of environment variables is set", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("LINE_CHANNEL_ACCESS_TOKEN", "dummy_line_channel_access_token")
+		t.Setenv("LINE_TO", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if _, gotErr := NewLineConfig(ctx); !errors.Is(gotErr, errIncompleteLineConfig) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errIncompleteLineConfig)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/caarlos0/env/v11"
+)
+
+// A struct to store the configuration for notifications
+//
+// [FYI]
+// NotificationCooldown is a duration before a video game which stays at the same price is notified again.
+// A video game is notified again regardless of the cooldown if its price drops further or its sale restarts.
+// NearLowPercent is a margin of the lowest price in percent to notify a video game close to its lowest price,
+// and 0 disables it.
+// DealRules are semicolon-separated rules in the form of "name: expression", and the name can be omitted
+// e.g. "Big sale: discount >= 50 && price <= lowest * 1.1; review_score >= 95".
+// MonthlyBudget is a budget per month in the major units of the currency of the Steam Store (e.g. "10000" for 10,000 JPY),
+// and nil disables recommending a basket of deals.
+// DigestWeekday is a weekday in JST to send the weekly digest (e.g. "Sunday"). If it is set, only new all-time lows are
+// notified instantly and the other deals are queued for the digest, and nil notifies all deals instantly
+type NotifierConfig struct {
+	NotificationCooldown time.Duration      `env:"NOTIFICATION_COOLDOWN" envDefault:"168h"`
+	NearLowPercent       uint32             `env:"NEAR_LOW_PERCENT" envDefault:"0"`
+	DealRules            []string           `env:"DEAL_RULES" envSeparator:";"`
+	MonthlyBudget        *model.MajorAmount `env:"MONTHLY_BUDGET"`
+	DigestWeekday        *time.Weekday      `env:"DIGEST_WEEKDAY"`
+}
+
+// Generate configuration for notifications
+//
+// [FYI]
+// The monthly budget and the weekday of the weekly digest are validated when the app starts
+func NewNotifierConfig(ctx context.Context) (*NotifierConfig, error) {
+	cfg := &NotifierConfig{}
+	opts := env.Options{
+		FuncMap: map[reflect.Type]env.ParserFunc{
+			reflect.TypeFor[model.MajorAmount](): func(v string) (any, error) {
+				amount, err := model.ParseMajorAmount(v)
+				if err != nil {
+					return nil, err
+				}
+
+				return *amount, nil
+			},
+			reflect.TypeFor[time.Weekday](): func(v string) (any, error) {
+				return model.ParseWeekday(v)
+			},
+		},
+	}
+	if err := env.ParseWithOptions(cfg, opts); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to load configuration for notifications",
+			slog.Any("error", err),
+		)
+
+		return nil, err
+	}
+
+	return cfg, nil
+}
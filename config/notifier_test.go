@@ -0,0 +1,116 @@
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TsubasaBneAus/steam_game_price_notifier/app/model"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewNotifierConfig(t *testing.T) {
+	t.Run("Positive case: Successfully load configuration for notifications", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("NOTIFICATION_COOLDOWN", "72h")
+		t.Setenv("NEAR_LOW_PERCENT", "10")
+		t.Setenv("DEAL_RULES", "Big sale: discount >= 50;review_score >= 95")
+		t.Setenv("MONTHLY_BUDGET", "10000")
+		t.Setenv("DIGEST_WEEKDAY", "Sunday")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewNotifierConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if want := 72 * time.Hour; cfg.NotificationCooldown != want {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.NotificationCooldown, want)
+		}
+		if want := uint32(10); cfg.NearLowPercent != want {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.NearLowPercent, want)
+		}
+		want := []string{"Big sale: discount >= 50", "review_score >= 95"}
+		if diff := cmp.Diff(cfg.DealRules, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+		monthlyBudget, err := cfg.MonthlyBudget.ToMoney("JPY")
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if want := (model.Money{Currency: "JPY", Amount: 10000}); *monthlyBudget != want {
+			t.Errorf("\ngot: %v\nwant: %v", *monthlyBudget, want)
+		}
+		if want := time.Sunday; *cfg.DigestWeekday != want {
+			t.Errorf("\ngot: %v\nwant: %v", *cfg.DigestWeekday, want)
+		}
+	})
+
+	t.Run("Positive case: The notification cooldown defaults to a week, and the other features are disabled", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("NOTIFICATION_COOLDOWN", "")
+		t.Setenv("NEAR_LOW_PERCENT", "")
+		t.Setenv("DEAL_RULES", "")
+		t.Setenv("MONTHLY_BUDGET", "")
+		t.Setenv("DIGEST_WEEKDAY", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewNotifierConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if want := 7 * 24 * time.Hour; cfg.NotificationCooldown != want {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.NotificationCooldown, want)
+		}
+		if cfg.NearLowPercent != 0 {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.NearLowPercent, 0)
+		}
+		if len(cfg.DealRules) != 0 {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.DealRules, nil)
+		}
+		if cfg.MonthlyBudget != nil {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.MonthlyBudget, nil)
+		}
+		if cfg.DigestWeekday != nil {
+			t.Errorf("\ngot: %v\nwant: %v", *cfg.DigestWeekday, nil)
+		}
+	})
+
+	t.Run("Negative case: The notification cooldown is not a duration", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("NOTIFICATION_COOLDOWN", "a week")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if _, err := NewNotifierConfig(ctx); err == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated in notifier.go", nil)
+		}
+	})
+	t.Run("Negative case: The monthly budget is not a number", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("MONTHLY_BUDGET", "ten thousand")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if _, err := NewNotifierConfig(ctx); err == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated in money.go", nil)
+		}
+	})
+
+	t.Run("Negative case: The weekday of the weekly digest is not a weekday", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("DIGEST_WEEKDAY", "Someday")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if _, err := NewNotifierConfig(ctx); err == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated in digest.go", nil)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/caarlos0/env/v11"
+)
+
+// A struct to store the configuration for Slack
+//
+// [FYI]
+// SlackWebhookURL is an incoming webhook URL of a Slack app (e.g. "https://hooks.slack.com/services/T000/B000/XXXX"),
+// and Slack is disabled if it is empty
+type SlackConfig struct {
+	SlackWebhookURL string `env:"SLACK_WEBHOOK_URL"`
+}
+
+// Generate configuration for Slack
+func NewSlackConfig(ctx context.Context) (*SlackConfig, error) {
+	cfg := &SlackConfig{}
+	if err := env.Parse(cfg); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to load configuration for Slack",
+			slog.Any("error", err),
+		)
+
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Check whether notifications on Slack are enabled
+func (c *SlackConfig) Enabled() bool {
+	return c.SlackWebhookURL != ""
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewSlackConfig(t *testing.T) {
+	t.Run("Positive case: Successfully load configuration for Slack", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("SLACK_WEBHOOK_URL", "https://hooks.slack.com/services/dummy")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewSlackConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if !cfg.Enabled() {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.Enabled(), true)
+		}
+	})
+
+	t.Run("Positive case: Slack is disabled if the webhook URL is empty", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("SLACK_WEBHOOK_URL", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewSlackConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if cfg.Enabled() {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.Enabled(), false)
+		}
+	})
+}
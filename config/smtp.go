@@ -0,0 +1,71 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/caarlos0/env/v11"
+)
+
+var (
+	errIncompleteSMTPConfig = errors.New("SMTP_FROM and SMTP_TO must be set if SMTP_HOST is set")
+	errIncompleteSMTPAuth   = errors.New("SMTP_USERNAME and SMTP_PASSWORD must be set together")
+)
+
+// A struct to store the configuration for emails over SMTP
+//
+// [FYI]
+// Emails are disabled if SMTPHost is empty, and SMTPTo is a comma-separated list of recipients.
+// SMTPStartTLS upgrades the connection with STARTTLS and fails if the server does not support it.
+// The authentication is PLAIN and it is skipped if both of the username and the password are empty.
+// PLAIN authentication is refused over an unencrypted connection except to localhost
+type SMTPConfig struct {
+	SMTPHost     string   `env:"SMTP_HOST"`
+	SMTPPort     uint16   `env:"SMTP_PORT" envDefault:"587"`
+	SMTPStartTLS bool     `env:"SMTP_STARTTLS" envDefault:"true"`
+	SMTPUsername string   `env:"SMTP_USERNAME"`
+	SMTPPassword string   `env:"SMTP_PASSWORD"`
+	SMTPFrom     string   `env:"SMTP_FROM"`
+	SMTPTo       []string `env:"SMTP_TO" envSeparator:","`
+}
+
+// Generate configuration for emails over SMTP
+func NewSMTPConfig(ctx context.Context) (*SMTPConfig, error) {
+	cfg := &SMTPConfig{}
+	if err := env.Parse(cfg); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to load configuration for SMTP",
+			slog.Any("error", err),
+		)
+
+		return nil, err
+	}
+
+	if !cfg.Enabled() {
+		return cfg, nil
+	}
+
+	if cfg.SMTPFrom == "" || len(cfg.SMTPTo) == 0 {
+		slog.ErrorContext(ctx, "failed to load configuration for SMTP", slog.Any("error", errIncompleteSMTPConfig))
+		return nil, errIncompleteSMTPConfig
+	}
+
+	if (cfg.SMTPUsername == "") != (cfg.SMTPPassword == "") {
+		slog.ErrorContext(ctx, "failed to load configuration for SMTP", slog.Any("error", errIncompleteSMTPAuth))
+		return nil, errIncompleteSMTPAuth
+	}
+
+	return cfg, nil
+}
+
+// Check whether notifications by email are enabled
+func (c *SMTPConfig) Enabled() bool {
+	return c.SMTPHost != ""
+}
+
+// Check whether the SMTP server requires authentication
+func (c *SMTPConfig) AuthEnabled() bool {
+	return c.SMTPUsername != "" && c.SMTPPassword != ""
+}
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewSMTPConfig(t *testing.T) {
+	t.Run("Positive case: Successfully load configuration for SMTP", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("SMTP_HOST", "smtp.example.com")
+		t.Setenv("SMTP_PORT", "2525")
+		t.Setenv("SMTP_STARTTLS", "false")
+		t.Setenv("SMTP_USERNAME", "dummy_smtp_username")
+		t.Setenv("SMTP_PASSWORD", "dummy_smtp_password")
+		t.Setenv("SMTP_FROM", "notifier@example.com")
+		t.Setenv("SMTP_TO", "alice@example.com,bob@example.com")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		got, err := NewSMTPConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &SMTPConfig{
+			SMTPHost:     "smtp.example.com",
+			SMTPPort:     2525,
+			SMTPStartTLS: false,
+			SMTPUsername: "dummy_smtp_username",
+			SMTPPassword: "dummy_smtp_password",
+			SMTPFrom:     "notifier@example.com",
+			SMTPTo:       []string{"alice@example.com", "bob@example.com"},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+		if !got.Enabled() || !got.AuthEnabled() {
+			t.Errorf("\ngot: %v, %v\nwant: %v, %v", got.Enabled(), got.AuthEnabled(), true, true)
+		}
+	})
+
+	t.Run("Positive case: The port and STARTTLS are set to default values", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("SMTP_HOST", "smtp.example.com")
+		t.Setenv("SMTP_FROM", "notifier@example.com")
+		t.Setenv("SMTP_TO", "alice@example.com")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		got, err := NewSMTPConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := &SMTPConfig{
+			SMTPHost:     "smtp.example.com",
+			SMTPPort:     587,
+			SMTPStartTLS: true,
+			SMTPFrom:     "notifier@example.com",
+			SMTPTo:       []string{"alice@example.com"},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+		if got.AuthEnabled() {
+			t.Errorf("\ngot: %v\nwant: %v", got.AuthEnabled(), false)
+		}
+	})
+
+	t.Run("Positive case: SMTP is disabled if the host is empty", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("SMTP_HOST", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewSMTPConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if cfg.Enabled() {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.Enabled(), false)
+		}
+	})
+
+	t.Run("Negative case: The recipients are not set", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("SMTP_HOST", "smtp.example.com")
+		t.Setenv("SMTP_FROM", "notifier@example.com")
+		t.Setenv("SMTP_TO", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if _, gotErr := NewSMTPConfig(ctx); !errors.Is(gotErr, errIncompleteSMTPConfig) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errIncompleteSMTPConfig)
+		}
+	})
+
+	t.Run("Negative case: Only the username is set", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("SMTP_HOST", "smtp.example.com")
+		t.Setenv("SMTP_FROM", "notifier@example.com")
+		t.Setenv("SMTP_TO", "alice@example.com")
+		t.Setenv("SMTP_USERNAME", "dummy_smtp_username")
+		t.Setenv("SMTP_PASSWORD", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if _, gotErr := NewSMTPConfig(ctx); !errors.Is(gotErr, errIncompleteSMTPAuth) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errIncompleteSMTPAuth)
+		}
+	})
+
+	t.Run("Negative case: The port is invalid", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("SMTP_HOST", "smtp.example.com")
+		t.Setenv("SMTP_PORT", "invalid_port")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if _, err := NewSMTPConfig(ctx); err == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated in smtp.go", err)
+		}
+	})
+}
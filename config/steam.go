@@ -2,14 +2,64 @@ package config
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 )
 
+var (
+	errMissingSteamUserIDs    = errors.New("STEAM_USER_IDS or STEAM_USER_ID is required")
+	errInvalidSteamProfileURL = errors.New("invalid Steam Community profile URL")
+	errInvalidCountryCode     = errors.New("invalid ISO 3166-1 alpha-2 country code")
+)
+
+// A host of Steam Community profile URLs
+const steamCommunityHost string = "steamcommunity.com"
+
+// ISO 3166-1 alpha-2 country codes in lowercase
+//
+// [FYI]
+// ref. https://www.iso.org/iso-3166-country-codes.html
+var countryCodes = []string{
+	"ad", "ae", "af", "ag", "ai", "al", "am", "ao", "aq", "ar", "as", "at", "au", "aw", "ax", "az", "ba", "bb", "bd", "be",
+	"bf", "bg", "bh", "bi", "bj", "bl", "bm", "bn", "bo", "bq", "br", "bs", "bt", "bv", "bw", "by", "bz", "ca", "cc", "cd",
+	"cf", "cg", "ch", "ci", "ck", "cl", "cm", "cn", "co", "cr", "cu", "cv", "cw", "cx", "cy", "cz", "de", "dj", "dk", "dm",
+	"do", "dz", "ec", "ee", "eg", "eh", "er", "es", "et", "fi", "fj", "fk", "fm", "fo", "fr", "ga", "gb", "gd", "ge", "gf",
+	"gg", "gh", "gi", "gl", "gm", "gn", "gp", "gq", "gr", "gs", "gt", "gu", "gw", "gy", "hk", "hm", "hn", "hr", "ht", "hu",
+	"id", "ie", "il", "im", "in", "io", "iq", "ir", "is", "it", "je", "jm", "jo", "jp", "ke", "kg", "kh", "ki", "km", "kn",
+	"kp", "kr", "kw", "ky", "kz", "la", "lb", "lc", "li", "lk", "lr", "ls", "lt", "lu", "lv", "ly", "ma", "mc", "md", "me",
+	"mf", "mg", "mh", "mk", "ml", "mm", "mn", "mo", "mp", "mq", "mr", "ms", "mt", "mu", "mv", "mw", "mx", "my", "mz", "na",
+	"nc", "ne", "nf", "ng", "ni", "nl", "no", "np", "nr", "nu", "nz", "om", "pa", "pe", "pf", "pg", "ph", "pk", "pl", "pm",
+	"pn", "pr", "ps", "pt", "pw", "py", "qa", "re", "ro", "rs", "ru", "rw", "sa", "sb", "sc", "sd", "se", "sg", "sh", "si",
+	"sj", "sk", "sl", "sm", "sn", "so", "sr", "ss", "st", "sv", "sx", "sy", "sz", "tc", "td", "tf", "tg", "th", "tj", "tk",
+	"tl", "tm", "tn", "to", "tr", "tt", "tv", "tw", "tz", "ua", "ug", "um", "us", "uy", "uz", "va", "vc", "ve", "vg", "vi",
+	"vn", "vu", "wf", "ws", "ye", "yt", "za", "zm", "zw",
+}
+
 // A struct to store the configuration for Steamworks API
+//
+// [FYI]
+// SteamUserIDs is a comma-separated list of Steam user IDs whose wishlists are merged into one Notion DB.
+// SteamUserID is kept for backward compatibility and is merged into SteamUserIDs.
+// Each Steam user ID can be a SteamID64, a vanity name, or a Steam Community profile URL
+// e.g. "76561197960287930", "gabelogannewell", and "https://steamcommunity.com/id/gabelogannewell/".
+// Profile URLs are normalized into a SteamID64 or a vanity name, which is resolved by the steam package.
+// SteamCountryCode is an ISO 3166-1 alpha-2 code which decides the store region and the currency of prices,
+// and it is normalized into lowercase.
+// SteamWebAPIKey is optional, and it is used to resolve vanity names with the Steam Web API.
+// SteamMaxRetries and SteamRetryBaseDelay decide how requests throttled or failed by Steam are retried
 type SteamConfig struct {
-	SteamUserID string `env:"STEAM_USER_ID,notEmpty"`
+	SteamUserIDs        []string      `env:"STEAM_USER_IDS" envSeparator:","`
+	SteamUserID         string        `env:"STEAM_USER_ID"`
+	SteamCountryCode    string        `env:"STEAM_COUNTRY_CODE" envDefault:"jp"`
+	SteamWebAPIKey      string        `env:"STEAM_WEB_API_KEY"`
+	SteamMaxRetries     int           `env:"STEAM_MAX_RETRIES" envDefault:"3"`
+	SteamRetryBaseDelay time.Duration `env:"STEAM_RETRY_BASE_DELAY" envDefault:"1s"`
 }
 
 // Generate configuration for the unofficial Steam API
@@ -25,5 +75,65 @@ func NewSteamConfig(ctx context.Context) (*SteamConfig, error) {
 		return nil, err
 	}
 
+	// Normalize the Steam user IDs by trimming spaces, extracting IDs from profile URLs,
+	// and removing empty and duplicate IDs
+	steamUserIDs := make([]string, 0, len(cfg.SteamUserIDs)+1)
+	for _, v := range append(cfg.SteamUserIDs, cfg.SteamUserID) {
+		v, err := normalizeSteamUserID(v)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to load configuration for the Steam API", slog.Any("error", err))
+			return nil, err
+		}
+
+		if v != "" && !slices.Contains(steamUserIDs, v) {
+			steamUserIDs = append(steamUserIDs, v)
+		}
+	}
+	if len(steamUserIDs) == 0 {
+		slog.ErrorContext(ctx, "failed to load configuration for the Steam API", slog.Any("error", errMissingSteamUserIDs))
+		return nil, errMissingSteamUserIDs
+	}
+	cfg.SteamUserIDs = steamUserIDs
+
+	// Validate the country code because Steam silently returns prices of another region for an invalid one
+	cfg.SteamCountryCode = strings.ToLower(strings.TrimSpace(cfg.SteamCountryCode))
+	if !slices.Contains(countryCodes, cfg.SteamCountryCode) {
+		err := fmt.Errorf("%w: %s", errInvalidCountryCode, cfg.SteamCountryCode)
+		slog.ErrorContext(ctx, "failed to load configuration for the Steam API", slog.Any("error", err))
+		return nil, err
+	}
+
 	return cfg, nil
 }
+
+// Normalize a Steam user ID into a SteamID64 or a vanity name
+//
+// [FYI]
+// A Steam Community profile URL is either "https://steamcommunity.com/profiles/<SteamID64>/"
+// or "https://steamcommunity.com/id/<vanity name>/", and the scheme and "www." may be omitted
+func normalizeSteamUserID(steamUserID string) (string, error) {
+	steamUserID = strings.TrimSpace(steamUserID)
+	if !strings.Contains(steamUserID, "/") {
+		return steamUserID, nil
+	}
+
+	path := strings.TrimPrefix(steamUserID, "https://")
+	path = strings.TrimPrefix(path, "http://")
+	path = strings.TrimPrefix(path, "www.")
+	path, ok := strings.CutPrefix(path, steamCommunityHost+"/")
+	if !ok {
+		return "", fmt.Errorf("%w: %s", errInvalidSteamProfileURL, steamUserID)
+	}
+
+	// Remove a query and a fragment
+	// e.g. "id/gabelogannewell/?l=japanese" -> "id/gabelogannewell/"
+	path, _, _ = strings.Cut(path, "?")
+	path, _, _ = strings.Cut(path, "#")
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) != 2 || (segments[0] != "id" && segments[0] != "profiles") || segments[1] == "" {
+		return "", fmt.Errorf("%w: %s", errInvalidSteamProfileURL, steamUserID)
+	}
+
+	return segments[1], nil
+}
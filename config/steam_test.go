@@ -2,7 +2,11 @@ package config
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestNewSteamConfig(t *testing.T) {
@@ -18,8 +22,104 @@ func TestNewSteamConfig(t *testing.T) {
 		}
 	})
 
+	t.Run("Positive case: The store region defaults to Japan", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("STEAM_USER_ID", "dummy_steam_user_id")
+		t.Setenv("STEAM_COUNTRY_CODE", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewSteamConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if want := "jp"; cfg.SteamCountryCode != want {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.SteamCountryCode, want)
+		}
+	})
+
+	t.Run("Positive case: The country code is normalized into lowercase", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("STEAM_USER_ID", "dummy_steam_user_id")
+		t.Setenv("STEAM_COUNTRY_CODE", " AU ")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewSteamConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if want := "au"; cfg.SteamCountryCode != want {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.SteamCountryCode, want)
+		}
+	})
+
+	t.Run("Positive case: Retries of requests to Steam have default settings", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("STEAM_USER_ID", "dummy_steam_user_id")
+		t.Setenv("STEAM_MAX_RETRIES", "")
+		t.Setenv("STEAM_RETRY_BASE_DELAY", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewSteamConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if diff := cmp.Diff(
+			[]any{cfg.SteamMaxRetries, cfg.SteamRetryBaseDelay},
+			[]any{3, time.Second},
+		); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: Successfully load multiple Steam user IDs", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("STEAM_USER_IDS", "dummy_steam_user_id_1, dummy_steam_user_id_2,,dummy_steam_user_id_1")
+		t.Setenv("STEAM_USER_ID", "dummy_steam_user_id_3")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewSteamConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := []string{"dummy_steam_user_id_1", "dummy_steam_user_id_2", "dummy_steam_user_id_3"}
+		if diff := cmp.Diff(cfg.SteamUserIDs, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
+	t.Run("Positive case: Successfully normalize Steam Community profile URLs", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv(
+			"STEAM_USER_IDS",
+			"https://steamcommunity.com/id/dummy_vanity_name/,steamcommunity.com/profiles/76561197960287930,"+
+				"https://steamcommunity.com/id/dummy_vanity_name?l=japanese",
+		)
+		t.Setenv("STEAM_USER_ID", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewSteamConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		want := []string{"dummy_vanity_name", "76561197960287930"}
+		if diff := cmp.Diff(cfg.SteamUserIDs, want); diff != "" {
+			t.Errorf("got(-) want(+)\n%s", diff)
+		}
+	})
+
 	t.Run("Negative case: Environment variables are missing or empty", func(t *testing.T) {
 		// Set environment variables
+		t.Setenv("STEAM_USER_IDS", "")
 		t.Setenv("STEAM_USER_ID", "")
 
 		// Execute the function to be tested
@@ -29,4 +129,31 @@ func TestNewSteamConfig(t *testing.T) {
 			t.Errorf("\ngot: %v\nwant: an error generated in steam.go", nil)
 		}
 	})
+
+	t.Run("Negative case: A profile URL is not a Steam Community profile URL", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("STEAM_USER_IDS", "https://store.steampowered.com/app/105600/")
+		t.Setenv("STEAM_USER_ID", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if _, gotErr := NewSteamConfig(ctx); !errors.Is(gotErr, errInvalidSteamProfileURL) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errInvalidSteamProfileURL)
+		}
+	})
+	t.Run("Negative case: The country code is not an ISO 3166-1 alpha-2 code", func(t *testing.T) {
+		for _, countryCode := range []string{"japan", "xx", "j"} {
+			// Set environment variables
+			t.Setenv("STEAM_USER_ID", "dummy_steam_user_id")
+			t.Setenv("STEAM_COUNTRY_CODE", countryCode)
+
+			// Execute the function to be tested
+			ctx, cancel := context.WithCancel(context.Background())
+			if _, gotErr := NewSteamConfig(ctx); !errors.Is(gotErr, errInvalidCountryCode) {
+				t.Errorf("\ngot: %v\nwant: %v", gotErr, errInvalidCountryCode)
+			}
+			cancel()
+		}
+	})
 }
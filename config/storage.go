@@ -0,0 +1,34 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/caarlos0/env/v11"
+)
+
+// A struct to store the configuration for the local storage
+//
+// [FYI]
+// StorageFilePath is a path of an embedded database file which stores the price history.
+// The file under /tmp is ephemeral on AWS Lambda, so mount a persistent file system (e.g. Amazon EFS)
+// and set the path on it to keep the history across cold starts
+type StorageConfig struct {
+	StorageFilePath string `env:"STORAGE_FILE_PATH" envDefault:"/tmp/steam_game_price_notifier.db"`
+}
+
+// Generate configuration for the local storage
+func NewStorageConfig(ctx context.Context) (*StorageConfig, error) {
+	cfg := &StorageConfig{}
+	if err := env.Parse(cfg); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to load configuration for the local storage",
+			slog.Any("error", err),
+		)
+
+		return nil, err
+	}
+
+	return cfg, nil
+}
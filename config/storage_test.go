@@ -0,0 +1,40 @@
+package config
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewStorageConfig(t *testing.T) {
+	t.Run("Positive case: Successfully load configuration for the local storage", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("STORAGE_FILE_PATH", "/mnt/efs/steam_game_price_notifier.db")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewStorageConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if want := "/mnt/efs/steam_game_price_notifier.db"; cfg.StorageFilePath != want {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.StorageFilePath, want)
+		}
+	})
+
+	t.Run("Positive case: The storage file path defaults to a file under /tmp", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("STORAGE_FILE_PATH", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewStorageConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if want := "/tmp/steam_game_price_notifier.db"; cfg.StorageFilePath != want {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.StorageFilePath, want)
+		}
+	})
+}
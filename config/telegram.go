@@ -0,0 +1,48 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/caarlos0/env/v11"
+)
+
+var errIncompleteTelegramConfig = errors.New("TELEGRAM_BOT_TOKEN and TELEGRAM_CHAT_ID must be set together")
+
+// A struct to store the configuration for Telegram
+//
+// [FYI]
+// TelegramBotToken is a token of a bot issued by @BotFather,
+// and TelegramChatID is an ID of a chat to send messages to (e.g. "123456789" or "@channel_username").
+// Telegram is disabled if both of them are empty
+type TelegramConfig struct {
+	TelegramBotToken string `env:"TELEGRAM_BOT_TOKEN"`
+	TelegramChatID   string `env:"TELEGRAM_CHAT_ID"`
+}
+
+// Generate configuration for Telegram
+func NewTelegramConfig(ctx context.Context) (*TelegramConfig, error) {
+	cfg := &TelegramConfig{}
+	if err := env.Parse(cfg); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to load configuration for Telegram",
+			slog.Any("error", err),
+		)
+
+		return nil, err
+	}
+
+	if (cfg.TelegramBotToken == "") != (cfg.TelegramChatID == "") {
+		slog.ErrorContext(ctx, "failed to load configuration for Telegram", slog.Any("error", errIncompleteTelegramConfig))
+		return nil, errIncompleteTelegramConfig
+	}
+
+	return cfg, nil
+}
+
+// Check whether notifications on Telegram are enabled
+func (c *TelegramConfig) Enabled() bool {
+	return c.TelegramBotToken != "" && c.TelegramChatID != ""
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestNewTelegramConfig(t *testing.T) {
+	t.Run("Positive case: Successfully load configuration for Telegram", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("TELEGRAM_BOT_TOKEN", "dummy_telegram_bot_token")
+		t.Setenv("TELEGRAM_CHAT_ID", "dummy_telegram_chat_id")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewTelegramConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if !cfg.Enabled() {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.Enabled(), true)
+		}
+	})
+
+	t.Run("Positive case: Telegram is disabled if environment variables are empty", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("TELEGRAM_BOT_TOKEN", "")
+		t.Setenv("TELEGRAM_CHAT_ID", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewTelegramConfig(ctx)
+		if err != nil {
+			t.Errorf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if cfg.Enabled() {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.Enabled(), false)
+		}
+	})
+
+	t.Run("Negative case: Only one of environment variables is set", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("TELEGRAM_BOT_TOKEN", "dummy_telegram_bot_token")
+		t.Setenv("TELEGRAM_CHAT_ID", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if _, gotErr := NewTelegramConfig(ctx); !errors.Is(gotErr, errIncompleteTelegramConfig) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errIncompleteTelegramConfig)
+		}
+	})
+}
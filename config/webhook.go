@@ -0,0 +1,52 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+var errIncompleteWebhookConfig = errors.New("WEBHOOK_URL and WEBHOOK_SECRET must be set together")
+
+// A struct to store the configuration for a generic webhook
+//
+// [FYI]
+// WebhookURL is an endpoint which receives JSON payloads by POST (e.g. a webhook of Home Assistant or n8n),
+// and WebhookSecret is a shared secret to sign the payloads with HMAC-SHA256.
+// The webhook is disabled if both of them are empty.
+// WebhookMaxRetries and WebhookRetryBaseDelay decide how requests failed by the endpoint are retried
+type WebhookConfig struct {
+	WebhookURL            string        `env:"WEBHOOK_URL"`
+	WebhookSecret         string        `env:"WEBHOOK_SECRET"`
+	WebhookMaxRetries     int           `env:"WEBHOOK_MAX_RETRIES" envDefault:"3"`
+	WebhookRetryBaseDelay time.Duration `env:"WEBHOOK_RETRY_BASE_DELAY" envDefault:"1s"`
+}
+
+// Generate configuration for a generic webhook
+func NewWebhookConfig(ctx context.Context) (*WebhookConfig, error) {
+	cfg := &WebhookConfig{}
+	if err := env.Parse(cfg); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to load configuration for a webhook",
+			slog.Any("error", err),
+		)
+
+		return nil, err
+	}
+
+	if (cfg.WebhookURL == "") != (cfg.WebhookSecret == "") {
+		slog.ErrorContext(ctx, "failed to load configuration for a webhook", slog.Any("error", errIncompleteWebhookConfig))
+		return nil, errIncompleteWebhookConfig
+	}
+
+	return cfg, nil
+}
+
+// Check whether notifications with a webhook are enabled
+func (c *WebhookConfig) Enabled() bool {
+	return c.WebhookURL != "" && c.WebhookSecret != ""
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewWebhookConfig(t *testing.T) {
+	t.Run("Positive case: Successfully load configuration for a webhook", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("WEBHOOK_URL", "https://example.com/webhook")
+		t.Setenv("WEBHOOK_SECRET", "dummy_webhook_secret")
+		t.Setenv("WEBHOOK_MAX_RETRIES", "")
+		t.Setenv("WEBHOOK_RETRY_BASE_DELAY", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewWebhookConfig(ctx)
+		if err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if !cfg.Enabled() {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.Enabled(), true)
+		}
+		if cfg.WebhookMaxRetries != 3 || cfg.WebhookRetryBaseDelay != time.Second {
+			t.Errorf("\ngot: %v, %v\nwant: %v, %v", cfg.WebhookMaxRetries, cfg.WebhookRetryBaseDelay, 3, time.Second)
+		}
+	})
+
+	t.Run("Positive case: The webhook is disabled if environment variables are empty", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("WEBHOOK_URL", "")
+		t.Setenv("WEBHOOK_SECRET", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cfg, err := NewWebhookConfig(ctx)
+		if err != nil {
+			t.Fatalf("\ngot: %v\nwant: %v", err, nil)
+		}
+		if cfg.Enabled() {
+			t.Errorf("\ngot: %v\nwant: %v", cfg.Enabled(), false)
+		}
+	})
+
+	t.Run("Negative case: Only one of environment variables is set", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("WEBHOOK_URL", "https://example.com/webhook")
+		t.Setenv("WEBHOOK_SECRET", "")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if _, gotErr := NewWebhookConfig(ctx); !errors.Is(gotErr, errIncompleteWebhookConfig) {
+			t.Errorf("\ngot: %v\nwant: %v", gotErr, errIncompleteWebhookConfig)
+		}
+	})
+
+	t.Run("Negative case: WEBHOOK_RETRY_BASE_DELAY is not a duration", func(t *testing.T) {
+		// Set environment variables
+		t.Setenv("WEBHOOK_URL", "https://example.com/webhook")
+		t.Setenv("WEBHOOK_SECRET", "dummy_webhook_secret")
+		t.Setenv("WEBHOOK_RETRY_BASE_DELAY", "invalid")
+
+		// Execute the function to be tested
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if _, gotErr := NewWebhookConfig(ctx); gotErr == nil {
+			t.Errorf("\ngot: %v\nwant: an error generated in webhook.go", nil)
+		}
+	})
+}
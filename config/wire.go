@@ -7,4 +7,12 @@ var Set = wire.NewSet(
 	NewNotionConfig,
 	NewSteamConfig,
 	NewDiscordConfig,
+	NewSlackConfig,
+	NewSMTPConfig,
+	NewLineConfig,
+	NewTelegramConfig,
+	NewWebhookConfig,
+	NewStorageConfig,
+	NewIsThereAnyDealConfig,
+	NewNotifierConfig,
 )
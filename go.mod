@@ -9,8 +9,12 @@ require (
 	github.com/google/wire v0.7.0
 	github.com/hashicorp/go-multierror v1.1.1
 	github.com/shogo82148/pointer v1.4.0
+	go.etcd.io/bbolt v1.4.3
 	go.uber.org/mock v0.6.0
 	golang.org/x/time v0.15.0
 )
 
-require github.com/hashicorp/errwrap v1.1.0 // indirect
+require (
+	github.com/hashicorp/errwrap v1.1.0 // indirect
+	golang.org/x/sys v0.29.0 // indirect
+)